@@ -12,11 +12,13 @@ import (
 	"github.com/blagoySimandov/ampledata/go/internal/api"
 	"github.com/blagoySimandov/ampledata/go/internal/auth"
 	"github.com/blagoySimandov/ampledata/go/internal/billing"
+	"github.com/blagoySimandov/ampledata/go/internal/blob"
 	"github.com/blagoySimandov/ampledata/go/internal/config"
 	"github.com/blagoySimandov/ampledata/go/internal/db"
 	"github.com/blagoySimandov/ampledata/go/internal/enricher"
 	"github.com/blagoySimandov/ampledata/go/internal/gcs"
 	"github.com/blagoySimandov/ampledata/go/internal/services"
+	"github.com/blagoySimandov/ampledata/go/internal/sqlsource"
 	"github.com/blagoySimandov/ampledata/go/internal/state"
 	"github.com/blagoySimandov/ampledata/go/internal/templates"
 	"github.com/blagoySimandov/ampledata/go/internal/temporal/activities"
@@ -50,11 +52,18 @@ func main() {
 		log.Fatalf("Failed to create cost tracker: %v", err)
 	}
 
-	gcsReader, err := gcs.NewCSVReader("ampledata-enrichment-uploads")
+	blobStore, err := blob.New(context.Background(), cfg)
 	if err != nil {
-		log.Fatalf("Failed to create GCS reader: %v", err)
+		log.Fatalf("Failed to create blob store: %v", err)
 	}
-	defer gcsReader.Close()
+	defer blobStore.Close()
+	gcsReader := gcs.NewCSVReader(blobStore)
+	sourceConnections, err := sqlsource.ParseConnections(cfg.SourceConnections)
+	if err != nil {
+		log.Fatalf("Failed to load source connections: %v", err)
+	}
+	connections := sqlsource.NewRegistry(sourceConnections)
+	defer connections.Close()
 	stateManager := state.NewStateManager(store)
 
 	aiClient, err := services.NewGeminiAIClient(services.WithCostTracker(costTracker))
@@ -86,6 +95,10 @@ func main() {
 	}
 	defer tc.Close()
 
+	// Create Temporal-based enricher
+	enr := enricher.NewTemporalEnricher(tc, stateManager, cfg.TemporalTaskQueue, cfg.MaxEnrichmentRetries)
+	sourcesService := services.NewSourcesService(store, gcsReader, connections, enr, aiClient, promptService)
+
 	acts := activities.NewActivities(
 		stateManager,
 		webSearcher,
@@ -94,6 +107,10 @@ func main() {
 		extractor,
 		patternGenerator,
 		billingService,
+		services.NewResultWriteBack(store, connections),
+		services.NewExporter(store, sourcesService, blobStore),
+		services.NewWebhookDispatcher(store),
+		cfg.ClassifyCellsPerCredit,
 	)
 
 	w := worker.NewWorker(tc, cfg.TemporalTaskQueue, acts)
@@ -103,18 +120,34 @@ func main() {
 	}
 	defer w.Stop()
 
-	// Create Temporal-based enricher
-	enr := enricher.NewTemporalEnricher(tc, stateManager, cfg.TemporalTaskQueue, cfg.MaxEnrichmentRetries)
-
 	jwtVerifier, err := auth.NewJWTVerifier(cfg.WorkOSClientID, cfg.DebugAuthBypass)
 	if err != nil {
 		log.Fatalf("Failed to create JWT verifier: %v", err)
 	}
 
-	sourcesService := services.NewSourcesService(store, gcsReader, enr, aiClient, promptService)
 	templatesRepo := templates.NewTemplatesRepo(db)
-	server := api.NewServer(enr, gcsReader, store, userRepo, billingService, keySelector, sourcesService, templatesRepo)
-	router := api.SetupRoutes(server, jwtVerifier, userService, cfg.StaticDir)
+	exportService := services.NewExportService(store, blobStore, enr)
+	correctionService := services.NewCorrectionService(store)
+	rerunService := services.NewRerunService(store, sourcesService, enr)
+	diffService := services.NewDiffService(store)
+	webhookService := services.NewWebhookService(store)
+	broker := state.NewRowEventBroker(db, store)
+	brokerCtx, stopBroker := context.WithCancel(context.Background())
+	defer stopBroker()
+	go func() {
+		if err := broker.Run(brokerCtx); err != nil {
+			log.Printf("Row event broker stopped: %v", err)
+		}
+	}()
+	rowEventService := services.NewRowEventService(store, broker)
+	apiKeyService := services.NewAPIKeyService(store, userRepo)
+	lookupService := services.NewLookupService(enr, billingService, time.Duration(cfg.LookupTimeoutSeconds)*time.Second)
+	server := api.NewServer(enr, gcsReader, store, userRepo, billingService, keySelector, sourcesService, templatesRepo, exportService, correctionService, rerunService, diffService, webhookService, rowEventService, apiKeyService, lookupService)
+	var uploadHandler http.Handler
+	if local, ok := blobStore.(*blob.LocalStore); ok {
+		uploadHandler = local.Handler()
+	}
+	router := api.SetupRoutes(server, jwtVerifier, apiKeyService, userService, cfg.StaticDir, uploadHandler)
 
 	srv := &http.Server{
 		Addr:         cfg.ServerAddr,
@@ -0,0 +1,71 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/blagoySimandov/ampledata/go/internal/auth"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/services"
+	"github.com/google/uuid"
+)
+
+func (s *Server) CreateAPIKey(ctx context.Context, req CreateAPIKeyRequestObject) (CreateAPIKeyResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return CreateAPIKey401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	var scopes []models.APIKeyScope
+	for _, scope := range services.Deref(req.Body.Scopes) {
+		scopes = append(scopes, models.APIKeyScope(scope))
+	}
+	apiKey, key, err := s.apiKeys.CreateKey(ctx, u.ID, req.Body.Name, scopes, req.Body.ExpiresAt)
+	if err != nil {
+		var validErr services.ValidationError
+		if errors.As(err, &validErr) {
+			return CreateAPIKey400JSONResponse{Message: validErr.Msg}, nil
+		}
+		log.Printf("Failed to create API key: %v", err)
+		return CreateAPIKey500JSONResponse{Message: "Failed to create API key"}, nil
+	}
+	resp := toAPIAPIKey(apiKey)
+	resp.Key = &key
+	return CreateAPIKey201JSONResponse(resp), nil
+}
+
+func (s *Server) ListAPIKeys(ctx context.Context, req ListAPIKeysRequestObject) (ListAPIKeysResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return ListAPIKeys401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	keys, err := s.apiKeys.ListKeys(ctx, u.ID)
+	if err != nil {
+		log.Printf("Failed to list API keys: %v", err)
+		return ListAPIKeys500JSONResponse{Message: "Failed to list API keys"}, nil
+	}
+	resp := APIKeyListResponse{Keys: make([]APIKeyResponse, len(keys))}
+	for i, k := range keys {
+		resp.Keys[i] = toAPIAPIKey(k)
+	}
+	return ListAPIKeys200JSONResponse(resp), nil
+}
+
+func (s *Server) RevokeAPIKey(ctx context.Context, req RevokeAPIKeyRequestObject) (RevokeAPIKeyResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return RevokeAPIKey401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	err := s.apiKeys.RevokeKey(ctx, uuid.UUID(req.KeyID), u.ID)
+	switch {
+	case err == nil:
+		return RevokeAPIKey204Response{}, nil
+	case errors.Is(err, services.ErrAPIKeyNotFound):
+		return RevokeAPIKey404JSONResponse{Message: "API key not found"}, nil
+	case errors.Is(err, services.ErrJobForbidden):
+		return RevokeAPIKey403JSONResponse{Message: "Forbidden"}, nil
+	default:
+		log.Printf("Failed to revoke API key: %v", err)
+		return RevokeAPIKey500JSONResponse{Message: "Failed to revoke API key"}, nil
+	}
+}
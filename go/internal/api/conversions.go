@@ -1,6 +1,7 @@
 package api
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/blagoySimandov/ampledata/go/internal/models"
@@ -50,6 +51,10 @@ func toModelColumnMetadataSlice(cols []ColumnMetadata) []*models.ColumnMetadata
 			Type:        models.ColumnType(c.Type),
 			JobType:     models.JobType(c.JobType),
 			Description: c.Description,
+			Expression:  c.Expression,
+		}
+		if c.InputColumns != nil {
+			result[i].InputColumns = *c.InputColumns
 		}
 	}
 	return result
@@ -162,12 +167,72 @@ func toAPIConfidence(c map[string]*models.FieldConfidenceInfo) *map[string]Field
 	m := make(map[string]FieldConfidenceInfo, len(c))
 	for k, v := range c {
 		if v != nil {
-			m[k] = FieldConfidenceInfo{Score: v.Score, Reason: v.Reason}
+			info := FieldConfidenceInfo{Score: v.Score, Reason: v.Reason}
+			if v.Precision != "" {
+				precision := DatePrecision(v.Precision)
+				info.Precision = &precision
+			}
+			if v.HumanVerified {
+				info.HumanVerified = &v.HumanVerified
+			}
+			if v.Citation != nil {
+				info.Citation = &Citation{Url: v.Citation.URL, Quote: v.Citation.Quote, Verified: v.Citation.Verified}
+			}
+			m[k] = info
 		}
 	}
 	return &m
 }
 
+func toAPICellCorrection(c *models.CellCorrection) CellCorrection {
+	return CellCorrection{
+		Id:        c.ID,
+		JobId:     c.JobID,
+		RowKey:    c.RowKey,
+		Column:    c.Column,
+		OldValue:  &c.OldValue,
+		NewValue:  &c.NewValue,
+		UserId:    c.UserID,
+		CreatedAt: c.CreatedAt,
+	}
+}
+
+func toAPIJobDiff(d *models.JobDiff) JobDiffResponse {
+	resp := JobDiffResponse{
+		BaseJobId:  d.BaseJobID,
+		JobId:      d.JobID,
+		Columns:    make([]ColumnDiffSummary, len(d.Columns)),
+		Rows:       make([]RowDiff, len(d.Rows)),
+		Pagination: toAPIPagination(d.Pagination),
+	}
+	for i, c := range d.Columns {
+		resp.Columns[i] = ColumnDiffSummary{
+			Column:              c.Column,
+			Added:               c.Added,
+			Removed:             c.Removed,
+			Changed:             c.Changed,
+			Unchanged:           c.Unchanged,
+			MeanConfidenceDelta: c.MeanConfidenceDelta,
+		}
+	}
+	for i, r := range d.Rows {
+		row := RowDiff{Key: r.Key, Cells: make([]CellDiff, len(r.Cells))}
+		for j, c := range r.Cells {
+			row.Cells[j] = CellDiff{
+				Column:          c.Column,
+				Change:          DiffChange(c.Change),
+				BaseValue:       &c.BaseValue,
+				Value:           &c.Value,
+				BaseConfidence:  c.BaseConfidence,
+				Confidence:      c.Confidence,
+				ConfidenceDelta: c.ConfidenceDelta,
+			}
+		}
+		resp.Rows[i] = row
+	}
+	return resp
+}
+
 func toAPISourceJobSummary(j *models.Job) SourceJobSummary {
 	summary := SourceJobSummary{
 		JobId:     j.JobID,
@@ -175,13 +240,23 @@ func toAPISourceJobSummary(j *models.Job) SourceJobSummary {
 		TotalRows: j.TotalRows,
 		CreatedAt: j.CreatedAt,
 		StartedAt: j.StartedAt,
+		BaseJobId: j.BaseJobID,
+	}
+	if j.SourceRevision > 0 {
+		summary.SourceRevision = &j.SourceRevision
 	}
 	if j.KeyColumns != nil {
 		summary.KeyColumns = &j.KeyColumns
 	}
+	if j.ContextColumns != nil {
+		summary.ContextColumns = &j.ContextColumns
+	}
 	if j.KeyColumnDescription != nil {
 		summary.KeyColumnDescription = j.KeyColumnDescription
 	}
+	if j.Locale != nil {
+		summary.Locale = j.Locale
+	}
 	if j.ColumnsMetadata != nil {
 		apiCols := toAPIColumnMetadataSlice(j.ColumnsMetadata)
 		summary.ColumnsMetadata = &apiCols
@@ -197,6 +272,11 @@ func toAPIColumnMetadataSlice(cols []*models.ColumnMetadata) []ColumnMetadata {
 			Type:        ColumnType(c.Type),
 			JobType:     JobType(c.JobType),
 			Description: c.Description,
+			Expression:  c.Expression,
+		}
+		if len(c.InputColumns) > 0 {
+			inputColumns := c.InputColumns
+			result[i].InputColumns = &inputColumns
 		}
 	}
 	return result
@@ -226,10 +306,61 @@ func toAPISourceSummary(r *services.SourceWithJobs) SourceSummary {
 }
 
 func sourceMetaName(s *models.Source) *string {
-	if meta, ok := s.Metadata.(*models.CSVSourceMetadata); ok && meta.Name != "" {
-		return &meta.Name
+	var name string
+	switch meta := s.Metadata.(type) {
+	case *models.CSVSourceMetadata:
+		name = meta.Name
+	case *models.XLSXSourceMetadata:
+		name = meta.Name
+	case *models.SQLSourceMetadata:
+		name = meta.Name
+	}
+	if name == "" {
+		return nil
+	}
+	return &name
+}
+
+func toAPISourceSheets(s *services.SourceSheets) SourceSheetsResponse {
+	resp := SourceSheetsResponse{Sheets: s.Sheets, HeaderRow: s.Selection.HeaderRow}
+	if resp.HeaderRow == 0 {
+		resp.HeaderRow = 1
+	}
+	if s.Selection.SheetName != "" {
+		name := s.Selection.SheetName
+		resp.SheetName = &name
+	}
+	return resp
+}
+
+func toAPIExport(d *services.ExportDownload) ExportResponse {
+	e := d.Export
+	return ExportResponse{
+		Id:                openapi_types.UUID(e.ID),
+		JobId:             e.JobID,
+		Format:            string(e.Format),
+		Status:            ExportResponseStatus(e.Status),
+		RowCount:          e.RowCount,
+		IncludeConfidence: e.Options.IncludeConfidence,
+		IncludeSources:    e.Options.IncludeSources,
+		IncludeCitations:  e.Options.IncludeCitations,
+		IncludeError:      e.Options.IncludeError,
+		Error:             e.Error,
+		CreatedAt:         e.CreatedAt,
+		CompletedAt:       e.CompletedAt,
+		DownloadUrl:       d.URL,
+		DownloadExpiresAt: d.ExpiresAt,
+	}
+}
+
+func toAPICSVDialect(d *models.CSVDialect) CSVDialectResponse {
+	return CSVDialectResponse{
+		Delimiter: d.Delimiter,
+		Encoding:  d.Encoding,
+		Bom:       d.BOM,
+		HeaderRow: d.HeaderRow,
+		Manual:    d.Manual,
 	}
-	return nil
 }
 
 func toAPISourceDetail(source *models.Source, jobs []*models.Job) SourceDetail {
@@ -237,10 +368,88 @@ func toAPISourceDetail(source *models.Source, jobs []*models.Job) SourceDetail {
 	for i, j := range jobs {
 		jobSummaries[i] = toAPISourceJobSummary(j)
 	}
-	return SourceDetail{
+	detail := SourceDetail{
 		SourceId:  openapi_types.UUID(source.ID),
 		Type:      string(source.Type),
 		CreatedAt: source.CreatedAt,
 		Jobs:      jobSummaries,
 	}
+	if meta, ok := source.Metadata.(models.RevisionedMetadata); ok {
+		revision := meta.CurrentRevision()
+		detail.Revision = &revision
+	}
+	return detail
+}
+
+func toAPIWebhookEndpoint(e *models.WebhookEndpoint) WebhookEndpointResponse {
+	events := make([]WebhookEventType, len(e.Events))
+	for i, event := range e.Events {
+		events[i] = WebhookEventType(event)
+	}
+	return WebhookEndpointResponse{
+		Id:        e.ID,
+		Url:       e.URL,
+		Events:    events,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+func toAPIWebhookDelivery(d *models.WebhookDelivery) WebhookDeliveryResponse {
+	// Payloads are encoded from a WebhookEvent, so they always decode.
+	var payload map[string]interface{}
+	_ = json.Unmarshal(d.Payload, &payload)
+	return WebhookDeliveryResponse{
+		Id:             d.ID,
+		EndpointId:     d.EndpointID,
+		EventId:        d.EventID,
+		EventType:      WebhookEventType(d.EventType),
+		JobId:          d.JobID,
+		Payload:        payload,
+		Status:         WebhookDeliveryResponseStatus(d.Status),
+		Attempts:       d.Attempts,
+		ResponseStatus: d.ResponseStatus,
+		Error:          d.Error,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    d.DeliveredAt,
+	}
+}
+
+func toAPIAPIKey(k *models.APIKey) APIKeyResponse {
+	scopes := make([]APIKeyScope, len(k.Scopes))
+	for i, scope := range k.Scopes {
+		scopes[i] = APIKeyScope(scope)
+	}
+	return APIKeyResponse{
+		Id:         k.ID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     scopes,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+		CreatedAt:  k.CreatedAt,
+	}
+}
+
+func toAPILookup(r *models.EntityLookupResult) LookupResponse {
+	resp := LookupResponse{
+		Key:           r.Key,
+		ExtractedData: r.ExtractedData,
+		Confidence:    map[string]FieldConfidenceInfo{},
+		Sources:       r.Sources,
+		Success:       r.Success,
+	}
+	if resp.ExtractedData == nil {
+		resp.ExtractedData = map[string]interface{}{}
+	}
+	if c := toAPIConfidence(r.Confidence); c != nil {
+		resp.Confidence = *c
+	}
+	if resp.Sources == nil {
+		resp.Sources = []string{}
+	}
+	if r.Error != "" {
+		resp.Error = &r.Error
+	}
+	return resp
 }
@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/blagoySimandov/ampledata/go/internal/auth"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/services"
+)
+
+func (s *Server) CorrectJobCell(ctx context.Context, req CorrectJobCellRequestObject) (CorrectJobCellResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return CorrectJobCell401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	correction, row, err := s.corrections.CorrectCell(ctx, services.CellCorrectionInput{
+		JobID:  req.JobID,
+		UserID: u.ID,
+		RowKey: req.Body.RowKey,
+		Column: req.Body.Column,
+		Value:  services.Deref(req.Body.Value),
+	})
+	if err != nil {
+		return toCorrectJobCellError(err), nil
+	}
+	return CorrectJobCell200JSONResponse{
+		Correction: toAPICellCorrection(correction),
+		Row:        toAPIRowProgressItem(models.ToRowProgressItem(row)),
+	}, nil
+}
+
+func (s *Server) ListJobCorrections(ctx context.Context, req ListJobCorrectionsRequestObject) (ListJobCorrectionsResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return ListJobCorrections401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	corrections, err := s.corrections.ListCorrections(ctx, req.JobID, u.ID, services.Deref(req.Params.RowKey))
+	if err != nil {
+		return toListJobCorrectionsError(err), nil
+	}
+	resp := CellCorrectionListResponse{Corrections: make([]CellCorrection, len(corrections))}
+	for i, c := range corrections {
+		resp.Corrections[i] = toAPICellCorrection(c)
+	}
+	return ListJobCorrections200JSONResponse(resp), nil
+}
+
+func toCorrectJobCellError(err error) CorrectJobCellResponseObject {
+	var validErr services.ValidationError
+	switch {
+	case errors.Is(err, services.ErrJobNotFound):
+		return CorrectJobCell404JSONResponse{Message: "Job not found"}
+	case errors.Is(err, services.ErrRowNotFound):
+		return CorrectJobCell404JSONResponse{Message: "Row not found"}
+	case errors.Is(err, services.ErrJobForbidden):
+		return CorrectJobCell403JSONResponse{Message: "Forbidden"}
+	case errors.As(err, &validErr):
+		return CorrectJobCell400JSONResponse{Message: validErr.Msg}
+	default:
+		log.Printf("Failed to correct cell: %v", err)
+		return CorrectJobCell500JSONResponse{Message: "Failed to correct cell"}
+	}
+}
+
+func toListJobCorrectionsError(err error) ListJobCorrectionsResponseObject {
+	switch {
+	case errors.Is(err, services.ErrJobNotFound):
+		return ListJobCorrections404JSONResponse{Message: "Job not found"}
+	case errors.Is(err, services.ErrJobForbidden):
+		return ListJobCorrections403JSONResponse{Message: "Forbidden"}
+	default:
+		log.Printf("Failed to list corrections: %v", err)
+		return ListJobCorrections500JSONResponse{Message: "Failed to list corrections"}
+	}
+}
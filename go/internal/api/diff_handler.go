@@ -0,0 +1,116 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/blagoySimandov/ampledata/go/internal/auth"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/services"
+)
+
+func (s *Server) DiffJobs(ctx context.Context, req DiffJobsRequestObject) (DiffJobsResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return DiffJobs401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	offset, limit := paginationParams(req.Params.Offset, req.Params.Limit, 50)
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	input := diffInput(req.JobID, u.ID, req.Params.Base, req.Params.Column, req.Params.Change)
+	input.Offset, input.Limit = offset, limit
+	diff, err := s.diffs.Diff(ctx, input)
+	if err != nil {
+		return toDiffJobsError(err), nil
+	}
+	return DiffJobs200JSONResponse(toAPIJobDiff(diff)), nil
+}
+
+func (s *Server) ExportJobDiff(ctx context.Context, req ExportJobDiffRequestObject) (ExportJobDiffResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return ExportJobDiff401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	input := diffInput(req.JobID, u.ID, req.Params.Base, req.Params.Column, req.Params.Change)
+	write, err := s.diffs.DiffCSV(ctx, input)
+	if err != nil {
+		return toExportJobDiffError(err), nil
+	}
+	return diffCSVResponse{
+		write:    write,
+		filename: fmt.Sprintf("diff-%s-%s.csv", req.Params.Base, req.JobID),
+	}, nil
+}
+
+// diffExportWriteTimeout is how long streaming a diff export may take.
+const diffExportWriteTimeout = 10 * time.Minute
+
+// diffCSVResponse streams the CSV as an attachment, which the generated
+// response type has no header for. An error while writing can only cut the
+// response short, since the status has been sent.
+type diffCSVResponse struct {
+	write    func(w io.Writer) error
+	filename string
+}
+
+func (r diffCSVResponse) VisitExportJobDiffResponse(w http.ResponseWriter) error {
+	// Rows are compared as they are written, which can outlast the server's
+	// write timeout on large jobs.
+	err := http.NewResponseController(w).SetWriteDeadline(time.Now().Add(diffExportWriteTimeout))
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", r.filename))
+	w.WriteHeader(http.StatusOK)
+	return r.write(w)
+}
+
+func diffInput(jobID, userID, baseJobID string, columns *[]string, changes *[]DiffChange) services.DiffInput {
+	input := services.DiffInput{
+		JobID:     jobID,
+		BaseJobID: baseJobID,
+		UserID:    userID,
+		Columns:   services.Deref(columns),
+	}
+	for _, c := range services.Deref(changes) {
+		input.Changes = append(input.Changes, models.DiffChange(c))
+	}
+	return input
+}
+
+func toDiffJobsError(err error) DiffJobsResponseObject {
+	var validErr services.ValidationError
+	switch {
+	case errors.Is(err, services.ErrJobNotFound):
+		return DiffJobs404JSONResponse{Message: "Job not found"}
+	case errors.Is(err, services.ErrJobForbidden):
+		return DiffJobs403JSONResponse{Message: "Forbidden"}
+	case errors.As(err, &validErr):
+		return DiffJobs400JSONResponse{Message: validErr.Msg}
+	default:
+		log.Printf("Failed to compare jobs: %v", err)
+		return DiffJobs500JSONResponse{Message: "Failed to compare jobs"}
+	}
+}
+
+func toExportJobDiffError(err error) ExportJobDiffResponseObject {
+	var validErr services.ValidationError
+	switch {
+	case errors.Is(err, services.ErrJobNotFound):
+		return ExportJobDiff404JSONResponse{Message: "Job not found"}
+	case errors.Is(err, services.ErrJobForbidden):
+		return ExportJobDiff403JSONResponse{Message: "Forbidden"}
+	case errors.As(err, &validErr):
+		return ExportJobDiff400JSONResponse{Message: validErr.Msg}
+	default:
+		log.Printf("Failed to export job comparison: %v", err)
+		return ExportJobDiff500JSONResponse{Message: "Failed to export job comparison"}
+	}
+}
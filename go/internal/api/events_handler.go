@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/blagoySimandov/ampledata/go/internal/auth"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/services"
+)
+
+func (s *Server) StreamJobEvents(ctx context.Context, req StreamJobEventsRequestObject) (StreamJobEventsResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return StreamJobEvents401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	lastEventID, err := parseLastEventID(req.Params.LastEventID, req.Params.LastEventId)
+	if err != nil {
+		return StreamJobEvents400JSONResponse{Message: err.Error()}, nil
+	}
+	stream, err := s.rowEvents.OpenStream(ctx, req.JobID, u.ID)
+	switch {
+	case err == nil:
+		return rowEventStreamResponse{ctx: ctx, stream: stream, lastEventID: lastEventID}, nil
+	case errors.Is(err, services.ErrJobNotFound):
+		return StreamJobEvents404JSONResponse{Message: "Job not found"}, nil
+	case errors.Is(err, services.ErrJobForbidden):
+		return StreamJobEvents403JSONResponse{Message: "Forbidden"}, nil
+	default:
+		log.Printf("Failed to open event stream: %v", err)
+		return StreamJobEvents500JSONResponse{Message: "Failed to open event stream"}, nil
+	}
+}
+
+// parseLastEventID reads the ID a reconnecting client resumes after. The
+// header EventSource sends on reconnects takes precedence.
+func parseLastEventID(header, query *string) (int64, error) {
+	raw := services.Deref(header)
+	if raw == "" {
+		raw = services.Deref(query)
+	}
+	if raw == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || id < 0 {
+		return 0, fmt.Errorf("invalid last event ID %q", raw)
+	}
+	return id, nil
+}
+
+// rowEventStreamResponse writes the stream as Server-Sent Events until the
+// client disconnects.
+type rowEventStreamResponse struct {
+	ctx         context.Context
+	stream      *services.RowEventStream
+	lastEventID int64
+}
+
+func (r rowEventStreamResponse) VisitStreamJobEventsResponse(w http.ResponseWriter) error {
+	defer r.stream.Close()
+	rc := http.NewResponseController(w)
+	// The stream outlives the server's write timeout.
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		return err
+	}
+
+	send := func(event *models.RowEvent) error {
+		data, err := json.Marshal(event)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintf(w, "id: %d\nevent: row\ndata: %s\n\n", event.ID, data); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+	heartbeat := func() error {
+		if _, err := fmt.Fprint(w, ": heartbeat\n\n"); err != nil {
+			return err
+		}
+		return rc.Flush()
+	}
+	// The response has started, so errors can only end the stream.
+	if err := r.stream.Run(r.ctx, r.lastEventID, send, heartbeat); err != nil && r.ctx.Err() == nil {
+		log.Printf("Event stream ended: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/blagoySimandov/ampledata/go/internal/auth"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/services"
+	"github.com/google/uuid"
+)
+
+func (s *Server) CreateJobExport(ctx context.Context, req CreateJobExportRequestObject) (CreateJobExportResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return CreateJobExport401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	format := models.ExportFormatCSV
+	if req.Body.Format != nil {
+		format = models.ExportFormat(*req.Body.Format)
+	}
+	opts := models.ExportOptions{
+		IncludeConfidence: services.Deref(req.Body.IncludeConfidence),
+		IncludeSources:    services.Deref(req.Body.IncludeSources),
+		IncludeCitations:  services.Deref(req.Body.IncludeCitations),
+		IncludeError:      services.Deref(req.Body.IncludeError),
+	}
+	export, err := s.exportService.CreateExport(ctx, req.JobID, u.ID, format, opts)
+	if err != nil {
+		return toCreateJobExportError(err), nil
+	}
+	return CreateJobExport202JSONResponse(toAPIExport(&services.ExportDownload{Export: export})), nil
+}
+
+func (s *Server) ListJobExports(ctx context.Context, req ListJobExportsRequestObject) (ListJobExportsResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return ListJobExports401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	exports, err := s.exportService.ListExports(ctx, req.JobID, u.ID)
+	if err != nil {
+		return toListJobExportsError(err), nil
+	}
+	resp := ExportListResponse{Exports: make([]ExportResponse, len(exports))}
+	for i, e := range exports {
+		resp.Exports[i] = toAPIExport(&services.ExportDownload{Export: e})
+	}
+	return ListJobExports200JSONResponse(resp), nil
+}
+
+func (s *Server) GetExport(ctx context.Context, req GetExportRequestObject) (GetExportResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return GetExport401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	download, err := s.exportService.GetExport(ctx, uuid.UUID(req.ExportID), u.ID)
+	if err != nil {
+		return toGetExportError(err), nil
+	}
+	return GetExport200JSONResponse(toAPIExport(download)), nil
+}
+
+func toCreateJobExportError(err error) CreateJobExportResponseObject {
+	var validErr services.ValidationError
+	switch {
+	case errors.Is(err, services.ErrJobNotFound):
+		return CreateJobExport404JSONResponse{Message: "Job not found"}
+	case errors.Is(err, services.ErrJobForbidden):
+		return CreateJobExport403JSONResponse{Message: "Forbidden"}
+	case errors.As(err, &validErr):
+		return CreateJobExport400JSONResponse{Message: validErr.Msg}
+	default:
+		log.Printf("Failed to create export: %v", err)
+		return CreateJobExport500JSONResponse{Message: "Failed to start export"}
+	}
+}
+
+func toListJobExportsError(err error) ListJobExportsResponseObject {
+	switch {
+	case errors.Is(err, services.ErrJobNotFound):
+		return ListJobExports404JSONResponse{Message: "Job not found"}
+	case errors.Is(err, services.ErrJobForbidden):
+		return ListJobExports403JSONResponse{Message: "Forbidden"}
+	default:
+		log.Printf("Failed to list exports: %v", err)
+		return ListJobExports500JSONResponse{Message: "Failed to list exports"}
+	}
+}
+
+func toGetExportError(err error) GetExportResponseObject {
+	switch {
+	case errors.Is(err, services.ErrExportNotFound):
+		return GetExport404JSONResponse{Message: "Export not found"}
+	case errors.Is(err, services.ErrJobForbidden):
+		return GetExport403JSONResponse{Message: "Forbidden"}
+	default:
+		log.Printf("Failed to get export: %v", err)
+		return GetExport500JSONResponse{Message: "Failed to get export"}
+	}
+}
@@ -2,7 +2,12 @@ package api
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 
+	"github.com/blagoySimandov/ampledata/go/internal/services"
 	"github.com/blagoySimandov/ampledata/go/internal/state"
 )
 
@@ -41,7 +46,10 @@ func (s *Server) GetJobResults(ctx context.Context, req GetJobResultsRequestObje
 }
 
 func (s *Server) GetRowsProgress(ctx context.Context, req GetRowsProgressRequestObject) (GetRowsProgressResponseObject, error) {
-	params := parseRowsParams(req.Params)
+	params, err := parseRowsParams(req.Params)
+	if err != nil {
+		return GetRowsProgress400JSONResponse{Message: err.Error()}, nil
+	}
 	response, err := s.enricher.GetRowsProgress(ctx, req.JobID, params)
 	if err != nil {
 		return GetRowsProgress500JSONResponse{Message: err.Error()}, nil
@@ -49,7 +57,7 @@ func (s *Server) GetRowsProgress(ctx context.Context, req GetRowsProgressRequest
 	return GetRowsProgress200JSONResponse(toAPIRowsProgressResponse(response)), nil
 }
 
-func parseRowsParams(p GetRowsProgressParams) state.RowsQueryParams {
+func parseRowsParams(p GetRowsProgressParams) (state.RowsQueryParams, error) {
 	offset, limit := 0, 50
 	stage, sort := "all", "updated_at_desc"
 	if p.Offset != nil {
@@ -70,5 +78,63 @@ func parseRowsParams(p GetRowsProgressParams) state.RowsQueryParams {
 	if p.Sort != nil {
 		sort = *p.Sort
 	}
-	return state.RowsQueryParams{Offset: offset, Limit: limit, Stage: stage, Sort: sort}
+	filters, err := parseRowFilters(p)
+	if err != nil {
+		return state.RowsQueryParams{}, err
+	}
+	return state.RowsQueryParams{Offset: offset, Limit: limit, Stage: stage, Sort: sort, Filters: filters}, nil
+}
+
+func parseRowFilters(p GetRowsProgressParams) (state.RowFilters, error) {
+	f := state.RowFilters{
+		Present:       services.Deref(p.Present),
+		Missing:       services.Deref(p.Missing),
+		ErrorContains: services.Deref(p.ErrorContains),
+		KeyContains:   services.Deref(p.KeyContains),
+		MinAttempts:   p.MinAttempts,
+		MaxAttempts:   p.MaxAttempts,
+	}
+	for _, raw := range services.Deref(p.Confidence) {
+		r, err := parseConfidenceRange(raw)
+		if err != nil {
+			return state.RowFilters{}, err
+		}
+		f.Confidence = append(f.Confidence, r)
+	}
+	if f.MinAttempts != nil && f.MaxAttempts != nil && *f.MinAttempts > *f.MaxAttempts {
+		return state.RowFilters{}, errors.New("min_attempts must not exceed max_attempts")
+	}
+	return f, nil
+}
+
+// parseConfidenceRange reads a confidence filter written as column:min..max,
+// where either bound may be left out.
+func parseConfidenceRange(raw string) (state.ConfidenceRange, error) {
+	invalid := fmt.Errorf("invalid confidence filter %q, want column:min..max", raw)
+	sep := strings.LastIndex(raw, ":")
+	if sep <= 0 {
+		return state.ConfidenceRange{}, invalid
+	}
+	lo, hi, ok := strings.Cut(raw[sep+1:], "..")
+	if !ok {
+		return state.ConfidenceRange{}, invalid
+	}
+	r := state.ConfidenceRange{Column: raw[:sep]}
+	for _, bound := range []struct {
+		text  string
+		value **float64
+	}{{lo, &r.Min}, {hi, &r.Max}} {
+		if bound.text == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(bound.text, 64)
+		if err != nil || v < 0 || v > 1 {
+			return state.ConfidenceRange{}, fmt.Errorf("confidence bound %q must be a number between 0 and 1", bound.text)
+		}
+		*bound.value = &v
+	}
+	if r.Min != nil && r.Max != nil && *r.Min >= *r.Max {
+		return state.ConfidenceRange{}, fmt.Errorf("confidence filter %q has an empty range", raw)
+	}
+	return r, nil
 }
@@ -21,23 +21,19 @@ func (s *Server) SelectKey(ctx context.Context, req SelectKeyRequestObject) (Sel
 	if source.UserID != u.ID {
 		return SelectKey403JSONResponse{Message: "Forbidden: You do not own this source"}, nil
 	}
-	csvMeta, ok := source.Metadata.(*models.CSVSourceMetadata)
-	if !ok {
-		return SelectKey500JSONResponse{Message: "Source metadata not found"}, nil
-	}
-	return s.selectKeyForJob(ctx, csvMeta.FileURI, req.Body.ColumnsMetadata)
+	return s.selectKeyForJob(ctx, source, req.Body.ColumnsMetadata)
 }
 
-func (s *Server) selectKeyForJob(ctx context.Context, filePath string, meta *[]ColumnMetadata) (SelectKeyResponseObject, error) {
-	csvResult, err := s.gcsReader.ReadCSV(ctx, filePath)
+func (s *Server) selectKeyForJob(ctx context.Context, source *models.Source, meta *[]ColumnMetadata) (SelectKeyResponseObject, error) {
+	headers, err := s.sourcesService.SourceHeaders(ctx, source)
 	if err != nil {
-		log.Printf("Failed to read CSV file: %v", err)
-		return SelectKey500JSONResponse{Message: "Failed to read CSV file"}, nil
+		log.Printf("Failed to read source file: %v", err)
+		return SelectKey500JSONResponse{Message: "Failed to read source file"}, nil
 	}
-	if len(csvResult.Headers) == 0 {
-		return SelectKey400JSONResponse{Message: "No headers found in CSV file"}, nil
+	if len(headers) == 0 {
+		return SelectKey400JSONResponse{Message: "No headers found in source file"}, nil
 	}
-	result, err := s.keySelector.SelectBestKey(ctx, csvResult.Headers, toModelColumnMetadataSlicePtr(meta))
+	result, err := s.keySelector.SelectBestKey(ctx, headers, toModelColumnMetadataSlicePtr(meta))
 	if err != nil {
 		log.Printf("Failed to select key: %v", err)
 		return SelectKey500JSONResponse{Message: "Failed to select key"}, nil
@@ -0,0 +1,67 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/blagoySimandov/ampledata/go/internal/auth"
+	"github.com/blagoySimandov/ampledata/go/internal/services"
+	"github.com/blagoySimandov/ampledata/go/internal/user"
+)
+
+// lookupWriteTimeout is how long writing a lookup's response may take once
+// the lookup is done.
+const lookupWriteTimeout = 15 * time.Second
+
+func (s *Server) LookupEntity(ctx context.Context, req LookupEntityRequestObject) (LookupEntityResponseObject, error) {
+	return lookupResponse{s.lookupEntity(ctx, req)}, nil
+}
+
+func (s *Server) lookupEntity(ctx context.Context, req LookupEntityRequestObject) LookupEntityResponseObject {
+	if _, ok := auth.GetUserFromContext(ctx); !ok {
+		return LookupEntity401JSONResponse{Message: "Unauthorized"}
+	}
+	dbUser, ok := user.GetDBUserFromContext(ctx)
+	if !ok {
+		return LookupEntity500JSONResponse{Message: "User not found"}
+	}
+	result, err := s.lookups.Lookup(ctx, services.LookupInput{
+		DBUser:               dbUser,
+		Key:                  req.Body.Key,
+		KeyColumnDescription: req.Body.KeyColumnDescription,
+		ColumnsMetadata:      toModelColumnMetadataSlice(req.Body.ColumnsMetadata),
+		Locale:               req.Body.Locale,
+		Timeout:              time.Duration(services.Deref(req.Body.TimeoutSeconds)) * time.Second,
+	})
+	var validErr services.ValidationError
+	switch {
+	case err == nil:
+		return LookupEntity200JSONResponse(toAPILookup(result))
+	case errors.As(err, &validErr):
+		return LookupEntity400JSONResponse{Message: validErr.Msg}
+	case errors.Is(err, services.ErrInsufficientCredits):
+		return LookupEntity402JSONResponse{Message: "Insufficient credits to run this lookup"}
+	case errors.Is(err, services.ErrLookupTimeout):
+		return LookupEntity504JSONResponse{Message: "Lookup did not finish in time"}
+	default:
+		log.Printf("Failed to look up entity: %v", err)
+		return LookupEntity500JSONResponse{Message: "Failed to look up entity"}
+	}
+}
+
+// lookupResponse moves the write deadline past the server's write timeout,
+// which a lookup can outlast, before writing the response.
+type lookupResponse struct {
+	LookupEntityResponseObject
+}
+
+func (r lookupResponse) VisitLookupEntityResponse(w http.ResponseWriter) error {
+	err := http.NewResponseController(w).SetWriteDeadline(time.Now().Add(lookupWriteTimeout))
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	return r.LookupEntityResponseObject.VisitLookupEntityResponse(w)
+}
@@ -0,0 +1,63 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/blagoySimandov/ampledata/go/internal/auth"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/services"
+	"github.com/blagoySimandov/ampledata/go/internal/user"
+)
+
+func (s *Server) RerunJobRows(ctx context.Context, req RerunJobRowsRequestObject) (RerunJobRowsResponseObject, error) {
+	if _, ok := auth.GetUserFromContext(ctx); !ok {
+		return RerunJobRows401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	dbUser, ok := user.GetDBUserFromContext(ctx)
+	if !ok {
+		return RerunJobRows500JSONResponse{Message: "User not found"}, nil
+	}
+	var merge models.MergeMode
+	if req.Body.Merge != nil {
+		merge = models.MergeMode(*req.Body.Merge)
+	}
+	var columns []string
+	if req.Body.Columns != nil {
+		columns = *req.Body.Columns
+	}
+	result, err := s.reruns.Rerun(ctx, services.RerunInput{
+		JobID:        req.JobID,
+		DBUser:       dbUser,
+		RowKeys:      req.Body.RowKeys,
+		Columns:      columns,
+		Instructions: services.Deref(req.Body.Instructions),
+		Merge:        merge,
+	})
+	if err != nil {
+		return toRerunJobRowsError(err), nil
+	}
+	return RerunJobRows202JSONResponse{
+		JobId:   result.JobID,
+		Rows:    result.Rows,
+		Credits: result.Credits,
+	}, nil
+}
+
+func toRerunJobRowsError(err error) RerunJobRowsResponseObject {
+	var validErr services.ValidationError
+	switch {
+	case errors.Is(err, services.ErrJobNotFound):
+		return RerunJobRows404JSONResponse{Message: "Job not found"}
+	case errors.Is(err, services.ErrJobForbidden):
+		return RerunJobRows403JSONResponse{Message: "Forbidden"}
+	case errors.Is(err, services.ErrInsufficientCredits):
+		return RerunJobRows402JSONResponse{Message: "Insufficient credits to re-run these cells"}
+	case errors.As(err, &validErr):
+		return RerunJobRows400JSONResponse{Message: validErr.Msg}
+	default:
+		log.Printf("Failed to re-run rows: %v", err)
+		return RerunJobRows500JSONResponse{Message: "Failed to re-run rows"}
+	}
+}
@@ -3,13 +3,17 @@ package api
 
 import (
 	"net/http"
+	"strings"
 
 	"github.com/blagoySimandov/ampledata/go/internal/auth"
+	"github.com/blagoySimandov/ampledata/go/internal/blob"
 	"github.com/blagoySimandov/ampledata/go/internal/user"
 	"github.com/gorilla/mux"
 )
 
-func SetupRoutes(server *Server, jwtVerifier *auth.JWTVerifier, userService user.Service, staticDir string) *mux.Router {
+// SetupRoutes builds the API router. uploadHandler, when set, serves signed
+// upload and download URLs of the local blob store.
+func SetupRoutes(server *Server, jwtVerifier *auth.JWTVerifier, apiKeys auth.APIKeyAuthenticator, userService user.Service, staticDir string, uploadHandler http.Handler) *mux.Router {
 	mainRouter := mux.NewRouter()
 	mainRouter.Use(CORSMiddleware().Handler)
 	mainRouter.Use(LoggingMiddleware)
@@ -26,9 +30,12 @@ func SetupRoutes(server *Server, jwtVerifier *auth.JWTVerifier, userService user
 	mainRouter.HandleFunc("/api/v1/webhooks/stripe", webhookWrapper.HandleStripeWebhook).Methods("POST")
 	mainRouter.HandleFunc("/openapi.json", serveOpenAPISpec).Methods("GET")
 	mainRouter.PathPrefix("/swagger/").Handler(http.StripPrefix("/swagger/", swaggerUIHandler()))
+	if uploadHandler != nil {
+		mainRouter.PathPrefix(blob.LocalUploadPath).Handler(http.StripPrefix(strings.TrimSuffix(blob.LocalUploadPath, "/"), uploadHandler))
+	}
 
 	protectedRouter := mux.NewRouter()
-	protectedRouter.Use(auth.Middleware(jwtVerifier))
+	protectedRouter.Use(auth.Middleware(jwtVerifier, apiKeys))
 	protectedRouter.Use(user.UserMiddleware(userService))
 	HandlerFromMuxWithBaseURL(strictHandler, protectedRouter, "/api/v1")
 
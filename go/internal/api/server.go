@@ -11,6 +11,14 @@ type Server struct {
 	billing        BillingService
 	keySelector    KeySelector
 	sourcesService SourcesService
+	exportService  ExportService
+	corrections    CorrectionService
+	reruns         RerunService
+	diffs          DiffService
+	webhooks       WebhookService
+	rowEvents      RowEventService
+	apiKeys        APIKeyService
+	lookups        LookupService
 }
 
 func NewServer(
@@ -22,6 +30,14 @@ func NewServer(
 	keySelector KeySelector,
 	sourcesService SourcesService,
 	templatesRepo ITemplateRepo,
+	exportService ExportService,
+	corrections CorrectionService,
+	reruns RerunService,
+	diffs DiffService,
+	webhooks WebhookService,
+	rowEvents RowEventService,
+	apiKeys APIKeyService,
+	lookups LookupService,
 ) *Server {
 	return &Server{
 		enricher:       enr,
@@ -32,5 +48,13 @@ func NewServer(
 		keySelector:    keySelector,
 		sourcesService: sourcesService,
 		templatesRepo:  templatesRepo,
+		exportService:  exportService,
+		corrections:    corrections,
+		reruns:         reruns,
+		diffs:          diffs,
+		webhooks:       webhooks,
+		rowEvents:      rowEvents,
+		apiKeys:        apiKeys,
+		lookups:        lookups,
 	}
 }
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"github.com/blagoySimandov/ampledata/go/internal/gcs"
@@ -17,7 +18,7 @@ type ITemplateRepo interface {
 }
 
 type IEnricher interface {
-	Enrich(ctx context.Context, jobID, userID, stripeCustomerID string, rowKeys []string, columnsMetadata []*models.ColumnMetadata, keyColumnDescription *string) error
+	Enrich(ctx context.Context, jobID, userID, stripeCustomerID string, rowKeys []string, columnsMetadata []*models.ColumnMetadata, keyColumnDescription *string, locale string, contextColumns []string) error
 	GetProgress(ctx context.Context, jobID string) (*models.JobProgress, error)
 	Cancel(ctx context.Context, jobID string) error
 	GetResults(ctx context.Context, jobID string, offset, limit int) ([]*models.EnrichmentResult, error)
@@ -31,7 +32,7 @@ type Store interface {
 	GetJobsBySource(ctx context.Context, sourceID uuid.UUID) ([]*models.Job, error)
 	CreatePendingJob(ctx context.Context, jobID, userID string, sourceID uuid.UUID, templateID *uuid.UUID) error
 	GetJob(ctx context.Context, jobID string) (*models.Job, error)
-	UpdateJobConfiguration(ctx context.Context, jobID string, keyColumns []string, columnsMetadata []*models.ColumnMetadata, keyColumnDescription *string) error
+	UpdateJobConfiguration(ctx context.Context, jobID string, keyColumns, contextColumns []string, columnsMetadata []*models.ColumnMetadata, keyColumnDescription, locale *string) error
 	StartJob(ctx context.Context, jobID string, totalRows int) error
 	GetJobsByUser(ctx context.Context, userID string, offset, limit int) ([]*models.Job, error)
 	BulkCreateRows(ctx context.Context, jobID string, rowKeys []string) error
@@ -80,10 +81,60 @@ type KeySelector interface {
 	SelectBestKey(ctx context.Context, headers []string, columnsMetadata []*models.ColumnMetadata) (*services.KeySelectorResult, error)
 }
 
+type ExportService interface {
+	CreateExport(ctx context.Context, jobID, userID string, format models.ExportFormat, opts models.ExportOptions) (*models.Export, error)
+	GetExport(ctx context.Context, exportID uuid.UUID, userID string) (*services.ExportDownload, error)
+	ListExports(ctx context.Context, jobID, userID string) ([]*models.Export, error)
+}
+
+type CorrectionService interface {
+	CorrectCell(ctx context.Context, input services.CellCorrectionInput) (*models.CellCorrection, *models.RowState, error)
+	ListCorrections(ctx context.Context, jobID, userID, rowKey string) ([]*models.CellCorrection, error)
+}
+
+type RerunService interface {
+	Rerun(ctx context.Context, input services.RerunInput) (*services.RerunResult, error)
+}
+
+type DiffService interface {
+	Diff(ctx context.Context, input services.DiffInput) (*models.JobDiff, error)
+	DiffCSV(ctx context.Context, input services.DiffInput) (func(w io.Writer) error, error)
+}
+
+type WebhookService interface {
+	CreateEndpoint(ctx context.Context, userID, endpointURL string, events []models.WebhookEventType) (*models.WebhookEndpoint, error)
+	ListEndpoints(ctx context.Context, userID string) ([]*models.WebhookEndpoint, error)
+	DeleteEndpoint(ctx context.Context, endpointID uuid.UUID, userID string) error
+	ListDeliveries(ctx context.Context, endpointID uuid.UUID, userID, jobID string, offset, limit int) ([]*models.WebhookDelivery, int, error)
+}
+
+type APIKeyService interface {
+	CreateKey(ctx context.Context, userID, name string, scopes []models.APIKeyScope, expiresAt *time.Time) (*models.APIKey, string, error)
+	ListKeys(ctx context.Context, userID string) ([]*models.APIKey, error)
+	RevokeKey(ctx context.Context, keyID uuid.UUID, userID string) error
+}
+
+type LookupService interface {
+	Lookup(ctx context.Context, input services.LookupInput) (*models.EntityLookupResult, error)
+}
+
+type RowEventService interface {
+	OpenStream(ctx context.Context, jobID, userID string) (*services.RowEventStream, error)
+}
+
 type SourcesService interface {
 	ListSources(ctx context.Context, userID string, offset, limit int) ([]*services.SourceWithJobs, error)
 	GetSource(ctx context.Context, sourceID uuid.UUID, userID string) (*services.SourceWithJobs, error)
-	GetSourceData(ctx context.Context, sourceID uuid.UUID, userID string) (*gcs.CSVResult, error)
+	GetSourceData(ctx context.Context, sourceID uuid.UUID, userID string, offset, limit int) (*gcs.SourcePage, error)
 	EnrichSource(ctx context.Context, input services.EnrichSourceInput) (string, error)
-	CreateUploadSource(ctx context.Context, userID, contentType string, headers []string) (uuid.UUID, string, error)
+	CreateUploadSource(ctx context.Context, userID, contentType string, headers []string, sheet services.SheetSelection) (uuid.UUID, string, error)
+	ListSourceSheets(ctx context.Context, sourceID uuid.UUID, userID string) (*services.SourceSheets, error)
+	SelectSourceSheet(ctx context.Context, sourceID uuid.UUID, userID string, selection services.SheetSelection) (*services.SourceSheets, error)
+	GetSourceDialect(ctx context.Context, sourceID uuid.UUID, userID string) (*models.CSVDialect, error)
+	SetSourceDialect(ctx context.Context, sourceID uuid.UUID, userID string, override *services.DialectOverride) (*models.CSVDialect, error)
+	SourceHeaders(ctx context.Context, source *models.Source) ([]string, error)
+	ListConnections(userID string) []string
+	CreateSQLSource(ctx context.Context, userID string, input services.SQLSourceInput) (uuid.UUID, error)
+	CreateSourceRevision(ctx context.Context, sourceID uuid.UUID, userID, contentType string) (int, string, error)
+	ConfirmSourceRevision(ctx context.Context, sourceID uuid.UUID, userID string, revision int) error
 }
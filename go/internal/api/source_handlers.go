@@ -17,6 +17,8 @@ import (
 var WHITELISTED_CONTENT_TYPES = []SignedURLRequestContentType{
 	Textcsv,
 	Applicationjson,
+	ApplicationxNdjson,
+	ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheet,
 }
 
 func (s *Server) UploadFileForEnrichment(ctx context.Context, req UploadFileForEnrichmentRequestObject) (UploadFileForEnrichmentResponseObject, error) {
@@ -30,11 +32,15 @@ func (s *Server) UploadFileForEnrichment(ctx context.Context, req UploadFileForE
 	if req.Body.Length <= 0 {
 		return UploadFileForEnrichment400JSONResponse{Message: "invalid length"}, nil
 	}
+	if req.Body.HeaderRow != nil && *req.Body.HeaderRow < 1 {
+		return UploadFileForEnrichment400JSONResponse{Message: "headerRow must be at least 1"}, nil
+	}
 	var headers []string
 	if req.Body.Headers != nil {
 		headers = *req.Body.Headers
 	}
-	sourceID, url, err := s.sourcesService.CreateUploadSource(ctx, u.ID, string(req.Body.ContentType), headers)
+	sheet := services.SheetSelection{SheetName: services.Deref(req.Body.SheetName), HeaderRow: services.Deref(req.Body.HeaderRow)}
+	sourceID, url, err := s.sourcesService.CreateUploadSource(ctx, u.ID, string(req.Body.ContentType), headers, sheet)
 	if err != nil {
 		log.Printf("Failed to create upload source: %v", err)
 		return UploadFileForEnrichment500JSONResponse{Message: "Failed to create source"}, nil
@@ -42,6 +48,36 @@ func (s *Server) UploadFileForEnrichment(ctx context.Context, req UploadFileForE
 	return UploadFileForEnrichment200JSONResponse{Url: url, SourceId: sourceID}, nil
 }
 
+func (s *Server) CreateSQLSource(ctx context.Context, req CreateSQLSourceRequestObject) (CreateSQLSourceResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok || u == nil {
+		return CreateSQLSource401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	input := services.SQLSourceInput{
+		Connection:     req.Body.Connection,
+		Query:          req.Body.Query,
+		Name:           services.Deref(req.Body.Name),
+		WriteBackTable: services.Deref(req.Body.WriteBackTable),
+	}
+	sourceID, err := s.sourcesService.CreateSQLSource(ctx, u.ID, input)
+	if err != nil {
+		return toCreateSQLSourceError(err), nil
+	}
+	return CreateSQLSource201JSONResponse{SourceId: sourceID}, nil
+}
+
+func (s *Server) ListSourceConnections(ctx context.Context, req ListSourceConnectionsRequestObject) (ListSourceConnectionsResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok || u == nil {
+		return ListSourceConnections401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	connections := s.sourcesService.ListConnections(u.ID)
+	if connections == nil {
+		connections = []string{}
+	}
+	return ListSourceConnections200JSONResponse{Connections: connections}, nil
+}
+
 func (s *Server) ListSources(ctx context.Context, req ListSourcesRequestObject) (ListSourcesResponseObject, error) {
 	u, ok := auth.GetUserFromContext(ctx)
 	if !ok {
@@ -73,11 +109,113 @@ func (s *Server) GetSourceData(ctx context.Context, req GetSourceDataRequestObje
 	if !ok {
 		return GetSourceData401JSONResponse{Message: "Unauthorized"}, nil
 	}
-	result, err := s.sourcesService.GetSourceData(ctx, uuid.UUID(req.SourceID), u.ID)
+	offset, limit := paginationParams(req.Params.Offset, req.Params.Limit, 1000)
+	page, err := s.sourcesService.GetSourceData(ctx, uuid.UUID(req.SourceID), u.ID, offset, limit)
 	if err != nil {
 		return toGetSourceDataError(err), nil
 	}
-	return GetSourceData200JSONResponse{Headers: result.Headers, Rows: result.Rows}, nil
+	return GetSourceData200JSONResponse{Headers: page.Headers, Rows: page.Rows, Offset: page.Offset, HasMore: page.HasMore}, nil
+}
+
+func (s *Server) CreateSourceRevision(ctx context.Context, req CreateSourceRevisionRequestObject) (CreateSourceRevisionResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return CreateSourceRevision401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	if !slices.Contains(WHITELISTED_CONTENT_TYPES, SignedURLRequestContentType(req.Body.ContentType)) {
+		return CreateSourceRevision400JSONResponse{Message: fmt.Sprintf("invalid content type: %s", req.Body.ContentType)}, nil
+	}
+	if req.Body.Length <= 0 {
+		return CreateSourceRevision400JSONResponse{Message: "invalid length"}, nil
+	}
+	revision, url, err := s.sourcesService.CreateSourceRevision(ctx, uuid.UUID(req.SourceID), u.ID, req.Body.ContentType)
+	if err != nil {
+		return toCreateSourceRevisionError(err), nil
+	}
+	return CreateSourceRevision200JSONResponse{Url: url, Revision: revision}, nil
+}
+
+func (s *Server) ConfirmSourceRevision(ctx context.Context, req ConfirmSourceRevisionRequestObject) (ConfirmSourceRevisionResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return ConfirmSourceRevision401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	if err := s.sourcesService.ConfirmSourceRevision(ctx, uuid.UUID(req.SourceID), u.ID, req.Revision); err != nil {
+		return toConfirmSourceRevisionError(err), nil
+	}
+	return ConfirmSourceRevision204Response{}, nil
+}
+
+func (s *Server) ListSourceSheets(ctx context.Context, req ListSourceSheetsRequestObject) (ListSourceSheetsResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return ListSourceSheets401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	result, err := s.sourcesService.ListSourceSheets(ctx, uuid.UUID(req.SourceID), u.ID)
+	if err != nil {
+		return toListSourceSheetsError(err), nil
+	}
+	return ListSourceSheets200JSONResponse(toAPISourceSheets(result)), nil
+}
+
+func (s *Server) SelectSourceSheet(ctx context.Context, req SelectSourceSheetRequestObject) (SelectSourceSheetResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return SelectSourceSheet401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	if req.Body.HeaderRow != nil && *req.Body.HeaderRow < 1 {
+		return SelectSourceSheet400JSONResponse{Message: "header_row must be at least 1"}, nil
+	}
+	selection := services.SheetSelection{SheetName: services.Deref(req.Body.SheetName), HeaderRow: services.Deref(req.Body.HeaderRow)}
+	result, err := s.sourcesService.SelectSourceSheet(ctx, uuid.UUID(req.SourceID), u.ID, selection)
+	if err != nil {
+		return toSelectSourceSheetError(err), nil
+	}
+	return SelectSourceSheet200JSONResponse(toAPISourceSheets(result)), nil
+}
+
+func (s *Server) GetSourceDialect(ctx context.Context, req GetSourceDialectRequestObject) (GetSourceDialectResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return GetSourceDialect401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	dialect, err := s.sourcesService.GetSourceDialect(ctx, uuid.UUID(req.SourceID), u.ID)
+	if err != nil {
+		return toGetSourceDialectError(err), nil
+	}
+	return GetSourceDialect200JSONResponse(toAPICSVDialect(dialect)), nil
+}
+
+func (s *Server) SetSourceDialect(ctx context.Context, req SetSourceDialectRequestObject) (SetSourceDialectResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return SetSourceDialect401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	if req.Body.HeaderRow != nil && *req.Body.HeaderRow < 1 {
+		return SetSourceDialect400JSONResponse{Message: "header_row must be at least 1"}, nil
+	}
+	override := &services.DialectOverride{
+		Delimiter: services.Deref(req.Body.Delimiter),
+		Encoding:  services.Deref(req.Body.Encoding),
+		HeaderRow: services.Deref(req.Body.HeaderRow),
+	}
+	dialect, err := s.sourcesService.SetSourceDialect(ctx, uuid.UUID(req.SourceID), u.ID, override)
+	if err != nil {
+		return toSetSourceDialectError(err), nil
+	}
+	return SetSourceDialect200JSONResponse(toAPICSVDialect(dialect)), nil
+}
+
+func (s *Server) ResetSourceDialect(ctx context.Context, req ResetSourceDialectRequestObject) (ResetSourceDialectResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return ResetSourceDialect401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	dialect, err := s.sourcesService.SetSourceDialect(ctx, uuid.UUID(req.SourceID), u.ID, nil)
+	if err != nil {
+		return toResetSourceDialectError(err), nil
+	}
+	return ResetSourceDialect200JSONResponse(toAPICSVDialect(dialect)), nil
 }
 
 func (s *Server) EnrichSource(ctx context.Context, req EnrichSourceRequestObject) (EnrichSourceResponseObject, error) {
@@ -109,15 +247,27 @@ func buildEnrichInput(req EnrichSourceRequestObject, authUserID string, dbUser *
 		}
 	}
 
+	var contextColumns []string
+	if req.Body.ContextColumns != nil {
+		contextColumns = *req.Body.ContextColumns
+	}
+
 	return services.EnrichSourceInput{
 		SourceID:             uuid.UUID(req.SourceID),
 		AuthUserID:           authUserID,
 		DBUser:               dbUser,
 		KeyColumns:           keyColumns,
+		ContextColumns:       contextColumns,
 		KeyColumnDescription: req.Body.KeyColumnDescription,
+		Locale:               req.Body.Locale,
 		ColumnsMetadata:      toModelColumnMetadataSlice(req.Body.ColumnsMetadata),
 		RowLimit:             req.Body.RowLimit,
 		TemplateID:           templateID,
+		KeyNormalization: services.KeyNormalization{
+			Enabled:        services.Deref(req.Body.NormalizeKeys) || req.Body.FuzzyThreshold != nil,
+			FuzzyThreshold: services.Deref(req.Body.FuzzyThreshold),
+		},
+		Incremental: services.Deref(req.Body.Incremental),
 	}
 }
 
@@ -150,7 +300,121 @@ func toGetSourceDataError(err error) GetSourceDataResponseObject {
 	case errors.Is(err, services.ErrSourceForbidden):
 		return GetSourceData403JSONResponse{Message: "Forbidden"}
 	default:
-		return GetSourceData500JSONResponse{Message: fmt.Sprintf("Failed to read CSV: %v", err)}
+		return GetSourceData500JSONResponse{Message: fmt.Sprintf("Failed to read source: %v", err)}
+	}
+}
+
+func toCreateSQLSourceError(err error) CreateSQLSourceResponseObject {
+	var validErr services.ValidationError
+	if errors.As(err, &validErr) {
+		return CreateSQLSource400JSONResponse{Message: validErr.Msg}
+	}
+	log.Printf("Failed to create SQL source: %v", err)
+	return CreateSQLSource500JSONResponse{Message: "Failed to create source"}
+}
+
+func toCreateSourceRevisionError(err error) CreateSourceRevisionResponseObject {
+	var validErr services.ValidationError
+	switch {
+	case errors.Is(err, services.ErrSourceNotFound):
+		return CreateSourceRevision404JSONResponse{Message: "Source not found"}
+	case errors.Is(err, services.ErrSourceForbidden):
+		return CreateSourceRevision403JSONResponse{Message: "Forbidden"}
+	case errors.As(err, &validErr):
+		return CreateSourceRevision400JSONResponse{Message: validErr.Msg}
+	default:
+		log.Printf("Failed to create source revision: %v", err)
+		return CreateSourceRevision500JSONResponse{Message: "Failed to create revision"}
+	}
+}
+
+func toConfirmSourceRevisionError(err error) ConfirmSourceRevisionResponseObject {
+	var validErr services.ValidationError
+	switch {
+	case errors.Is(err, services.ErrSourceNotFound):
+		return ConfirmSourceRevision404JSONResponse{Message: "Source not found"}
+	case errors.Is(err, services.ErrSourceForbidden):
+		return ConfirmSourceRevision403JSONResponse{Message: "Forbidden"}
+	case errors.As(err, &validErr):
+		return ConfirmSourceRevision400JSONResponse{Message: validErr.Msg}
+	default:
+		log.Printf("Failed to confirm source revision: %v", err)
+		return ConfirmSourceRevision500JSONResponse{Message: "Failed to confirm revision"}
+	}
+}
+
+func toListSourceSheetsError(err error) ListSourceSheetsResponseObject {
+	var validErr services.ValidationError
+	switch {
+	case errors.Is(err, services.ErrSourceNotFound):
+		return ListSourceSheets404JSONResponse{Message: "Source not found"}
+	case errors.Is(err, services.ErrSourceForbidden):
+		return ListSourceSheets403JSONResponse{Message: "Forbidden"}
+	case errors.As(err, &validErr):
+		return ListSourceSheets400JSONResponse{Message: validErr.Msg}
+	default:
+		log.Printf("Failed to list source sheets: %v", err)
+		return ListSourceSheets500JSONResponse{Message: "Failed to read workbook"}
+	}
+}
+
+func toSelectSourceSheetError(err error) SelectSourceSheetResponseObject {
+	var validErr services.ValidationError
+	switch {
+	case errors.Is(err, services.ErrSourceNotFound):
+		return SelectSourceSheet404JSONResponse{Message: "Source not found"}
+	case errors.Is(err, services.ErrSourceForbidden):
+		return SelectSourceSheet403JSONResponse{Message: "Forbidden"}
+	case errors.As(err, &validErr):
+		return SelectSourceSheet400JSONResponse{Message: validErr.Msg}
+	default:
+		log.Printf("Failed to select source sheet: %v", err)
+		return SelectSourceSheet500JSONResponse{Message: "Failed to update sheet selection"}
+	}
+}
+
+func toGetSourceDialectError(err error) GetSourceDialectResponseObject {
+	var validErr services.ValidationError
+	switch {
+	case errors.Is(err, services.ErrSourceNotFound):
+		return GetSourceDialect404JSONResponse{Message: "Source not found"}
+	case errors.Is(err, services.ErrSourceForbidden):
+		return GetSourceDialect403JSONResponse{Message: "Forbidden"}
+	case errors.As(err, &validErr):
+		return GetSourceDialect400JSONResponse{Message: validErr.Msg}
+	default:
+		log.Printf("Failed to get source dialect: %v", err)
+		return GetSourceDialect500JSONResponse{Message: "Failed to read CSV dialect"}
+	}
+}
+
+func toSetSourceDialectError(err error) SetSourceDialectResponseObject {
+	var validErr services.ValidationError
+	switch {
+	case errors.Is(err, services.ErrSourceNotFound):
+		return SetSourceDialect404JSONResponse{Message: "Source not found"}
+	case errors.Is(err, services.ErrSourceForbidden):
+		return SetSourceDialect403JSONResponse{Message: "Forbidden"}
+	case errors.As(err, &validErr):
+		return SetSourceDialect400JSONResponse{Message: validErr.Msg}
+	default:
+		log.Printf("Failed to set source dialect: %v", err)
+		return SetSourceDialect500JSONResponse{Message: "Failed to update CSV dialect"}
+	}
+}
+
+func toResetSourceDialectError(err error) ResetSourceDialectResponseObject {
+	var validErr services.ValidationError
+	switch {
+	case errors.Is(err, services.ErrSourceNotFound):
+		return ResetSourceDialect404JSONResponse{Message: "Source not found"}
+	case errors.Is(err, services.ErrSourceForbidden):
+		return ResetSourceDialect403JSONResponse{Message: "Forbidden"}
+	case errors.As(err, &validErr):
+		return ResetSourceDialect400JSONResponse{Message: validErr.Msg}
+	default:
+		log.Printf("Failed to reset source dialect: %v", err)
+		return ResetSourceDialect500JSONResponse{Message: "Failed to detect CSV dialect"}
 	}
 }
 
@@ -0,0 +1,111 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"log"
+
+	"github.com/blagoySimandov/ampledata/go/internal/auth"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/services"
+	"github.com/google/uuid"
+)
+
+func (s *Server) CreateWebhookEndpoint(ctx context.Context, req CreateWebhookEndpointRequestObject) (CreateWebhookEndpointResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return CreateWebhookEndpoint401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	var events []models.WebhookEventType
+	for _, e := range services.Deref(req.Body.Events) {
+		events = append(events, models.WebhookEventType(e))
+	}
+	endpoint, err := s.webhooks.CreateEndpoint(ctx, u.ID, req.Body.Url, events)
+	if err != nil {
+		var validErr services.ValidationError
+		if errors.As(err, &validErr) {
+			return CreateWebhookEndpoint400JSONResponse{Message: validErr.Msg}, nil
+		}
+		log.Printf("Failed to create webhook endpoint: %v", err)
+		return CreateWebhookEndpoint500JSONResponse{Message: "Failed to create webhook endpoint"}, nil
+	}
+	resp := toAPIWebhookEndpoint(endpoint)
+	resp.Secret = &endpoint.Secret
+	return CreateWebhookEndpoint201JSONResponse(resp), nil
+}
+
+func (s *Server) ListWebhookEndpoints(ctx context.Context, req ListWebhookEndpointsRequestObject) (ListWebhookEndpointsResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return ListWebhookEndpoints401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	endpoints, err := s.webhooks.ListEndpoints(ctx, u.ID)
+	if err != nil {
+		log.Printf("Failed to list webhook endpoints: %v", err)
+		return ListWebhookEndpoints500JSONResponse{Message: "Failed to list webhook endpoints"}, nil
+	}
+	resp := WebhookEndpointListResponse{Endpoints: make([]WebhookEndpointResponse, len(endpoints))}
+	for i, e := range endpoints {
+		resp.Endpoints[i] = toAPIWebhookEndpoint(e)
+	}
+	return ListWebhookEndpoints200JSONResponse(resp), nil
+}
+
+func (s *Server) DeleteWebhookEndpoint(ctx context.Context, req DeleteWebhookEndpointRequestObject) (DeleteWebhookEndpointResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return DeleteWebhookEndpoint401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	err := s.webhooks.DeleteEndpoint(ctx, uuid.UUID(req.EndpointID), u.ID)
+	switch {
+	case err == nil:
+		return DeleteWebhookEndpoint204Response{}, nil
+	case errors.Is(err, services.ErrWebhookNotFound):
+		return DeleteWebhookEndpoint404JSONResponse{Message: "Webhook endpoint not found"}, nil
+	case errors.Is(err, services.ErrJobForbidden):
+		return DeleteWebhookEndpoint403JSONResponse{Message: "Forbidden"}, nil
+	default:
+		log.Printf("Failed to delete webhook endpoint: %v", err)
+		return DeleteWebhookEndpoint500JSONResponse{Message: "Failed to delete webhook endpoint"}, nil
+	}
+}
+
+func (s *Server) ListWebhookDeliveries(ctx context.Context, req ListWebhookDeliveriesRequestObject) (ListWebhookDeliveriesResponseObject, error) {
+	u, ok := auth.GetUserFromContext(ctx)
+	if !ok {
+		return ListWebhookDeliveries401JSONResponse{Message: "Unauthorized"}, nil
+	}
+	offset, limit := paginationParams(req.Params.Offset, req.Params.Limit, 50)
+	if limit <= 0 || limit > 100 {
+		limit = 50
+	}
+	deliveries, total, err := s.webhooks.ListDeliveries(ctx, uuid.UUID(req.EndpointID), u.ID, services.Deref(req.Params.JobId), offset, limit)
+	if err != nil {
+		return toListWebhookDeliveriesError(err), nil
+	}
+	resp := WebhookDeliveryListResponse{
+		Deliveries: make([]WebhookDeliveryResponse, len(deliveries)),
+		Pagination: PaginationInfo{
+			Total:   total,
+			Offset:  offset,
+			Limit:   limit,
+			HasMore: offset+len(deliveries) < total,
+		},
+	}
+	for i, d := range deliveries {
+		resp.Deliveries[i] = toAPIWebhookDelivery(d)
+	}
+	return ListWebhookDeliveries200JSONResponse(resp), nil
+}
+
+func toListWebhookDeliveriesError(err error) ListWebhookDeliveriesResponseObject {
+	switch {
+	case errors.Is(err, services.ErrWebhookNotFound):
+		return ListWebhookDeliveries404JSONResponse{Message: "Webhook endpoint not found"}
+	case errors.Is(err, services.ErrJobForbidden):
+		return ListWebhookDeliveries403JSONResponse{Message: "Forbidden"}
+	default:
+		log.Printf("Failed to list webhook deliveries: %v", err)
+		return ListWebhookDeliveries500JSONResponse{Message: "Failed to list webhook deliveries"}
+	}
+}
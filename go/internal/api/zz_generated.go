@@ -28,14 +28,55 @@ const (
 	BearerAuthScopes = "BearerAuth.Scopes"
 )
 
+// Defines values for APIKeyScope.
+const (
+	Billing  APIKeyScope = "billing"
+	Enrich   APIKeyScope = "enrich"
+	ReadOnly APIKeyScope = "read-only"
+)
+
 // Defines values for ColumnType.
 const (
 	Boolean ColumnType = "boolean"
 	Date    ColumnType = "date"
+	List    ColumnType = "list"
 	Number  ColumnType = "number"
 	String  ColumnType = "string"
 )
 
+// Defines values for DatePrecision.
+const (
+	Day     DatePrecision = "day"
+	Month   DatePrecision = "month"
+	Quarter DatePrecision = "quarter"
+	Week    DatePrecision = "week"
+	Year    DatePrecision = "year"
+)
+
+// Defines values for DiffChange.
+const (
+	Added     DiffChange = "added"
+	Changed   DiffChange = "changed"
+	Removed   DiffChange = "removed"
+	Unchanged DiffChange = "unchanged"
+)
+
+// Defines values for ExportRequestFormat.
+const (
+	Csv     ExportRequestFormat = "csv"
+	Ndjson  ExportRequestFormat = "ndjson"
+	Parquet ExportRequestFormat = "parquet"
+	Xlsx    ExportRequestFormat = "xlsx"
+)
+
+// Defines values for ExportResponseStatus.
+const (
+	ExportResponseStatusCOMPLETED ExportResponseStatus = "COMPLETED"
+	ExportResponseStatusFAILED    ExportResponseStatus = "FAILED"
+	ExportResponseStatusPENDING   ExportResponseStatus = "PENDING"
+	ExportResponseStatusRUNNING   ExportResponseStatus = "RUNNING"
+)
+
 // Defines values for JobStatus.
 const (
 	JobStatusCANCELLED JobStatus = "CANCELLED"
@@ -47,10 +88,18 @@ const (
 
 // Defines values for JobType.
 const (
+	Classify   JobType = "classify"
+	Derived    JobType = "derived"
 	Enrichment JobType = "enrichment"
 	Imputation JobType = "imputation"
 )
 
+// Defines values for RerunRequestMerge.
+const (
+	Best    RerunRequestMerge = "best"
+	Replace RerunRequestMerge = "replace"
+)
+
 // Defines values for RowStage.
 const (
 	RowStageCANCELLED    RowStage = "CANCELLED"
@@ -65,8 +114,10 @@ const (
 
 // Defines values for SignedURLRequestContentType.
 const (
-	Applicationjson SignedURLRequestContentType = "application/json"
-	Textcsv         SignedURLRequestContentType = "text/csv"
+	Applicationjson                                              SignedURLRequestContentType = "application/json"
+	ApplicationvndOpenxmlformatsOfficedocumentSpreadsheetmlSheet SignedURLRequestContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	ApplicationxNdjson                                           SignedURLRequestContentType = "application/x-ndjson"
+	Textcsv                                                      SignedURLRequestContentType = "text/csv"
 )
 
 // Defines values for TemplateType.
@@ -75,17 +126,179 @@ const (
 	UserDefinedTemplate TemplateType = "user_defined_template"
 )
 
+// Defines values for WebhookDeliveryResponseStatus.
+const (
+	WebhookDeliveryResponseStatusDELIVERED WebhookDeliveryResponseStatus = "DELIVERED"
+	WebhookDeliveryResponseStatusFAILED    WebhookDeliveryResponseStatus = "FAILED"
+	WebhookDeliveryResponseStatusPENDING   WebhookDeliveryResponseStatus = "PENDING"
+	WebhookDeliveryResponseStatusRETRYING  WebhookDeliveryResponseStatus = "RETRYING"
+)
+
+// Defines values for WebhookEventType.
+const (
+	JobCancelled WebhookEventType = "job.cancelled"
+	JobCompleted WebhookEventType = "job.completed"
+	JobStarted   WebhookEventType = "job.started"
+	RowFailed    WebhookEventType = "row.failed"
+)
+
+// APIKeyListResponse defines model for APIKeyListResponse.
+type APIKeyListResponse struct {
+	Keys []APIKeyResponse `json:"keys"`
+}
+
+// APIKeyResponse defines model for APIKeyResponse.
+type APIKeyResponse struct {
+	CreatedAt time.Time          `json:"created_at"`
+	ExpiresAt *time.Time         `json:"expires_at"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Key The key, sent as "Authorization: Bearer <key>". Only returned when the key is created; just its hash is stored.
+	Key        *string    `json:"key,omitempty"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	Name       string     `json:"name"`
+
+	// Prefix Start of the key, to identify it
+	Prefix    string        `json:"prefix"`
+	RevokedAt *time.Time    `json:"revoked_at"`
+	Scopes    []APIKeyScope `json:"scopes"`
+}
+
+// APIKeyScope defines model for APIKeyScope.
+type APIKeyScope string
+
+// CSVDialectRequest defines model for CSVDialectRequest.
+type CSVDialectRequest struct {
+	// Delimiter Single field separator character; detected when omitted
+	Delimiter *string `json:"delimiter"`
+
+	// Encoding One of utf-8, utf-16le, utf-16be, windows-1252 or iso-8859-1; detected when omitted
+	Encoding *string `json:"encoding"`
+
+	// HeaderRow 1-based record holding the column headers; detected when omitted
+	HeaderRow *int `json:"header_row"`
+}
+
+// CSVDialectResponse defines model for CSVDialectResponse.
+type CSVDialectResponse struct {
+	// Bom Whether the file starts with a byte order mark
+	Bom       bool   `json:"bom"`
+	Delimiter string `json:"delimiter"`
+	Encoding  string `json:"encoding"`
+
+	// HeaderRow 1-based record holding the column headers
+	HeaderRow int `json:"header_row"`
+
+	// Manual Whether the dialect was set by hand rather than detected
+	Manual bool `json:"manual"`
+}
+
+// CellCorrection defines model for CellCorrection.
+type CellCorrection struct {
+	Column    string             `json:"column"`
+	CreatedAt time.Time          `json:"created_at"`
+	Id        openapi_types.UUID `json:"id"`
+	JobId     string             `json:"job_id"`
+	NewValue  *interface{}       `json:"new_value"`
+	OldValue  *interface{}       `json:"old_value"`
+	RowKey    string             `json:"row_key"`
+	UserId    string             `json:"user_id"`
+}
+
+// CellCorrectionListResponse defines model for CellCorrectionListResponse.
+type CellCorrectionListResponse struct {
+	Corrections []CellCorrection `json:"corrections"`
+}
+
+// CellCorrectionRequest defines model for CellCorrectionRequest.
+type CellCorrectionRequest struct {
+	// Column An enriched column of the job
+	Column string `json:"column"`
+	RowKey string `json:"row_key"`
+
+	// Value The correct value, converted to the column's type; null when the cell has no value
+	Value *interface{} `json:"value"`
+}
+
+// CellCorrectionResponse defines model for CellCorrectionResponse.
+type CellCorrectionResponse struct {
+	Correction CellCorrection  `json:"correction"`
+	Row        RowProgressItem `json:"row"`
+}
+
+// CellDiff defines model for CellDiff.
+type CellDiff struct {
+	BaseConfidence *float64 `json:"base_confidence,omitempty"`
+
+	// BaseValue Value in the base job
+	BaseValue  *interface{} `json:"base_value"`
+	Change     DiffChange   `json:"change"`
+	Column     string       `json:"column"`
+	Confidence *float64     `json:"confidence,omitempty"`
+
+	// ConfidenceDelta Compared job's score minus the base job's, when both scored the cell
+	ConfidenceDelta *float64 `json:"confidence_delta,omitempty"`
+
+	// Value Value in the compared job
+	Value *interface{} `json:"value"`
+}
+
+// Citation defines model for Citation.
+type Citation struct {
+	// Quote Passage of the page supporting the value
+	Quote string `json:"quote"`
+
+	// Url Page the value was taken from
+	Url string `json:"url"`
+
+	// Verified Whether the quote was found in the page content fetched for the row
+	Verified bool `json:"verified"`
+}
+
+// ColumnDiffSummary defines model for ColumnDiffSummary.
+type ColumnDiffSummary struct {
+	Added               int      `json:"added"`
+	Changed             int      `json:"changed"`
+	Column              string   `json:"column"`
+	MeanConfidenceDelta *float64 `json:"mean_confidence_delta,omitempty"`
+	Removed             int      `json:"removed"`
+	Unchanged           int      `json:"unchanged"`
+}
+
 // ColumnMetadata defines model for ColumnMetadata.
 type ColumnMetadata struct {
-	Description *string    `json:"description"`
-	JobType     JobType    `json:"job_type"`
-	Name        string     `json:"name"`
-	Type        ColumnType `json:"type"`
+	Description *string `json:"description"`
+
+	// Expression Required for derived columns. Computed from the row's other
+	// columns once enrichment completes, e.g.
+	// "revenue / employee_count" or "year(today()) - founded_year".
+	// Use [Column Name] for names that are not plain identifiers.
+	Expression *string `json:"expression"`
+
+	// InputColumns Required for classify columns. Source columns whose values are
+	// sent to the model, e.g. ["description"] to categorize an industry.
+	// Classify columns skip web search and cost less per cell.
+	InputColumns *[]string  `json:"input_columns"`
+	JobType      JobType    `json:"job_type"`
+	Name         string     `json:"name"`
+	Type         ColumnType `json:"type"`
 }
 
 // ColumnType defines model for ColumnType.
 type ColumnType string
 
+// CreateAPIKeyRequest defines model for CreateAPIKeyRequest.
+type CreateAPIKeyRequest struct {
+	// ExpiresAt When the key stops working. Keys without one do not expire.
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+
+	// Name Label telling the key apart from the user's others
+	Name string `json:"name"`
+
+	// Scopes What the key may do. Defaults to read-only.
+	Scopes *[]APIKeyScope `json:"scopes,omitempty"`
+}
+
 // CreateCheckoutResponse defines model for CreateCheckoutResponse.
 type CreateCheckoutResponse struct {
 	CheckoutUrl string `json:"checkout_url"`
@@ -99,18 +312,62 @@ type CreateSubscriptionRequest struct {
 	TierId     string `json:"tier_id"`
 }
 
+// CreateWebhookEndpointRequest defines model for CreateWebhookEndpointRequest.
+type CreateWebhookEndpointRequest struct {
+	// Events Events to receive. Defaults to all of them.
+	Events *[]WebhookEventType `json:"events,omitempty"`
+
+	// Url HTTPS URL events are posted to
+	Url string `json:"url"`
+}
+
+// DatePrecision defines model for DatePrecision.
+type DatePrecision string
+
+// DiffChange defines model for DiffChange.
+type DiffChange string
+
 // EnrichRequest defines model for EnrichRequest.
 type EnrichRequest struct {
 	ColumnsMetadata []ColumnMetadata `json:"columns_metadata"`
 
+	// ContextColumns Source columns (e.g. city, website, industry) whose values are
+	// passed along with each row to disambiguate the entity. They are
+	// included in the search and extraction prompts and can be used as
+	// placeholders in query patterns, e.g. %website or %{Company City}.
+	ContextColumns *[]string `json:"context_columns"`
+
 	// FromTemplateId Optional. ID of the template this job was launched from, for
 	// attribution/analytics only. The actual config is taken from
 	// key_columns and columns_metadata in this request - this field
 	// does not affect execution.
-	FromTemplateId       *string   `json:"from_template_id"`
+	FromTemplateId *string `json:"from_template_id"`
+
+	// FuzzyThreshold Also merge keys whose normalized forms have at least this
+	// Jaro-Winkler similarity (e.g. 0.95). Only keys whose first two
+	// characters agree are compared. Implies normalize_keys.
+	FuzzyThreshold *float64 `json:"fuzzy_threshold"`
+
+	// Incremental Only enrich rows that were added or whose input columns changed
+	// since the source's latest completed job, which must have used the
+	// same key columns and enriched every requested column. The other
+	// rows keep that job's results and are not billed again.
+	Incremental          *bool     `json:"incremental"`
 	KeyColumnDescription *string   `json:"key_column_description"`
 	KeyColumns           *[]string `json:"key_columns"`
 
+	// Locale BCP 47 locale tag (e.g. "en-US", "de-DE") used to read extracted
+	// numbers and dates, such as decimal separators and DD/MM vs MM/DD.
+	// Defaults to en-US.
+	Locale *string `json:"locale"`
+
+	// NormalizeKeys Merge rows whose keys name the same entity before enriching, e.g.
+	// "Stripe", "Stripe, Inc." and " stripe ". Keys are trimmed,
+	// case-folded, stripped of legal suffixes and URLs are reduced to
+	// their domain. Merged rows are enriched and billed once and receive
+	// the same results.
+	NormalizeKeys *bool `json:"normalize_keys"`
+
 	// RowLimit Maximum number of rows to process. Processes all rows if not set.
 	RowLimit *int `json:"row_limit"`
 }
@@ -135,6 +392,55 @@ type ErrorResponse struct {
 	Message string `json:"message"`
 }
 
+// ExportListResponse defines model for ExportListResponse.
+type ExportListResponse struct {
+	Exports []ExportResponse `json:"exports"`
+}
+
+// ExportRequest defines model for ExportRequest.
+type ExportRequest struct {
+	// Format xlsx colors enriched cells by confidence, comments them with the confidence reason, citation and sources, and adds a History sheet of extraction attempts. ndjson and parquet keep the types of enriched columns (number, boolean, date, list) and write confidence scores as numbers and sources as lists.
+	Format *ExportRequestFormat `json:"format,omitempty"`
+
+	// IncludeCitations Add <column>_citation_url and <column>_citation_quote columns after each enriched column. Unverified quotes are left out.
+	IncludeCitations *bool `json:"include_citations,omitempty"`
+
+	// IncludeConfidence Add a <column>_confidence column after each enriched column
+	IncludeConfidence *bool `json:"include_confidence,omitempty"`
+
+	// IncludeError Add an enrichment_error column with the reason a row failed
+	IncludeError *bool `json:"include_error,omitempty"`
+
+	// IncludeSources Add an enrichment_sources column listing the pages each row was extracted from
+	IncludeSources *bool `json:"include_sources,omitempty"`
+}
+
+// ExportRequestFormat defines model for ExportRequest.Format.
+type ExportRequestFormat string
+
+// ExportResponse defines model for ExportResponse.
+type ExportResponse struct {
+	CompletedAt       *time.Time `json:"completed_at"`
+	CreatedAt         time.Time  `json:"created_at"`
+	DownloadExpiresAt *time.Time `json:"download_expires_at"`
+
+	// DownloadUrl Signed link to the file, set once the export is completed
+	DownloadUrl       *string              `json:"download_url"`
+	Error             *string              `json:"error"`
+	Format            string               `json:"format"`
+	Id                openapi_types.UUID   `json:"id"`
+	IncludeCitations  bool                 `json:"include_citations"`
+	IncludeConfidence bool                 `json:"include_confidence"`
+	IncludeError      bool                 `json:"include_error"`
+	IncludeSources    bool                 `json:"include_sources"`
+	JobId             string               `json:"job_id"`
+	RowCount          int                  `json:"row_count"`
+	Status            ExportResponseStatus `json:"status"`
+}
+
+// ExportResponseStatus defines model for ExportResponse.Status.
+type ExportResponseStatus string
+
 // ExtractionHistoryEntry defines model for ExtractionHistoryEntry.
 type ExtractionHistoryEntry struct {
 	AttemptNumber int                             `json:"attempt_number"`
@@ -146,8 +452,24 @@ type ExtractionHistoryEntry struct {
 
 // FieldConfidenceInfo defines model for FieldConfidenceInfo.
 type FieldConfidenceInfo struct {
-	Reason string  `json:"reason"`
-	Score  float64 `json:"score"`
+	Citation *Citation `json:"citation,omitempty"`
+
+	// HumanVerified Set by a reviewer; enrichment never overwrites the value
+	HumanVerified *bool          `json:"human_verified,omitempty"`
+	Precision     *DatePrecision `json:"precision,omitempty"`
+	Reason        string         `json:"reason"`
+	Score         float64        `json:"score"`
+}
+
+// JobDiffResponse defines model for JobDiffResponse.
+type JobDiffResponse struct {
+	BaseJobId string `json:"base_job_id"`
+
+	// Columns Change counts per column over all rows, regardless of the change filter
+	Columns    []ColumnDiffSummary `json:"columns"`
+	JobId      string              `json:"job_id"`
+	Pagination PaginationInfo      `json:"pagination"`
+	Rows       []RowDiff           `json:"rows"`
 }
 
 // JobProgressResponse defines model for JobProgressResponse.
@@ -165,6 +487,36 @@ type JobStatus string
 // JobType defines model for JobType.
 type JobType string
 
+// LookupRequest defines model for LookupRequest.
+type LookupRequest struct {
+	// ColumnsMetadata Columns to find. Classify columns need source rows and are not supported.
+	ColumnsMetadata []ColumnMetadata `json:"columns_metadata"`
+
+	// Key The entity to enrich, e.g. a company name
+	Key string `json:"key"`
+
+	// KeyColumnDescription What the key is, e.g. "company name"
+	KeyColumnDescription *string `json:"key_column_description,omitempty"`
+
+	// Locale BCP 47 tag whose number and date conventions values are read with
+	Locale *string `json:"locale,omitempty"`
+
+	// TimeoutSeconds Gives up sooner than the server's lookup timeout
+	TimeoutSeconds *int `json:"timeout_seconds,omitempty"`
+}
+
+// LookupResponse defines model for LookupResponse.
+type LookupResponse struct {
+	Confidence map[string]FieldConfidenceInfo `json:"confidence"`
+
+	// Error Why the entity could not be enriched
+	Error         *string                `json:"error,omitempty"`
+	ExtractedData map[string]interface{} `json:"extracted_data"`
+	Key           string                 `json:"key"`
+	Sources       []string               `json:"sources"`
+	Success       bool                   `json:"success"`
+}
+
 // PaginationInfo defines model for PaginationInfo.
 type PaginationInfo struct {
 	HasMore bool `json:"has_more"`
@@ -173,6 +525,40 @@ type PaginationInfo struct {
 	Total   int  `json:"total"`
 }
 
+// RerunRequest defines model for RerunRequest.
+type RerunRequest struct {
+	// Columns Enriched columns to re-run; all enriched columns when omitted. Derived columns are recomputed automatically.
+	Columns *[]string `json:"columns,omitempty"`
+
+	// Instructions Extra instructions added to the prompts of the re-run
+	Instructions *string `json:"instructions,omitempty"`
+
+	// Merge best keeps whichever value has the higher confidence; replace overwrites the stored values of the re-run cells
+	Merge *RerunRequestMerge `json:"merge,omitempty"`
+
+	// RowKeys Keys of the rows to enrich again
+	RowKeys []string `json:"row_keys"`
+}
+
+// RerunRequestMerge defines model for RerunRequest.Merge.
+type RerunRequestMerge string
+
+// RerunResponse defines model for RerunResponse.
+type RerunResponse struct {
+	// Credits Credits the re-run costs at most. Cells corrected by a reviewer are skipped and not billed.
+	Credits int64  `json:"credits"`
+	JobId   string `json:"job_id"`
+
+	// Rows Number of rows being re-run
+	Rows int `json:"rows"`
+}
+
+// RowDiff defines model for RowDiff.
+type RowDiff struct {
+	Cells []CellDiff `json:"cells"`
+	Key   string     `json:"key"`
+}
+
 // RowProgressItem defines model for RowProgressItem.
 type RowProgressItem struct {
 	Confidence        *map[string]FieldConfidenceInfo `json:"confidence"`
@@ -194,6 +580,26 @@ type RowsProgressResponse struct {
 	Rows       []RowProgressItem `json:"rows"`
 }
 
+// SQLSourceRequest defines model for SQLSourceRequest.
+type SQLSourceRequest struct {
+	// Connection Name of a server-side database connection
+	Connection string `json:"connection"`
+
+	// Name Source name; generated from the columns when omitted
+	Name *string `json:"name"`
+
+	// Query Read-only SELECT statement whose rows form the source
+	Query string `json:"query"`
+
+	// WriteBackTable Table that enriched results are upserted into on the key columns
+	WriteBackTable *string `json:"write_back_table"`
+}
+
+// SQLSourceResponse defines model for SQLSourceResponse.
+type SQLSourceResponse struct {
+	SourceId openapi_types.UUID `json:"source_id"`
+}
+
 // SelectKeyRequest defines model for SelectKeyRequest.
 type SelectKeyRequest struct {
 	ColumnsMetadata *[]ColumnMetadata  `json:"columns_metadata"`
@@ -207,13 +613,29 @@ type SelectKeyResponse struct {
 	SelectedKey string   `json:"selected_key"`
 }
 
+// SheetSelectionRequest defines model for SheetSelectionRequest.
+type SheetSelectionRequest struct {
+	// HeaderRow 1-based row holding the column headers; defaults to 1
+	HeaderRow *int `json:"header_row"`
+
+	// SheetName Sheet to read; null or empty selects the first sheet
+	SheetName *string `json:"sheet_name"`
+}
+
 // SignedURLRequest defines model for SignedURLRequest.
 type SignedURLRequest struct {
 	ContentType SignedURLRequestContentType `json:"contentType"`
 
-	// Headers CSV column headers, used to generate an AI name for the source
+	// HeaderRow XLSX only. 1-based row holding the column headers; defaults to 1
+	HeaderRow *int `json:"headerRow,omitempty"`
+
+	// Headers Column headers (for JSON, the flattened field names such as
+	// "address.city"), used to generate an AI name for the source
 	Headers *[]string `json:"headers,omitempty"`
 	Length  int       `json:"length"`
+
+	// SheetName XLSX only. Sheet to read; defaults to the first sheet
+	SheetName *string `json:"sheetName,omitempty"`
 }
 
 // SignedURLRequestContentType defines model for SignedURLRequest.ContentType.
@@ -225,24 +647,39 @@ type SignedURLResponse struct {
 	Url      string             `json:"url"`
 }
 
+// SourceConnectionsResponse defines model for SourceConnectionsResponse.
+type SourceConnectionsResponse struct {
+	Connections []string `json:"connections"`
+}
+
 // SourceDetail defines model for SourceDetail.
 type SourceDetail struct {
 	CreatedAt time.Time          `json:"created_at"`
 	Jobs      []SourceJobSummary `json:"jobs"`
-	SourceId  openapi_types.UUID `json:"source_id"`
-	Type      string             `json:"type"`
+
+	// Revision Current revision of an uploaded file source
+	Revision *int               `json:"revision"`
+	SourceId openapi_types.UUID `json:"source_id"`
+	Type     string             `json:"type"`
 }
 
 // SourceJobSummary defines model for SourceJobSummary.
 type SourceJobSummary struct {
+	// BaseJobId For incremental jobs, the job whose results were carried forward
+	BaseJobId            *string           `json:"base_job_id"`
 	ColumnsMetadata      *[]ColumnMetadata `json:"columns_metadata"`
+	ContextColumns       *[]string         `json:"context_columns"`
 	CreatedAt            time.Time         `json:"created_at"`
 	JobId                string            `json:"job_id"`
 	KeyColumnDescription *string           `json:"key_column_description"`
 	KeyColumns           *[]string         `json:"key_columns"`
-	StartedAt            *time.Time        `json:"started_at"`
-	Status               JobStatus         `json:"status"`
-	TotalRows            int               `json:"total_rows"`
+	Locale               *string           `json:"locale"`
+
+	// SourceRevision Revision of the source file the job read
+	SourceRevision *int       `json:"source_revision,omitempty"`
+	StartedAt      *time.Time `json:"started_at"`
+	Status         JobStatus  `json:"status"`
+	TotalRows      int        `json:"total_rows"`
 }
 
 // SourceListResponse defines model for SourceListResponse.
@@ -251,6 +688,33 @@ type SourceListResponse struct {
 	TotalCount int             `json:"total_count"`
 }
 
+// SourceRevisionRequest defines model for SourceRevisionRequest.
+type SourceRevisionRequest struct {
+	// ContentType One of the content types accepted for uploads. Must match the
+	// source's file type (workbook or not)
+	ContentType string `json:"contentType"`
+	Length      int    `json:"length"`
+}
+
+// SourceRevisionResponse defines model for SourceRevisionResponse.
+type SourceRevisionResponse struct {
+	// Revision Number of the pending revision. The source keeps reading its
+	// current file until the revision is confirmed after the upload.
+	Revision int `json:"revision"`
+
+	// Url Signed URL to upload the new revision to
+	Url string `json:"url"`
+}
+
+// SourceSheetsResponse defines model for SourceSheetsResponse.
+type SourceSheetsResponse struct {
+	HeaderRow int `json:"header_row"`
+
+	// SheetName Selected sheet; null when the first sheet is read
+	SheetName *string  `json:"sheet_name"`
+	Sheets    []string `json:"sheets"`
+}
+
 // SourceSummary defines model for SourceSummary.
 type SourceSummary struct {
 	CreatedAt       time.Time          `json:"created_at"`
@@ -321,6 +785,97 @@ type UserResponse struct {
 	LastName  string `json:"last_name"`
 }
 
+// WebhookDeliveryListResponse defines model for WebhookDeliveryListResponse.
+type WebhookDeliveryListResponse struct {
+	Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+	Pagination PaginationInfo            `json:"pagination"`
+}
+
+// WebhookDeliveryResponse defines model for WebhookDeliveryResponse.
+type WebhookDeliveryResponse struct {
+	Attempts    int                `json:"attempts"`
+	CreatedAt   time.Time          `json:"created_at"`
+	DeliveredAt *time.Time         `json:"delivered_at"`
+	EndpointId  openapi_types.UUID `json:"endpoint_id"`
+
+	// Error Why the latest attempt failed
+	Error     *string            `json:"error"`
+	EventId   string             `json:"event_id"`
+	EventType WebhookEventType   `json:"event_type"`
+	Id        openapi_types.UUID `json:"id"`
+	JobId     string             `json:"job_id"`
+
+	// Payload The event as posted to the endpoint
+	Payload map[string]interface{} `json:"payload"`
+
+	// ResponseStatus HTTP status of the latest attempt's response
+	ResponseStatus *int                          `json:"response_status"`
+	Status         WebhookDeliveryResponseStatus `json:"status"`
+}
+
+// WebhookDeliveryResponseStatus defines model for WebhookDeliveryResponse.Status.
+type WebhookDeliveryResponseStatus string
+
+// WebhookEndpointListResponse defines model for WebhookEndpointListResponse.
+type WebhookEndpointListResponse struct {
+	Endpoints []WebhookEndpointResponse `json:"endpoints"`
+}
+
+// WebhookEndpointResponse defines model for WebhookEndpointResponse.
+type WebhookEndpointResponse struct {
+	CreatedAt time.Time          `json:"created_at"`
+	Events    []WebhookEventType `json:"events"`
+	Id        openapi_types.UUID `json:"id"`
+
+	// Secret Key of the HMAC-SHA256 signature in the X-Ampledata-Signature header, sent as "t=<unix timestamp>,v1=<hex digest of '<timestamp>.<body>'>". Only returned when the endpoint is created.
+	Secret *string `json:"secret,omitempty"`
+	Url    string  `json:"url"`
+}
+
+// WebhookEventType defines model for WebhookEventType.
+type WebhookEventType string
+
+// ListJobCorrectionsParams defines parameters for ListJobCorrections.
+type ListJobCorrectionsParams struct {
+	// RowKey Only list the corrections of this row
+	RowKey *string `form:"row_key,omitempty" json:"row_key,omitempty"`
+}
+
+// DiffJobsParams defines parameters for DiffJobs.
+type DiffJobsParams struct {
+	// Base The earlier job on the same source to compare against
+	Base string `form:"base" json:"base"`
+
+	// Column Only compare these columns
+	Column *[]string `form:"column,omitempty" json:"column,omitempty"`
+
+	// Change Only report cells with these changes
+	Change *[]DiffChange `form:"change,omitempty" json:"change,omitempty"`
+	Offset *int          `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int          `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ExportJobDiffParams defines parameters for ExportJobDiff.
+type ExportJobDiffParams struct {
+	// Base The earlier job on the same source to compare against
+	Base string `form:"base" json:"base"`
+
+	// Column Only compare these columns
+	Column *[]string `form:"column,omitempty" json:"column,omitempty"`
+
+	// Change Only report cells with these changes
+	Change *[]DiffChange `form:"change,omitempty" json:"change,omitempty"`
+}
+
+// StreamJobEventsParams defines parameters for StreamJobEvents.
+type StreamJobEventsParams struct {
+	// LastEventId ID of the last event received, for clients that cannot set headers
+	LastEventId *string `form:"last_event_id,omitempty" json:"last_event_id,omitempty"`
+
+	// LastEventID ID of the last event received
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
 // GetJobResultsParams defines parameters for GetJobResults.
 type GetJobResultsParams struct {
 	Start *int `form:"start,omitempty" json:"start,omitempty"`
@@ -333,6 +888,27 @@ type GetRowsProgressParams struct {
 	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
 	Stage  *string `form:"stage,omitempty" json:"stage,omitempty"`
 	Sort   *string `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Present Only rows with an extracted value for each listed column
+	Present *[]string `form:"present,omitempty" json:"present,omitempty"`
+
+	// Missing Only rows without an extracted value (absent or null) for each listed column
+	Missing *[]string `form:"missing,omitempty" json:"missing,omitempty"`
+
+	// Confidence Confidence ranges as column:min..max, where min is inclusive, max is exclusive and either may be left out, e.g. ceo:0.5..0.8 or revenue:..0.5. The column * matches rows where any column's score is in range.
+	Confidence *[]string `form:"confidence,omitempty" json:"confidence,omitempty"`
+
+	// ErrorContains Only rows whose error contains the text, ignoring case
+	ErrorContains *string `form:"error_contains,omitempty" json:"error_contains,omitempty"`
+
+	// KeyContains Only rows whose key contains the text, ignoring case
+	KeyContains *string `form:"key_contains,omitempty" json:"key_contains,omitempty"`
+
+	// MinAttempts Only rows with at least this many extraction attempts
+	MinAttempts *int `form:"min_attempts,omitempty" json:"min_attempts,omitempty"`
+
+	// MaxAttempts Only rows with at most this many extraction attempts
+	MaxAttempts *int `form:"max_attempts,omitempty" json:"max_attempts,omitempty"`
 }
 
 // ListSourcesParams defines parameters for ListSources.
@@ -341,66 +917,179 @@ type ListSourcesParams struct {
 	Limit  *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// GetSourceDataParams defines parameters for GetSourceData.
+type GetSourceDataParams struct {
+	// Offset 0-based index of the first data row to return
+	Offset *int `form:"offset,omitempty" json:"offset,omitempty"`
+
+	// Limit Maximum number of rows to return (at most 1000)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // CreatePortalSessionParams defines parameters for CreatePortalSession.
 type CreatePortalSessionParams struct {
 	ReturnUrl string `form:"return_url" json:"return_url"`
 }
 
+// ListWebhookDeliveriesParams defines parameters for ListWebhookDeliveries.
+type ListWebhookDeliveriesParams struct {
+	// JobId Only list deliveries of this job's events
+	JobId  *string `form:"job_id,omitempty" json:"job_id,omitempty"`
+	Offset *int    `form:"offset,omitempty" json:"offset,omitempty"`
+	Limit  *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // HandleStripeWebhookParams defines parameters for HandleStripeWebhook.
 type HandleStripeWebhookParams struct {
 	StripeSignature string `json:"Stripe-Signature"`
 }
 
+// CreateAPIKeyJSONRequestBody defines body for CreateAPIKey for application/json ContentType.
+type CreateAPIKeyJSONRequestBody = CreateAPIKeyRequest
+
 // UploadFileForEnrichmentJSONRequestBody defines body for UploadFileForEnrichment for application/json ContentType.
 type UploadFileForEnrichmentJSONRequestBody = SignedURLRequest
 
+// CorrectJobCellJSONRequestBody defines body for CorrectJobCell for application/json ContentType.
+type CorrectJobCellJSONRequestBody = CellCorrectionRequest
+
+// CreateJobExportJSONRequestBody defines body for CreateJobExport for application/json ContentType.
+type CreateJobExportJSONRequestBody = ExportRequest
+
+// RerunJobRowsJSONRequestBody defines body for RerunJobRows for application/json ContentType.
+type RerunJobRowsJSONRequestBody = RerunRequest
+
+// LookupEntityJSONRequestBody defines body for LookupEntity for application/json ContentType.
+type LookupEntityJSONRequestBody = LookupRequest
+
 // SelectKeyJSONRequestBody defines body for SelectKey for application/json ContentType.
 type SelectKeyJSONRequestBody = SelectKeyRequest
 
+// CreateSQLSourceJSONRequestBody defines body for CreateSQLSource for application/json ContentType.
+type CreateSQLSourceJSONRequestBody = SQLSourceRequest
+
+// SetSourceDialectJSONRequestBody defines body for SetSourceDialect for application/json ContentType.
+type SetSourceDialectJSONRequestBody = CSVDialectRequest
+
 // EnrichSourceJSONRequestBody defines body for EnrichSource for application/json ContentType.
 type EnrichSourceJSONRequestBody = EnrichRequest
 
+// CreateSourceRevisionJSONRequestBody defines body for CreateSourceRevision for application/json ContentType.
+type CreateSourceRevisionJSONRequestBody = SourceRevisionRequest
+
+// SelectSourceSheetJSONRequestBody defines body for SelectSourceSheet for application/json ContentType.
+type SelectSourceSheetJSONRequestBody = SheetSelectionRequest
+
 // CreateSubscriptionCheckoutJSONRequestBody defines body for CreateSubscriptionCheckout for application/json ContentType.
 type CreateSubscriptionCheckoutJSONRequestBody = CreateSubscriptionRequest
 
 // UpgradeSubscriptionJSONRequestBody defines body for UpgradeSubscription for application/json ContentType.
 type UpgradeSubscriptionJSONRequestBody = UpgradeSubscriptionRequest
 
+// CreateWebhookEndpointJSONRequestBody defines body for CreateWebhookEndpoint for application/json ContentType.
+type CreateWebhookEndpointJSONRequestBody = CreateWebhookEndpointRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List the user's API keys, revoked ones included
+	// (GET /api-keys)
+	ListAPIKeys(w http.ResponseWriter, r *http.Request)
+	// Create an API key for programmatic access
+	// (POST /api-keys)
+	CreateAPIKey(w http.ResponseWriter, r *http.Request)
+	// Revoke an API key
+	// (DELETE /api-keys/{keyID})
+	RevokeAPIKey(w http.ResponseWriter, r *http.Request, keyID openapi_types.UUID)
 	// Create a pending enrichment job and get a signed upload URL
 	// (POST /enrichment-signed-url)
 	UploadFileForEnrichment(w http.ResponseWriter, r *http.Request)
+	// Get an export and, once completed, its download link
+	// (GET /exports/{exportID})
+	GetExport(w http.ResponseWriter, r *http.Request, exportID openapi_types.UUID)
 	// Cancel a running enrichment job
 	// (POST /jobs/{jobID}/cancel)
 	CancelJob(w http.ResponseWriter, r *http.Request, jobID string)
+	// List the cell corrections of a job
+	// (GET /jobs/{jobID}/corrections)
+	ListJobCorrections(w http.ResponseWriter, r *http.Request, jobID string, params ListJobCorrectionsParams)
+	// Correct an extracted cell
+	// (POST /jobs/{jobID}/corrections)
+	CorrectJobCell(w http.ResponseWriter, r *http.Request, jobID string)
+	// Compare the results of two jobs on the same source
+	// (GET /jobs/{jobID}/diff)
+	DiffJobs(w http.ResponseWriter, r *http.Request, jobID string, params DiffJobsParams)
+	// Download the comparison of two jobs as CSV
+	// (GET /jobs/{jobID}/diff/csv)
+	ExportJobDiff(w http.ResponseWriter, r *http.Request, jobID string, params ExportJobDiffParams)
+	// Stream the row stage transitions of a job
+	// (GET /jobs/{jobID}/events)
+	StreamJobEvents(w http.ResponseWriter, r *http.Request, jobID string, params StreamJobEventsParams)
+	// List the exports of a job
+	// (GET /jobs/{jobID}/exports)
+	ListJobExports(w http.ResponseWriter, r *http.Request, jobID string)
+	// Export a job's results merged into its source rows
+	// (POST /jobs/{jobID}/exports)
+	CreateJobExport(w http.ResponseWriter, r *http.Request, jobID string)
 	// Get progress summary for a job
 	// (GET /jobs/{jobID}/progress)
 	GetJobProgress(w http.ResponseWriter, r *http.Request, jobID string)
+	// Enrich selected rows and columns of a finished job again
+	// (POST /jobs/{jobID}/rerun)
+	RerunJobRows(w http.ResponseWriter, r *http.Request, jobID string)
 	// Get enrichment results for a job
 	// (GET /jobs/{jobID}/results)
 	GetJobResults(w http.ResponseWriter, r *http.Request, jobID string, params GetJobResultsParams)
 	// Get per-row progress for a job
 	// (GET /jobs/{jobID}/rows)
 	GetRowsProgress(w http.ResponseWriter, r *http.Request, jobID string, params GetRowsProgressParams)
+	// Enrich a single entity and wait for the result
+	// (POST /lookups)
+	LookupEntity(w http.ResponseWriter, r *http.Request)
 	// Get the current authenticated user's profile
 	// (GET /me)
 	GetMe(w http.ResponseWriter, r *http.Request)
 	// Use AI to select the best key column from a source's CSV
 	// (POST /select-key)
 	SelectKey(w http.ResponseWriter, r *http.Request)
+	// List the database connections query sources may use
+	// (GET /source-connections)
+	ListSourceConnections(w http.ResponseWriter, r *http.Request)
 	// List sources for the authenticated user
 	// (GET /sources)
 	ListSources(w http.ResponseWriter, r *http.Request, params ListSourcesParams)
+	// Create a source from a read-only query on a named connection
+	// (POST /sources/sql)
+	CreateSQLSource(w http.ResponseWriter, r *http.Request)
 	// Get source details with all enrichment runs
 	// (GET /sources/{sourceID})
 	GetSource(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID)
-	// Get the raw CSV data for a source
+	// Get a page of the raw CSV data for a source
 	// (GET /sources/{sourceID}/data)
-	GetSourceData(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID)
+	GetSourceData(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID, params GetSourceDataParams)
+	// Drop a dialect override and detect the dialect again
+	// (DELETE /sources/{sourceID}/dialect)
+	ResetSourceDialect(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID)
+	// Get the delimiter, encoding and header row a CSV source is read with
+	// (GET /sources/{sourceID}/dialect)
+	GetSourceDialect(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID)
+	// Override the detected dialect of a CSV source
+	// (PUT /sources/{sourceID}/dialect)
+	SetSourceDialect(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID)
 	// Start a new enrichment run for a source
 	// (POST /sources/{sourceID}/enrich)
 	EnrichSource(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID)
+	// Upload a new revision of a file source
+	// (POST /sources/{sourceID}/revisions)
+	CreateSourceRevision(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID)
+	// Make an uploaded revision the current file of a source
+	// (POST /sources/{sourceID}/revisions/{revision}/confirm)
+	ConfirmSourceRevision(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID, revision int)
+	// List the sheets of an XLSX source and the current selection
+	// (GET /sources/{sourceID}/sheets)
+	ListSourceSheets(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID)
+	// Choose the sheet and header row read from an XLSX source
+	// (PUT /sources/{sourceID}/sheets)
+	SelectSourceSheet(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID)
 	// Create a Stripe checkout session for a subscription
 	// (POST /subscribe)
 	CreateSubscriptionCheckout(w http.ResponseWriter, r *http.Request)
@@ -422,6 +1111,18 @@ type ServerInterface interface {
 	// List available subscription tiers
 	// (GET /tiers)
 	ListTiers(w http.ResponseWriter, r *http.Request)
+	// List the user's webhook endpoints
+	// (GET /webhook-endpoints)
+	ListWebhookEndpoints(w http.ResponseWriter, r *http.Request)
+	// Register a URL to receive signed job events
+	// (POST /webhook-endpoints)
+	CreateWebhookEndpoint(w http.ResponseWriter, r *http.Request)
+	// Delete a webhook endpoint and its delivery log
+	// (DELETE /webhook-endpoints/{endpointID})
+	DeleteWebhookEndpoint(w http.ResponseWriter, r *http.Request, endpointID openapi_types.UUID)
+	// List the delivery log of a webhook endpoint
+	// (GET /webhook-endpoints/{endpointID}/deliveries)
+	ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, endpointID openapi_types.UUID, params ListWebhookDeliveriesParams)
 	// Handle Stripe webhook events
 	// (POST /webhooks/stripe)
 	HandleStripeWebhook(w http.ResponseWriter, r *http.Request, params HandleStripeWebhookParams)
@@ -436,14 +1137,14 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
-// UploadFileForEnrichment operation middleware
-func (siw *ServerInterfaceWrapper) UploadFileForEnrichment(w http.ResponseWriter, r *http.Request) {
+// ListAPIKeys operation middleware
+func (siw *ServerInterfaceWrapper) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UploadFileForEnrichment(w, r)
+		siw.Handler.ListAPIKeys(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -453,25 +1154,42 @@ func (siw *ServerInterfaceWrapper) UploadFileForEnrichment(w http.ResponseWriter
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CancelJob operation middleware
-func (siw *ServerInterfaceWrapper) CancelJob(w http.ResponseWriter, r *http.Request) {
+// CreateAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAPIKey(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// RevokeAPIKey operation middleware
+func (siw *ServerInterfaceWrapper) RevokeAPIKey(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "jobID" -------------
-	var jobID string
+	// ------------- Path parameter "keyID" -------------
+	var keyID openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "jobID", mux.Vars(r)["jobID"], &jobID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "keyID", mux.Vars(r)["keyID"], &keyID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "keyID", Err: err})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CancelJob(w, r, jobID)
+		siw.Handler.RevokeAPIKey(w, r, keyID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -481,25 +1199,42 @@ func (siw *ServerInterfaceWrapper) CancelJob(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetJobProgress operation middleware
-func (siw *ServerInterfaceWrapper) GetJobProgress(w http.ResponseWriter, r *http.Request) {
+// UploadFileForEnrichment operation middleware
+func (siw *ServerInterfaceWrapper) UploadFileForEnrichment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UploadFileForEnrichment(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetExport operation middleware
+func (siw *ServerInterfaceWrapper) GetExport(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "jobID" -------------
-	var jobID string
+	// ------------- Path parameter "exportID" -------------
+	var exportID openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "jobID", mux.Vars(r)["jobID"], &jobID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "exportID", mux.Vars(r)["exportID"], &exportID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exportID", Err: err})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetJobProgress(w, r, jobID)
+		siw.Handler.GetExport(w, r, exportID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -509,8 +1244,8 @@ func (siw *ServerInterfaceWrapper) GetJobProgress(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetJobResults operation middleware
-func (siw *ServerInterfaceWrapper) GetJobResults(w http.ResponseWriter, r *http.Request) {
+// CancelJob operation middleware
+func (siw *ServerInterfaceWrapper) CancelJob(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -526,28 +1261,9 @@ func (siw *ServerInterfaceWrapper) GetJobResults(w http.ResponseWriter, r *http.
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetJobResultsParams
-
-	// ------------- Optional query parameter "start" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "start", r.URL.Query(), &params.Start)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
-	}
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetJobResults(w, r, jobID, params)
-	}))
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelJob(w, r, jobID)
+	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		handler = middleware(handler)
@@ -556,8 +1272,8 @@ func (siw *ServerInterfaceWrapper) GetJobResults(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetRowsProgress operation middleware
-func (siw *ServerInterfaceWrapper) GetRowsProgress(w http.ResponseWriter, r *http.Request) {
+// ListJobCorrections operation middleware
+func (siw *ServerInterfaceWrapper) ListJobCorrections(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
@@ -574,42 +1290,46 @@ func (siw *ServerInterfaceWrapper) GetRowsProgress(w http.ResponseWriter, r *htt
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetRowsProgressParams
+	var params ListJobCorrectionsParams
 
-	// ------------- Optional query parameter "offset" -------------
+	// ------------- Optional query parameter "row_key" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	err = runtime.BindQueryParameter("form", true, false, "row_key", r.URL.Query(), &params.RowKey)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "row_key", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "limit" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListJobCorrections(w, r, jobID, params)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "stage" -------------
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "stage", r.URL.Query(), &params.Stage)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stage", Err: err})
-		return
-	}
+// CorrectJobCell operation middleware
+func (siw *ServerInterfaceWrapper) CorrectJobCell(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	// ------------- Optional query parameter "sort" -------------
+	var err error
 
-	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	// ------------- Path parameter "jobID" -------------
+	var jobID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "jobID", mux.Vars(r)["jobID"], &jobID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobID", Err: err})
 		return
 	}
 
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRowsProgress(w, r, jobID, params)
+		siw.Handler.CorrectJobCell(w, r, jobID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -619,50 +1339,56 @@ func (siw *ServerInterfaceWrapper) GetRowsProgress(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetMe operation middleware
-func (siw *ServerInterfaceWrapper) GetMe(w http.ResponseWriter, r *http.Request) {
+// DiffJobs operation middleware
+func (siw *ServerInterfaceWrapper) DiffJobs(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	var err error
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetMe(w, r)
-	}))
+	// ------------- Path parameter "jobID" -------------
+	var jobID string
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	err = runtime.BindStyledParameterWithOptions("simple", "jobID", mux.Vars(r)["jobID"], &jobID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobID", Err: err})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-// SelectKey operation middleware
-func (siw *ServerInterfaceWrapper) SelectKey(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DiffJobsParams
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ------------- Required query parameter "base" -------------
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SelectKey(w, r)
-	}))
+	if paramValue := r.URL.Query().Get("base"); paramValue != "" {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "base"})
+		return
 	}
 
-	handler.ServeHTTP(w, r.WithContext(ctx))
-}
+	err = runtime.BindQueryParameter("form", true, true, "base", r.URL.Query(), &params.Base)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "base", Err: err})
+		return
+	}
 
-// ListSources operation middleware
-func (siw *ServerInterfaceWrapper) ListSources(w http.ResponseWriter, r *http.Request) {
-	ctx := r.Context()
+	// ------------- Optional query parameter "column" -------------
 
-	var err error
+	err = runtime.BindQueryParameter("form", true, false, "column", r.URL.Query(), &params.Column)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "column", Err: err})
+		return
+	}
 
-	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
+	// ------------- Optional query parameter "change" -------------
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListSourcesParams
+	err = runtime.BindQueryParameter("form", true, false, "change", r.URL.Query(), &params.Change)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "change", Err: err})
+		return
+	}
 
 	// ------------- Optional query parameter "offset" -------------
 
@@ -681,7 +1407,7 @@ func (siw *ServerInterfaceWrapper) ListSources(w http.ResponseWriter, r *http.Re
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListSources(w, r, params)
+		siw.Handler.DiffJobs(w, r, jobID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -691,25 +1417,59 @@ func (siw *ServerInterfaceWrapper) ListSources(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetSource operation middleware
-func (siw *ServerInterfaceWrapper) GetSource(w http.ResponseWriter, r *http.Request) {
+// ExportJobDiff operation middleware
+func (siw *ServerInterfaceWrapper) ExportJobDiff(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "sourceID" -------------
-	var sourceID openapi_types.UUID
+	// ------------- Path parameter "jobID" -------------
+	var jobID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sourceID", mux.Vars(r)["sourceID"], &sourceID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "jobID", mux.Vars(r)["jobID"], &jobID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobID", Err: err})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportJobDiffParams
+
+	// ------------- Required query parameter "base" -------------
+
+	if paramValue := r.URL.Query().Get("base"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "base"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "base", r.URL.Query(), &params.Base)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "base", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "column" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "column", r.URL.Query(), &params.Column)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "column", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "change" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "change", r.URL.Query(), &params.Change)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "change", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSource(w, r, sourceID)
+		siw.Handler.ExportJobDiff(w, r, jobID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -719,25 +1479,57 @@ func (siw *ServerInterfaceWrapper) GetSource(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetSourceData operation middleware
-func (siw *ServerInterfaceWrapper) GetSourceData(w http.ResponseWriter, r *http.Request) {
+// StreamJobEvents operation middleware
+func (siw *ServerInterfaceWrapper) StreamJobEvents(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "sourceID" -------------
-	var sourceID openapi_types.UUID
+	// ------------- Path parameter "jobID" -------------
+	var jobID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sourceID", mux.Vars(r)["sourceID"], &sourceID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "jobID", mux.Vars(r)["jobID"], &jobID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobID", Err: err})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params StreamJobEventsParams
+
+	// ------------- Optional query parameter "last_event_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "last_event_id", r.URL.Query(), &params.LastEventId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "last_event_id", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Last-Event-ID", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Last-Event-ID", Err: err})
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSourceData(w, r, sourceID)
+		siw.Handler.StreamJobEvents(w, r, jobID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -747,25 +1539,25 @@ func (siw *ServerInterfaceWrapper) GetSourceData(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// EnrichSource operation middleware
-func (siw *ServerInterfaceWrapper) EnrichSource(w http.ResponseWriter, r *http.Request) {
+// ListJobExports operation middleware
+func (siw *ServerInterfaceWrapper) ListJobExports(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
-	// ------------- Path parameter "sourceID" -------------
-	var sourceID openapi_types.UUID
+	// ------------- Path parameter "jobID" -------------
+	var jobID string
 
-	err = runtime.BindStyledParameterWithOptions("simple", "sourceID", mux.Vars(r)["sourceID"], &sourceID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "jobID", mux.Vars(r)["jobID"], &jobID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceID", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobID", Err: err})
 		return
 	}
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.EnrichSource(w, r, sourceID)
+		siw.Handler.ListJobExports(w, r, jobID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -775,14 +1567,25 @@ func (siw *ServerInterfaceWrapper) EnrichSource(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreateSubscriptionCheckout operation middleware
-func (siw *ServerInterfaceWrapper) CreateSubscriptionCheckout(w http.ResponseWriter, r *http.Request) {
+// CreateJobExport operation middleware
+func (siw *ServerInterfaceWrapper) CreateJobExport(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "jobID" -------------
+	var jobID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "jobID", mux.Vars(r)["jobID"], &jobID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobID", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateSubscriptionCheckout(w, r)
+		siw.Handler.CreateJobExport(w, r, jobID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -792,14 +1595,25 @@ func (siw *ServerInterfaceWrapper) CreateSubscriptionCheckout(w http.ResponseWri
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// GetSubscriptionStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetSubscriptionStatus(w http.ResponseWriter, r *http.Request) {
+// GetJobProgress operation middleware
+func (siw *ServerInterfaceWrapper) GetJobProgress(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "jobID" -------------
+	var jobID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "jobID", mux.Vars(r)["jobID"], &jobID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobID", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSubscriptionStatus(w, r)
+		siw.Handler.GetJobProgress(w, r, jobID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -809,14 +1623,25 @@ func (siw *ServerInterfaceWrapper) GetSubscriptionStatus(w http.ResponseWriter,
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CancelSubscription operation middleware
-func (siw *ServerInterfaceWrapper) CancelSubscription(w http.ResponseWriter, r *http.Request) {
+// RerunJobRows operation middleware
+func (siw *ServerInterfaceWrapper) RerunJobRows(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "jobID" -------------
+	var jobID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "jobID", mux.Vars(r)["jobID"], &jobID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobID", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CancelSubscription(w, r)
+		siw.Handler.RerunJobRows(w, r, jobID)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -826,34 +1651,44 @@ func (siw *ServerInterfaceWrapper) CancelSubscription(w http.ResponseWriter, r *
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// CreatePortalSession operation middleware
-func (siw *ServerInterfaceWrapper) CreatePortalSession(w http.ResponseWriter, r *http.Request) {
+// GetJobResults operation middleware
+func (siw *ServerInterfaceWrapper) GetJobResults(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	var err error
 
+	// ------------- Path parameter "jobID" -------------
+	var jobID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "jobID", mux.Vars(r)["jobID"], &jobID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobID", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params CreatePortalSessionParams
-
-	// ------------- Required query parameter "return_url" -------------
+	var params GetJobResultsParams
 
-	if paramValue := r.URL.Query().Get("return_url"); paramValue != "" {
+	// ------------- Optional query parameter "start" -------------
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "return_url"})
+	err = runtime.BindQueryParameter("form", true, false, "start", r.URL.Query(), &params.Start)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "start", Err: err})
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "return_url", r.URL.Query(), &params.ReturnUrl)
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "return_url", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreatePortalSession(w, r, params)
+		siw.Handler.GetJobResults(w, r, jobID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -863,14 +1698,116 @@ func (siw *ServerInterfaceWrapper) CreatePortalSession(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// UpgradeSubscription operation middleware
-func (siw *ServerInterfaceWrapper) UpgradeSubscription(w http.ResponseWriter, r *http.Request) {
+// GetRowsProgress operation middleware
+func (siw *ServerInterfaceWrapper) GetRowsProgress(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	var err error
+
+	// ------------- Path parameter "jobID" -------------
+	var jobID string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "jobID", mux.Vars(r)["jobID"], &jobID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "jobID", Err: err})
+		return
+	}
+
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRowsProgressParams
+
+	// ------------- Optional query parameter "offset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "stage" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "stage", r.URL.Query(), &params.Stage)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "stage", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sort", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "present" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "present", r.URL.Query(), &params.Present)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "present", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "missing" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "missing", r.URL.Query(), &params.Missing)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "missing", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "confidence" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "confidence", r.URL.Query(), &params.Confidence)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "confidence", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "error_contains" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error_contains", r.URL.Query(), &params.ErrorContains)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "error_contains", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "key_contains" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "key_contains", r.URL.Query(), &params.KeyContains)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key_contains", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "min_attempts" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "min_attempts", r.URL.Query(), &params.MinAttempts)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "min_attempts", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "max_attempts" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_attempts", r.URL.Query(), &params.MaxAttempts)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "max_attempts", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpgradeSubscription(w, r)
+		siw.Handler.GetRowsProgress(w, r, jobID, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -880,14 +1817,14 @@ func (siw *ServerInterfaceWrapper) UpgradeSubscription(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListTemplates operation middleware
-func (siw *ServerInterfaceWrapper) ListTemplates(w http.ResponseWriter, r *http.Request) {
+// LookupEntity operation middleware
+func (siw *ServerInterfaceWrapper) LookupEntity(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTemplates(w, r)
+		siw.Handler.LookupEntity(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -897,14 +1834,14 @@ func (siw *ServerInterfaceWrapper) ListTemplates(w http.ResponseWriter, r *http.
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// ListTiers operation middleware
-func (siw *ServerInterfaceWrapper) ListTiers(w http.ResponseWriter, r *http.Request) {
+// GetMe operation middleware
+func (siw *ServerInterfaceWrapper) GetMe(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListTiers(w, r)
+		siw.Handler.GetMe(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -914,42 +1851,31 @@ func (siw *ServerInterfaceWrapper) ListTiers(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// HandleStripeWebhook operation middleware
-func (siw *ServerInterfaceWrapper) HandleStripeWebhook(w http.ResponseWriter, r *http.Request) {
+// SelectKey operation middleware
+func (siw *ServerInterfaceWrapper) SelectKey(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params HandleStripeWebhookParams
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	headers := r.Header
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SelectKey(w, r)
+	}))
 
-	// ------------- Required header parameter "Stripe-Signature" -------------
-	if valueList, found := headers[http.CanonicalHeaderKey("Stripe-Signature")]; found {
-		var StripeSignature string
-		n := len(valueList)
-		if n != 1 {
-			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Stripe-Signature", Count: n})
-			return
-		}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-		err = runtime.BindStyledParameterWithOptions("simple", "Stripe-Signature", valueList[0], &StripeSignature, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
-		if err != nil {
-			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Stripe-Signature", Err: err})
-			return
-		}
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-		params.StripeSignature = StripeSignature
+// ListSourceConnections operation middleware
+func (siw *ServerInterfaceWrapper) ListSourceConnections(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	} else {
-		err = fmt.Errorf("Header parameter Stripe-Signature is required, but not found")
-		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Stripe-Signature", Err: err})
-		return
-	}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.HandleStripeWebhook(w, r, params)
+		siw.Handler.ListSourceConnections(w, r)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -959,932 +1885,3169 @@ func (siw *ServerInterfaceWrapper) HandleStripeWebhook(w http.ResponseWriter, r
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// ListSources operation middleware
+func (siw *ServerInterfaceWrapper) ListSources(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListSourcesParams
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Optional query parameter "offset" -------------
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-type RequiredParamError struct {
-	ParamName string
-}
+	// ------------- Optional query parameter "limit" -------------
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSources(w, r, params)
+	}))
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+// CreateSQLSource operation middleware
+func (siw *ServerInterfaceWrapper) CreateSQLSource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSQLSource(w, r)
+	}))
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{})
-}
+// GetSource operation middleware
+func (siw *ServerInterfaceWrapper) GetSource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GorillaServerOptions struct {
-	BaseURL          string
-	BaseRouter       *mux.Router
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	var err error
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{
-		BaseRouter: r,
-	})
-}
+	// ------------- Path parameter "sourceID" -------------
+	var sourceID openapi_types.UUID
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
-	return HandlerWithOptions(si, GorillaServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: r,
-	})
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "sourceID", mux.Vars(r)["sourceID"], &sourceID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceID", Err: err})
+		return
+	}
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
-	r := options.BaseRouter
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	if r == nil {
-		r = mux.NewRouter()
-	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		}
-	}
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSource(w, r, sourceID)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	r.HandleFunc(options.BaseURL+"/enrichment-signed-url", wrapper.UploadFileForEnrichment).Methods("POST")
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	r.HandleFunc(options.BaseURL+"/jobs/{jobID}/cancel", wrapper.CancelJob).Methods("POST")
+// GetSourceData operation middleware
+func (siw *ServerInterfaceWrapper) GetSourceData(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	r.HandleFunc(options.BaseURL+"/jobs/{jobID}/progress", wrapper.GetJobProgress).Methods("GET")
+	var err error
 
-	r.HandleFunc(options.BaseURL+"/jobs/{jobID}/results", wrapper.GetJobResults).Methods("GET")
+	// ------------- Path parameter "sourceID" -------------
+	var sourceID openapi_types.UUID
 
-	r.HandleFunc(options.BaseURL+"/jobs/{jobID}/rows", wrapper.GetRowsProgress).Methods("GET")
+	err = runtime.BindStyledParameterWithOptions("simple", "sourceID", mux.Vars(r)["sourceID"], &sourceID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceID", Err: err})
+		return
+	}
 
-	r.HandleFunc(options.BaseURL+"/me", wrapper.GetMe).Methods("GET")
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	r.HandleFunc(options.BaseURL+"/select-key", wrapper.SelectKey).Methods("POST")
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSourceDataParams
 
-	r.HandleFunc(options.BaseURL+"/sources", wrapper.ListSources).Methods("GET")
+	// ------------- Optional query parameter "offset" -------------
 
-	r.HandleFunc(options.BaseURL+"/sources/{sourceID}", wrapper.GetSource).Methods("GET")
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-	r.HandleFunc(options.BaseURL+"/sources/{sourceID}/data", wrapper.GetSourceData).Methods("GET")
+	// ------------- Optional query parameter "limit" -------------
 
-	r.HandleFunc(options.BaseURL+"/sources/{sourceID}/enrich", wrapper.EnrichSource).Methods("POST")
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-	r.HandleFunc(options.BaseURL+"/subscribe", wrapper.CreateSubscriptionCheckout).Methods("POST")
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSourceData(w, r, sourceID, params)
+	}))
 
-	r.HandleFunc(options.BaseURL+"/subscription", wrapper.GetSubscriptionStatus).Methods("GET")
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	r.HandleFunc(options.BaseURL+"/subscription/cancel", wrapper.CancelSubscription).Methods("POST")
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
 
-	r.HandleFunc(options.BaseURL+"/subscription/portal", wrapper.CreatePortalSession).Methods("POST")
+// ResetSourceDialect operation middleware
+func (siw *ServerInterfaceWrapper) ResetSourceDialect(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	r.HandleFunc(options.BaseURL+"/subscription/upgrade", wrapper.UpgradeSubscription).Methods("POST")
+	var err error
 
-	r.HandleFunc(options.BaseURL+"/templates", wrapper.ListTemplates).Methods("GET")
+	// ------------- Path parameter "sourceID" -------------
+	var sourceID openapi_types.UUID
 
-	r.HandleFunc(options.BaseURL+"/tiers", wrapper.ListTiers).Methods("GET")
+	err = runtime.BindStyledParameterWithOptions("simple", "sourceID", mux.Vars(r)["sourceID"], &sourceID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceID", Err: err})
+		return
+	}
 
-	r.HandleFunc(options.BaseURL+"/webhooks/stripe", wrapper.HandleStripeWebhook).Methods("POST")
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return r
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResetSourceDialect(w, r, sourceID)
+	}))
 
-type UploadFileForEnrichmentRequestObject struct {
-	Body *UploadFileForEnrichmentJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type UploadFileForEnrichmentResponseObject interface {
-	VisitUploadFileForEnrichmentResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UploadFileForEnrichment200JSONResponse SignedURLResponse
-
-func (response UploadFileForEnrichment200JSONResponse) VisitUploadFileForEnrichmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetSourceDialect operation middleware
+func (siw *ServerInterfaceWrapper) GetSourceDialect(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type UploadFileForEnrichment400JSONResponse ErrorResponse
+	// ------------- Path parameter "sourceID" -------------
+	var sourceID openapi_types.UUID
 
-func (response UploadFileForEnrichment400JSONResponse) VisitUploadFileForEnrichmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	err = runtime.BindStyledParameterWithOptions("simple", "sourceID", mux.Vars(r)["sourceID"], &sourceID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceID", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type UploadFileForEnrichment401JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSourceDialect(w, r, sourceID)
+	}))
 
-func (response UploadFileForEnrichment401JSONResponse) VisitUploadFileForEnrichmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type UploadFileForEnrichment500JSONResponse ErrorResponse
+// SetSourceDialect operation middleware
+func (siw *ServerInterfaceWrapper) SetSourceDialect(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response UploadFileForEnrichment500JSONResponse) VisitUploadFileForEnrichmentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "sourceID" -------------
+	var sourceID openapi_types.UUID
 
-type CancelJobRequestObject struct {
-	JobID string `json:"jobID"`
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "sourceID", mux.Vars(r)["sourceID"], &sourceID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceID", Err: err})
+		return
+	}
 
-type CancelJobResponseObject interface {
-	VisitCancelJobResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type CancelJob200JSONResponse struct {
-	Message string `json:"message"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetSourceDialect(w, r, sourceID)
+	}))
 
-func (response CancelJob200JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CancelJob404JSONResponse ErrorResponse
+// EnrichSource operation middleware
+func (siw *ServerInterfaceWrapper) EnrichSource(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response CancelJob404JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "sourceID" -------------
+	var sourceID openapi_types.UUID
 
-type CancelJob500JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "sourceID", mux.Vars(r)["sourceID"], &sourceID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceID", Err: err})
+		return
+	}
 
-func (response CancelJob500JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EnrichSource(w, r, sourceID)
+	}))
 
-type GetJobProgressRequestObject struct {
-	JobID string `json:"jobID"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetJobProgressResponseObject interface {
-	VisitGetJobProgressResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetJobProgress200JSONResponse JobProgressResponse
+// CreateSourceRevision operation middleware
+func (siw *ServerInterfaceWrapper) CreateSourceRevision(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetJobProgress200JSONResponse) VisitGetJobProgressResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "sourceID" -------------
+	var sourceID openapi_types.UUID
 
-type GetJobProgress404JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "sourceID", mux.Vars(r)["sourceID"], &sourceID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceID", Err: err})
+		return
+	}
 
-func (response GetJobProgress404JSONResponse) VisitGetJobProgressResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSourceRevision(w, r, sourceID)
+	}))
 
-type GetJobResultsRequestObject struct {
-	JobID  string `json:"jobID"`
-	Params GetJobResultsParams
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetJobResultsResponseObject interface {
-	VisitGetJobResultsResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetJobResults200JSONResponse []EnrichmentResult
-
-func (response GetJobResults200JSONResponse) VisitGetJobResultsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ConfirmSourceRevision operation middleware
+func (siw *ServerInterfaceWrapper) ConfirmSourceRevision(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type GetJobResults500JSONResponse ErrorResponse
+	// ------------- Path parameter "sourceID" -------------
+	var sourceID openapi_types.UUID
 
-func (response GetJobResults500JSONResponse) VisitGetJobResultsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	err = runtime.BindStyledParameterWithOptions("simple", "sourceID", mux.Vars(r)["sourceID"], &sourceID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceID", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "revision" -------------
+	var revision int
 
-type GetRowsProgressRequestObject struct {
-	JobID  string `json:"jobID"`
-	Params GetRowsProgressParams
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", mux.Vars(r)["revision"], &revision, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "revision", Err: err})
+		return
+	}
 
-type GetRowsProgressResponseObject interface {
-	VisitGetRowsProgressResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetRowsProgress200JSONResponse RowsProgressResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ConfirmSourceRevision(w, r, sourceID, revision)
+	}))
 
-func (response GetRowsProgress200JSONResponse) VisitGetRowsProgressResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetRowsProgress400JSONResponse ErrorResponse
+// ListSourceSheets operation middleware
+func (siw *ServerInterfaceWrapper) ListSourceSheets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetRowsProgress400JSONResponse) VisitGetRowsProgressResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "sourceID" -------------
+	var sourceID openapi_types.UUID
 
-type GetRowsProgress500JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "sourceID", mux.Vars(r)["sourceID"], &sourceID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceID", Err: err})
+		return
+	}
 
-func (response GetRowsProgress500JSONResponse) VisitGetRowsProgressResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListSourceSheets(w, r, sourceID)
+	}))
 
-type GetMeRequestObject struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type GetMeResponseObject interface {
-	VisitGetMeResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetMe200JSONResponse UserResponse
+// SelectSourceSheet operation middleware
+func (siw *ServerInterfaceWrapper) SelectSourceSheet(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetMe200JSONResponse) VisitGetMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "sourceID" -------------
+	var sourceID openapi_types.UUID
 
-type GetMe401JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "sourceID", mux.Vars(r)["sourceID"], &sourceID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "sourceID", Err: err})
+		return
+	}
 
-func (response GetMe401JSONResponse) VisitGetMeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SelectSourceSheet(w, r, sourceID)
+	}))
 
-type SelectKeyRequestObject struct {
-	Body *SelectKeyJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type SelectKeyResponseObject interface {
-	VisitSelectKeyResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type SelectKey200JSONResponse SelectKeyResponse
-
-func (response SelectKey200JSONResponse) VisitSelectKeyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// CreateSubscriptionCheckout operation middleware
+func (siw *ServerInterfaceWrapper) CreateSubscriptionCheckout(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type SelectKey400JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSubscriptionCheckout(w, r)
+	}))
 
-func (response SelectKey400JSONResponse) VisitSelectKeyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type SelectKey401JSONResponse ErrorResponse
-
-func (response SelectKey401JSONResponse) VisitSelectKeyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// GetSubscriptionStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type SelectKey403JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubscriptionStatus(w, r)
+	}))
 
-func (response SelectKey403JSONResponse) VisitSelectKeyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type SelectKey404JSONResponse ErrorResponse
-
-func (response SelectKey404JSONResponse) VisitSelectKeyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+// CancelSubscription operation middleware
+func (siw *ServerInterfaceWrapper) CancelSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type SelectKey500JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelSubscription(w, r)
+	}))
 
-func (response SelectKey500JSONResponse) VisitSelectKeyResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type ListSourcesRequestObject struct {
-	Params ListSourcesParams
-}
+// CreatePortalSession operation middleware
+func (siw *ServerInterfaceWrapper) CreatePortalSession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type ListSourcesResponseObject interface {
-	VisitListSourcesResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type ListSources200JSONResponse SourceListResponse
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-func (response ListSources200JSONResponse) VisitListSourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreatePortalSessionParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Required query parameter "return_url" -------------
 
-type ListSources401JSONResponse ErrorResponse
+	if paramValue := r.URL.Query().Get("return_url"); paramValue != "" {
 
-func (response ListSources401JSONResponse) VisitListSourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "return_url"})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, true, "return_url", r.URL.Query(), &params.ReturnUrl)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "return_url", Err: err})
+		return
+	}
 
-type ListSources500JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreatePortalSession(w, r, params)
+	}))
 
-func (response ListSources500JSONResponse) VisitListSourcesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetSourceRequestObject struct {
-	SourceID openapi_types.UUID `json:"sourceID"`
-}
+// UpgradeSubscription operation middleware
+func (siw *ServerInterfaceWrapper) UpgradeSubscription(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetSourceResponseObject interface {
-	VisitGetSourceResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetSource200JSONResponse SourceDetail
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpgradeSubscription(w, r)
+	}))
 
-func (response GetSource200JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetSource401JSONResponse ErrorResponse
-
-func (response GetSource401JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// ListTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListTemplates(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetSource403JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTemplates(w, r)
+	}))
 
-func (response GetSource403JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetSource404JSONResponse ErrorResponse
+// ListTiers operation middleware
+func (siw *ServerInterfaceWrapper) ListTiers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetSource404JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetSource500JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListTiers(w, r)
+	}))
 
-func (response GetSource500JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetSourceDataRequestObject struct {
-	SourceID openapi_types.UUID `json:"sourceID"`
-}
+// ListWebhookEndpoints operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookEndpoints(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-type GetSourceDataResponseObject interface {
-	VisitGetSourceDataResponse(w http.ResponseWriter) error
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetSourceData200JSONResponse struct {
-	Headers []string   `json:"headers"`
-	Rows    [][]string `json:"rows"`
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhookEndpoints(w, r)
+	}))
 
-func (response GetSourceData200JSONResponse) VisitGetSourceDataResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetSourceData401JSONResponse ErrorResponse
-
-func (response GetSourceData401JSONResponse) VisitGetSourceDataResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+// CreateWebhookEndpoint operation middleware
+func (siw *ServerInterfaceWrapper) CreateWebhookEndpoint(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-type GetSourceData403JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateWebhookEndpoint(w, r)
+	}))
 
-func (response GetSourceData403JSONResponse) VisitGetSourceDataResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type GetSourceData404JSONResponse ErrorResponse
+// DeleteWebhookEndpoint operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWebhookEndpoint(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response GetSourceData404JSONResponse) VisitGetSourceDataResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "endpointID" -------------
+	var endpointID openapi_types.UUID
 
-type GetSourceData500JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "endpointID", mux.Vars(r)["endpointID"], &endpointID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endpointID", Err: err})
+		return
+	}
 
-func (response GetSourceData500JSONResponse) VisitGetSourceDataResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteWebhookEndpoint(w, r, endpointID)
+	}))
 
-type EnrichSourceRequestObject struct {
-	SourceID openapi_types.UUID `json:"sourceID"`
-	Body     *EnrichSourceJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-type EnrichSourceResponseObject interface {
-	VisitEnrichSourceResponse(w http.ResponseWriter) error
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type EnrichSource200JSONResponse EnrichResponse
+// ListWebhookDeliveries operation middleware
+func (siw *ServerInterfaceWrapper) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response EnrichSource200JSONResponse) VisitEnrichSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "endpointID" -------------
+	var endpointID openapi_types.UUID
 
-type EnrichSource400JSONResponse ErrorResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "endpointID", mux.Vars(r)["endpointID"], &endpointID, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "endpointID", Err: err})
+		return
+	}
 
-func (response EnrichSource400JSONResponse) VisitEnrichSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx = context.WithValue(ctx, BearerAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListWebhookDeliveriesParams
 
-type EnrichSource401JSONResponse ErrorResponse
+	// ------------- Optional query parameter "job_id" -------------
 
-func (response EnrichSource401JSONResponse) VisitEnrichSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	err = runtime.BindQueryParameter("form", true, false, "job_id", r.URL.Query(), &params.JobId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "job_id", Err: err})
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "offset" -------------
 
-type EnrichSource402JSONResponse ErrorResponse
+	err = runtime.BindQueryParameter("form", true, false, "offset", r.URL.Query(), &params.Offset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "offset", Err: err})
+		return
+	}
 
-func (response EnrichSource402JSONResponse) VisitEnrichSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(402)
+	// ------------- Optional query parameter "limit" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
 
-type EnrichSource403JSONResponse ErrorResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListWebhookDeliveries(w, r, endpointID, params)
+	}))
 
-func (response EnrichSource403JSONResponse) VisitEnrichSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type EnrichSource404JSONResponse ErrorResponse
+// HandleStripeWebhook operation middleware
+func (siw *ServerInterfaceWrapper) HandleStripeWebhook(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-func (response EnrichSource404JSONResponse) VisitEnrichSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params HandleStripeWebhookParams
 
-type EnrichSource500JSONResponse ErrorResponse
+	headers := r.Header
 
-func (response EnrichSource500JSONResponse) VisitEnrichSourceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	// ------------- Required header parameter "Stripe-Signature" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Stripe-Signature")]; found {
+		var StripeSignature string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Stripe-Signature", Count: n})
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		err = runtime.BindStyledParameterWithOptions("simple", "Stripe-Signature", valueList[0], &StripeSignature, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Stripe-Signature", Err: err})
+			return
+		}
 
-type CreateSubscriptionCheckoutRequestObject struct {
-	Body *CreateSubscriptionCheckoutJSONRequestBody
-}
+		params.StripeSignature = StripeSignature
 
-type CreateSubscriptionCheckoutResponseObject interface {
-	VisitCreateSubscriptionCheckoutResponse(w http.ResponseWriter) error
-}
+	} else {
+		err = fmt.Errorf("Header parameter Stripe-Signature is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Stripe-Signature", Err: err})
+		return
+	}
 
-type CreateSubscriptionCheckout200JSONResponse CreateCheckoutResponse
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HandleStripeWebhook(w, r, params)
+	}))
 
-func (response CreateSubscriptionCheckout200JSONResponse) VisitCreateSubscriptionCheckoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
-type CreateSubscriptionCheckout400JSONResponse ErrorResponse
-
-func (response CreateSubscriptionCheckout400JSONResponse) VisitCreateSubscriptionCheckoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
 }
 
-type CreateSubscriptionCheckout500JSONResponse ErrorResponse
-
-func (response CreateSubscriptionCheckout500JSONResponse) VisitCreateSubscriptionCheckoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
 }
 
-type GetSubscriptionStatusRequestObject struct {
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
 }
 
-type GetSubscriptionStatusResponseObject interface {
-	VisitGetSubscriptionStatusResponse(w http.ResponseWriter) error
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
 }
 
-type GetSubscriptionStatus200JSONResponse SubscriptionStatusResponse
-
-func (response GetSubscriptionStatus200JSONResponse) VisitGetSubscriptionStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
 }
 
-type GetSubscriptionStatus400JSONResponse ErrorResponse
-
-func (response GetSubscriptionStatus400JSONResponse) VisitGetSubscriptionStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
 }
 
-type CancelSubscriptionRequestObject struct {
+type RequiredParamError struct {
+	ParamName string
 }
 
-type CancelSubscriptionResponseObject interface {
-	VisitCancelSubscriptionResponse(w http.ResponseWriter) error
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
 }
 
-type CancelSubscription200JSONResponse struct {
-	Message string `json:"message"`
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
 }
 
-func (response CancelSubscription200JSONResponse) VisitCancelSubscriptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
 }
 
-type CancelSubscription400JSONResponse ErrorResponse
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
 
-func (response CancelSubscription400JSONResponse) VisitCancelSubscriptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
 }
 
-type CancelSubscription500JSONResponse ErrorResponse
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
 
-func (response CancelSubscription500JSONResponse) VisitCancelSubscriptionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
 
-	return json.NewEncoder(w).Encode(response)
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{})
 }
 
-type CreatePortalSessionRequestObject struct {
-	Params CreatePortalSessionParams
+type GorillaServerOptions struct {
+	BaseURL          string
+	BaseRouter       *mux.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
 }
 
-type CreatePortalSessionResponseObject interface {
-	VisitCreatePortalSessionResponse(w http.ResponseWriter) error
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r *mux.Router) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseRouter: r,
+	})
 }
 
-type CreatePortalSession200JSONResponse struct {
-	Url string `json:"url"`
+func HandlerFromMuxWithBaseURL(si ServerInterface, r *mux.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, GorillaServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
 }
 
-func (response CreatePortalSession200JSONResponse) VisitCreatePortalSessionResponse(w http.ResponseWriter) error {
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options GorillaServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = mux.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.HandleFunc(options.BaseURL+"/api-keys", wrapper.ListAPIKeys).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/api-keys", wrapper.CreateAPIKey).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/api-keys/{keyID}", wrapper.RevokeAPIKey).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/enrichment-signed-url", wrapper.UploadFileForEnrichment).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/exports/{exportID}", wrapper.GetExport).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/jobs/{jobID}/cancel", wrapper.CancelJob).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/jobs/{jobID}/corrections", wrapper.ListJobCorrections).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/jobs/{jobID}/corrections", wrapper.CorrectJobCell).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/jobs/{jobID}/diff", wrapper.DiffJobs).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/jobs/{jobID}/diff/csv", wrapper.ExportJobDiff).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/jobs/{jobID}/events", wrapper.StreamJobEvents).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/jobs/{jobID}/exports", wrapper.ListJobExports).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/jobs/{jobID}/exports", wrapper.CreateJobExport).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/jobs/{jobID}/progress", wrapper.GetJobProgress).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/jobs/{jobID}/rerun", wrapper.RerunJobRows).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/jobs/{jobID}/results", wrapper.GetJobResults).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/jobs/{jobID}/rows", wrapper.GetRowsProgress).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/lookups", wrapper.LookupEntity).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/me", wrapper.GetMe).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/select-key", wrapper.SelectKey).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/source-connections", wrapper.ListSourceConnections).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/sources", wrapper.ListSources).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/sources/sql", wrapper.CreateSQLSource).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/sources/{sourceID}", wrapper.GetSource).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/sources/{sourceID}/data", wrapper.GetSourceData).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/sources/{sourceID}/dialect", wrapper.ResetSourceDialect).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/sources/{sourceID}/dialect", wrapper.GetSourceDialect).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/sources/{sourceID}/dialect", wrapper.SetSourceDialect).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/sources/{sourceID}/enrich", wrapper.EnrichSource).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/sources/{sourceID}/revisions", wrapper.CreateSourceRevision).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/sources/{sourceID}/revisions/{revision}/confirm", wrapper.ConfirmSourceRevision).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/sources/{sourceID}/sheets", wrapper.ListSourceSheets).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/sources/{sourceID}/sheets", wrapper.SelectSourceSheet).Methods("PUT")
+
+	r.HandleFunc(options.BaseURL+"/subscribe", wrapper.CreateSubscriptionCheckout).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/subscription", wrapper.GetSubscriptionStatus).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/subscription/cancel", wrapper.CancelSubscription).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/subscription/portal", wrapper.CreatePortalSession).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/subscription/upgrade", wrapper.UpgradeSubscription).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/templates", wrapper.ListTemplates).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/tiers", wrapper.ListTiers).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/webhook-endpoints", wrapper.ListWebhookEndpoints).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/webhook-endpoints", wrapper.CreateWebhookEndpoint).Methods("POST")
+
+	r.HandleFunc(options.BaseURL+"/webhook-endpoints/{endpointID}", wrapper.DeleteWebhookEndpoint).Methods("DELETE")
+
+	r.HandleFunc(options.BaseURL+"/webhook-endpoints/{endpointID}/deliveries", wrapper.ListWebhookDeliveries).Methods("GET")
+
+	r.HandleFunc(options.BaseURL+"/webhooks/stripe", wrapper.HandleStripeWebhook).Methods("POST")
+
+	return r
+}
+
+type ListAPIKeysRequestObject struct {
+}
+
+type ListAPIKeysResponseObject interface {
+	VisitListAPIKeysResponse(w http.ResponseWriter) error
+}
+
+type ListAPIKeys200JSONResponse APIKeyListResponse
+
+func (response ListAPIKeys200JSONResponse) VisitListAPIKeysResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreatePortalSession400JSONResponse ErrorResponse
+type ListAPIKeys401JSONResponse ErrorResponse
 
-func (response CreatePortalSession400JSONResponse) VisitCreatePortalSessionResponse(w http.ResponseWriter) error {
+func (response ListAPIKeys401JSONResponse) VisitListAPIKeysResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreatePortalSession500JSONResponse ErrorResponse
+type ListAPIKeys500JSONResponse ErrorResponse
 
-func (response CreatePortalSession500JSONResponse) VisitCreatePortalSessionResponse(w http.ResponseWriter) error {
+func (response ListAPIKeys500JSONResponse) VisitListAPIKeysResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpgradeSubscriptionRequestObject struct {
-	Body *UpgradeSubscriptionJSONRequestBody
+type CreateAPIKeyRequestObject struct {
+	Body *CreateAPIKeyJSONRequestBody
 }
 
-type UpgradeSubscriptionResponseObject interface {
-	VisitUpgradeSubscriptionResponse(w http.ResponseWriter) error
+type CreateAPIKeyResponseObject interface {
+	VisitCreateAPIKeyResponse(w http.ResponseWriter) error
 }
 
-type UpgradeSubscription200JSONResponse struct {
-	Message string `json:"message"`
-}
+type CreateAPIKey201JSONResponse APIKeyResponse
 
-func (response UpgradeSubscription200JSONResponse) VisitUpgradeSubscriptionResponse(w http.ResponseWriter) error {
+func (response CreateAPIKey201JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpgradeSubscription400JSONResponse ErrorResponse
+type CreateAPIKey400JSONResponse ErrorResponse
 
-func (response UpgradeSubscription400JSONResponse) VisitUpgradeSubscriptionResponse(w http.ResponseWriter) error {
+func (response CreateAPIKey400JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpgradeSubscription500JSONResponse ErrorResponse
+type CreateAPIKey401JSONResponse ErrorResponse
 
-func (response UpgradeSubscription500JSONResponse) VisitUpgradeSubscriptionResponse(w http.ResponseWriter) error {
+func (response CreateAPIKey401JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAPIKey500JSONResponse ErrorResponse
+
+func (response CreateAPIKey500JSONResponse) VisitCreateAPIKeyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListTemplatesRequestObject struct {
+type RevokeAPIKeyRequestObject struct {
+	KeyID openapi_types.UUID `json:"keyID"`
 }
 
-type ListTemplatesResponseObject interface {
-	VisitListTemplatesResponse(w http.ResponseWriter) error
+type RevokeAPIKeyResponseObject interface {
+	VisitRevokeAPIKeyResponse(w http.ResponseWriter) error
 }
 
-type ListTemplates200JSONResponse TemplateListResponse
+type RevokeAPIKey204Response struct {
+}
 
-func (response ListTemplates200JSONResponse) VisitListTemplatesResponse(w http.ResponseWriter) error {
+func (response RevokeAPIKey204Response) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RevokeAPIKey401JSONResponse ErrorResponse
+
+func (response RevokeAPIKey401JSONResponse) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListTemplates401JSONResponse ErrorResponse
+type RevokeAPIKey403JSONResponse ErrorResponse
 
-func (response ListTemplates401JSONResponse) VisitListTemplatesResponse(w http.ResponseWriter) error {
+func (response RevokeAPIKey403JSONResponse) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListTemplates500JSONResponse ErrorResponse
+type RevokeAPIKey404JSONResponse ErrorResponse
 
-func (response ListTemplates500JSONResponse) VisitListTemplatesResponse(w http.ResponseWriter) error {
+func (response RevokeAPIKey404JSONResponse) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevokeAPIKey500JSONResponse ErrorResponse
+
+func (response RevokeAPIKey500JSONResponse) VisitRevokeAPIKeyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListTiersRequestObject struct {
+type UploadFileForEnrichmentRequestObject struct {
+	Body *UploadFileForEnrichmentJSONRequestBody
 }
 
-type ListTiersResponseObject interface {
-	VisitListTiersResponse(w http.ResponseWriter) error
+type UploadFileForEnrichmentResponseObject interface {
+	VisitUploadFileForEnrichmentResponse(w http.ResponseWriter) error
 }
 
-type ListTiers200JSONResponse []TierResponse
+type UploadFileForEnrichment200JSONResponse SignedURLResponse
 
-func (response ListTiers200JSONResponse) VisitListTiersResponse(w http.ResponseWriter) error {
+func (response UploadFileForEnrichment200JSONResponse) VisitUploadFileForEnrichmentResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type HandleStripeWebhookRequestObject struct {
-	Params HandleStripeWebhookParams
-	Body   io.Reader
+type UploadFileForEnrichment400JSONResponse ErrorResponse
+
+func (response UploadFileForEnrichment400JSONResponse) VisitUploadFileForEnrichmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type HandleStripeWebhookResponseObject interface {
-	VisitHandleStripeWebhookResponse(w http.ResponseWriter) error
+type UploadFileForEnrichment401JSONResponse ErrorResponse
+
+func (response UploadFileForEnrichment401JSONResponse) VisitUploadFileForEnrichmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type HandleStripeWebhook200Response struct {
+type UploadFileForEnrichment500JSONResponse ErrorResponse
+
+func (response UploadFileForEnrichment500JSONResponse) VisitUploadFileForEnrichmentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-func (response HandleStripeWebhook200Response) VisitHandleStripeWebhookResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
+type GetExportRequestObject struct {
+	ExportID openapi_types.UUID `json:"exportID"`
 }
 
-type HandleStripeWebhook400JSONResponse ErrorResponse
+type GetExportResponseObject interface {
+	VisitGetExportResponse(w http.ResponseWriter) error
+}
 
-func (response HandleStripeWebhook400JSONResponse) VisitHandleStripeWebhookResponse(w http.ResponseWriter) error {
+type GetExport200JSONResponse ExportResponse
+
+func (response GetExport200JSONResponse) VisitGetExportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type HandleStripeWebhook401JSONResponse ErrorResponse
+type GetExport401JSONResponse ErrorResponse
 
-func (response HandleStripeWebhook401JSONResponse) VisitHandleStripeWebhookResponse(w http.ResponseWriter) error {
+func (response GetExport401JSONResponse) VisitGetExportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type HandleStripeWebhook500JSONResponse ErrorResponse
+type GetExport403JSONResponse ErrorResponse
 
-func (response HandleStripeWebhook500JSONResponse) VisitHandleStripeWebhookResponse(w http.ResponseWriter) error {
+func (response GetExport403JSONResponse) VisitGetExportResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
+type GetExport404JSONResponse ErrorResponse
+
+func (response GetExport404JSONResponse) VisitGetExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetExport500JSONResponse ErrorResponse
+
+func (response GetExport500JSONResponse) VisitGetExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJobRequestObject struct {
+	JobID string `json:"jobID"`
+}
+
+type CancelJobResponseObject interface {
+	VisitCancelJobResponse(w http.ResponseWriter) error
+}
+
+type CancelJob200JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response CancelJob200JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob404JSONResponse ErrorResponse
+
+func (response CancelJob404JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelJob500JSONResponse ErrorResponse
+
+func (response CancelJob500JSONResponse) VisitCancelJobResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListJobCorrectionsRequestObject struct {
+	JobID  string `json:"jobID"`
+	Params ListJobCorrectionsParams
+}
+
+type ListJobCorrectionsResponseObject interface {
+	VisitListJobCorrectionsResponse(w http.ResponseWriter) error
+}
+
+type ListJobCorrections200JSONResponse CellCorrectionListResponse
+
+func (response ListJobCorrections200JSONResponse) VisitListJobCorrectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListJobCorrections401JSONResponse ErrorResponse
+
+func (response ListJobCorrections401JSONResponse) VisitListJobCorrectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListJobCorrections403JSONResponse ErrorResponse
+
+func (response ListJobCorrections403JSONResponse) VisitListJobCorrectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListJobCorrections404JSONResponse ErrorResponse
+
+func (response ListJobCorrections404JSONResponse) VisitListJobCorrectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListJobCorrections500JSONResponse ErrorResponse
+
+func (response ListJobCorrections500JSONResponse) VisitListJobCorrectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CorrectJobCellRequestObject struct {
+	JobID string `json:"jobID"`
+	Body  *CorrectJobCellJSONRequestBody
+}
+
+type CorrectJobCellResponseObject interface {
+	VisitCorrectJobCellResponse(w http.ResponseWriter) error
+}
+
+type CorrectJobCell200JSONResponse CellCorrectionResponse
+
+func (response CorrectJobCell200JSONResponse) VisitCorrectJobCellResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CorrectJobCell400JSONResponse ErrorResponse
+
+func (response CorrectJobCell400JSONResponse) VisitCorrectJobCellResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CorrectJobCell401JSONResponse ErrorResponse
+
+func (response CorrectJobCell401JSONResponse) VisitCorrectJobCellResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CorrectJobCell403JSONResponse ErrorResponse
+
+func (response CorrectJobCell403JSONResponse) VisitCorrectJobCellResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CorrectJobCell404JSONResponse ErrorResponse
+
+func (response CorrectJobCell404JSONResponse) VisitCorrectJobCellResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CorrectJobCell500JSONResponse ErrorResponse
+
+func (response CorrectJobCell500JSONResponse) VisitCorrectJobCellResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffJobsRequestObject struct {
+	JobID  string `json:"jobID"`
+	Params DiffJobsParams
+}
+
+type DiffJobsResponseObject interface {
+	VisitDiffJobsResponse(w http.ResponseWriter) error
+}
+
+type DiffJobs200JSONResponse JobDiffResponse
+
+func (response DiffJobs200JSONResponse) VisitDiffJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffJobs400JSONResponse ErrorResponse
+
+func (response DiffJobs400JSONResponse) VisitDiffJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffJobs401JSONResponse ErrorResponse
+
+func (response DiffJobs401JSONResponse) VisitDiffJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffJobs403JSONResponse ErrorResponse
+
+func (response DiffJobs403JSONResponse) VisitDiffJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffJobs404JSONResponse ErrorResponse
+
+func (response DiffJobs404JSONResponse) VisitDiffJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffJobs500JSONResponse ErrorResponse
+
+func (response DiffJobs500JSONResponse) VisitDiffJobsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportJobDiffRequestObject struct {
+	JobID  string `json:"jobID"`
+	Params ExportJobDiffParams
+}
+
+type ExportJobDiffResponseObject interface {
+	VisitExportJobDiffResponse(w http.ResponseWriter) error
+}
+
+type ExportJobDiff200TextcsvResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportJobDiff200TextcsvResponse) VisitExportJobDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/csv")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportJobDiff400JSONResponse ErrorResponse
+
+func (response ExportJobDiff400JSONResponse) VisitExportJobDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportJobDiff401JSONResponse ErrorResponse
+
+func (response ExportJobDiff401JSONResponse) VisitExportJobDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportJobDiff403JSONResponse ErrorResponse
+
+func (response ExportJobDiff403JSONResponse) VisitExportJobDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportJobDiff404JSONResponse ErrorResponse
+
+func (response ExportJobDiff404JSONResponse) VisitExportJobDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportJobDiff500JSONResponse ErrorResponse
+
+func (response ExportJobDiff500JSONResponse) VisitExportJobDiffResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamJobEventsRequestObject struct {
+	JobID  string `json:"jobID"`
+	Params StreamJobEventsParams
+}
+
+type StreamJobEventsResponseObject interface {
+	VisitStreamJobEventsResponse(w http.ResponseWriter) error
+}
+
+type StreamJobEvents200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response StreamJobEvents200TexteventStreamResponse) VisitStreamJobEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type StreamJobEvents400JSONResponse ErrorResponse
+
+func (response StreamJobEvents400JSONResponse) VisitStreamJobEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamJobEvents401JSONResponse ErrorResponse
+
+func (response StreamJobEvents401JSONResponse) VisitStreamJobEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamJobEvents403JSONResponse ErrorResponse
+
+func (response StreamJobEvents403JSONResponse) VisitStreamJobEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamJobEvents404JSONResponse ErrorResponse
+
+func (response StreamJobEvents404JSONResponse) VisitStreamJobEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StreamJobEvents500JSONResponse ErrorResponse
+
+func (response StreamJobEvents500JSONResponse) VisitStreamJobEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListJobExportsRequestObject struct {
+	JobID string `json:"jobID"`
+}
+
+type ListJobExportsResponseObject interface {
+	VisitListJobExportsResponse(w http.ResponseWriter) error
+}
+
+type ListJobExports200JSONResponse ExportListResponse
+
+func (response ListJobExports200JSONResponse) VisitListJobExportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListJobExports401JSONResponse ErrorResponse
+
+func (response ListJobExports401JSONResponse) VisitListJobExportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListJobExports403JSONResponse ErrorResponse
+
+func (response ListJobExports403JSONResponse) VisitListJobExportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListJobExports404JSONResponse ErrorResponse
+
+func (response ListJobExports404JSONResponse) VisitListJobExportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListJobExports500JSONResponse ErrorResponse
+
+func (response ListJobExports500JSONResponse) VisitListJobExportsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJobExportRequestObject struct {
+	JobID string `json:"jobID"`
+	Body  *CreateJobExportJSONRequestBody
+}
+
+type CreateJobExportResponseObject interface {
+	VisitCreateJobExportResponse(w http.ResponseWriter) error
+}
+
+type CreateJobExport202JSONResponse ExportResponse
+
+func (response CreateJobExport202JSONResponse) VisitCreateJobExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJobExport400JSONResponse ErrorResponse
+
+func (response CreateJobExport400JSONResponse) VisitCreateJobExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJobExport401JSONResponse ErrorResponse
+
+func (response CreateJobExport401JSONResponse) VisitCreateJobExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJobExport403JSONResponse ErrorResponse
+
+func (response CreateJobExport403JSONResponse) VisitCreateJobExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJobExport404JSONResponse ErrorResponse
+
+func (response CreateJobExport404JSONResponse) VisitCreateJobExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateJobExport500JSONResponse ErrorResponse
+
+func (response CreateJobExport500JSONResponse) VisitCreateJobExportResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJobProgressRequestObject struct {
+	JobID string `json:"jobID"`
+}
+
+type GetJobProgressResponseObject interface {
+	VisitGetJobProgressResponse(w http.ResponseWriter) error
+}
+
+type GetJobProgress200JSONResponse JobProgressResponse
+
+func (response GetJobProgress200JSONResponse) VisitGetJobProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJobProgress404JSONResponse ErrorResponse
+
+func (response GetJobProgress404JSONResponse) VisitGetJobProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RerunJobRowsRequestObject struct {
+	JobID string `json:"jobID"`
+	Body  *RerunJobRowsJSONRequestBody
+}
+
+type RerunJobRowsResponseObject interface {
+	VisitRerunJobRowsResponse(w http.ResponseWriter) error
+}
+
+type RerunJobRows202JSONResponse RerunResponse
+
+func (response RerunJobRows202JSONResponse) VisitRerunJobRowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RerunJobRows400JSONResponse ErrorResponse
+
+func (response RerunJobRows400JSONResponse) VisitRerunJobRowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RerunJobRows401JSONResponse ErrorResponse
+
+func (response RerunJobRows401JSONResponse) VisitRerunJobRowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RerunJobRows402JSONResponse ErrorResponse
+
+func (response RerunJobRows402JSONResponse) VisitRerunJobRowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(402)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RerunJobRows403JSONResponse ErrorResponse
+
+func (response RerunJobRows403JSONResponse) VisitRerunJobRowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RerunJobRows404JSONResponse ErrorResponse
+
+func (response RerunJobRows404JSONResponse) VisitRerunJobRowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RerunJobRows500JSONResponse ErrorResponse
+
+func (response RerunJobRows500JSONResponse) VisitRerunJobRowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJobResultsRequestObject struct {
+	JobID  string `json:"jobID"`
+	Params GetJobResultsParams
+}
+
+type GetJobResultsResponseObject interface {
+	VisitGetJobResultsResponse(w http.ResponseWriter) error
+}
+
+type GetJobResults200JSONResponse []EnrichmentResult
+
+func (response GetJobResults200JSONResponse) VisitGetJobResultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetJobResults500JSONResponse ErrorResponse
+
+func (response GetJobResults500JSONResponse) VisitGetJobResultsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRowsProgressRequestObject struct {
+	JobID  string `json:"jobID"`
+	Params GetRowsProgressParams
+}
+
+type GetRowsProgressResponseObject interface {
+	VisitGetRowsProgressResponse(w http.ResponseWriter) error
+}
+
+type GetRowsProgress200JSONResponse RowsProgressResponse
+
+func (response GetRowsProgress200JSONResponse) VisitGetRowsProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRowsProgress400JSONResponse ErrorResponse
+
+func (response GetRowsProgress400JSONResponse) VisitGetRowsProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRowsProgress500JSONResponse ErrorResponse
+
+func (response GetRowsProgress500JSONResponse) VisitGetRowsProgressResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LookupEntityRequestObject struct {
+	Body *LookupEntityJSONRequestBody
+}
+
+type LookupEntityResponseObject interface {
+	VisitLookupEntityResponse(w http.ResponseWriter) error
+}
+
+type LookupEntity200JSONResponse LookupResponse
+
+func (response LookupEntity200JSONResponse) VisitLookupEntityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LookupEntity400JSONResponse ErrorResponse
+
+func (response LookupEntity400JSONResponse) VisitLookupEntityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LookupEntity401JSONResponse ErrorResponse
+
+func (response LookupEntity401JSONResponse) VisitLookupEntityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LookupEntity402JSONResponse ErrorResponse
+
+func (response LookupEntity402JSONResponse) VisitLookupEntityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(402)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LookupEntity500JSONResponse ErrorResponse
+
+func (response LookupEntity500JSONResponse) VisitLookupEntityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LookupEntity504JSONResponse ErrorResponse
+
+func (response LookupEntity504JSONResponse) VisitLookupEntityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(504)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMeRequestObject struct {
+}
+
+type GetMeResponseObject interface {
+	VisitGetMeResponse(w http.ResponseWriter) error
+}
+
+type GetMe200JSONResponse UserResponse
+
+func (response GetMe200JSONResponse) VisitGetMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMe401JSONResponse ErrorResponse
+
+func (response GetMe401JSONResponse) VisitGetMeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SelectKeyRequestObject struct {
+	Body *SelectKeyJSONRequestBody
+}
+
+type SelectKeyResponseObject interface {
+	VisitSelectKeyResponse(w http.ResponseWriter) error
+}
+
+type SelectKey200JSONResponse SelectKeyResponse
+
+func (response SelectKey200JSONResponse) VisitSelectKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SelectKey400JSONResponse ErrorResponse
+
+func (response SelectKey400JSONResponse) VisitSelectKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SelectKey401JSONResponse ErrorResponse
+
+func (response SelectKey401JSONResponse) VisitSelectKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SelectKey403JSONResponse ErrorResponse
+
+func (response SelectKey403JSONResponse) VisitSelectKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SelectKey404JSONResponse ErrorResponse
+
+func (response SelectKey404JSONResponse) VisitSelectKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SelectKey500JSONResponse ErrorResponse
+
+func (response SelectKey500JSONResponse) VisitSelectKeyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSourceConnectionsRequestObject struct {
+}
+
+type ListSourceConnectionsResponseObject interface {
+	VisitListSourceConnectionsResponse(w http.ResponseWriter) error
+}
+
+type ListSourceConnections200JSONResponse SourceConnectionsResponse
+
+func (response ListSourceConnections200JSONResponse) VisitListSourceConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSourceConnections401JSONResponse ErrorResponse
+
+func (response ListSourceConnections401JSONResponse) VisitListSourceConnectionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSourcesRequestObject struct {
+	Params ListSourcesParams
+}
+
+type ListSourcesResponseObject interface {
+	VisitListSourcesResponse(w http.ResponseWriter) error
+}
+
+type ListSources200JSONResponse SourceListResponse
+
+func (response ListSources200JSONResponse) VisitListSourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSources401JSONResponse ErrorResponse
+
+func (response ListSources401JSONResponse) VisitListSourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSources500JSONResponse ErrorResponse
+
+func (response ListSources500JSONResponse) VisitListSourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSQLSourceRequestObject struct {
+	Body *CreateSQLSourceJSONRequestBody
+}
+
+type CreateSQLSourceResponseObject interface {
+	VisitCreateSQLSourceResponse(w http.ResponseWriter) error
+}
+
+type CreateSQLSource201JSONResponse SQLSourceResponse
+
+func (response CreateSQLSource201JSONResponse) VisitCreateSQLSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSQLSource400JSONResponse ErrorResponse
+
+func (response CreateSQLSource400JSONResponse) VisitCreateSQLSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSQLSource401JSONResponse ErrorResponse
+
+func (response CreateSQLSource401JSONResponse) VisitCreateSQLSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSQLSource500JSONResponse ErrorResponse
+
+func (response CreateSQLSource500JSONResponse) VisitCreateSQLSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceRequestObject struct {
+	SourceID openapi_types.UUID `json:"sourceID"`
+}
+
+type GetSourceResponseObject interface {
+	VisitGetSourceResponse(w http.ResponseWriter) error
+}
+
+type GetSource200JSONResponse SourceDetail
+
+func (response GetSource200JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSource401JSONResponse ErrorResponse
+
+func (response GetSource401JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSource403JSONResponse ErrorResponse
+
+func (response GetSource403JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSource404JSONResponse ErrorResponse
+
+func (response GetSource404JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSource500JSONResponse ErrorResponse
+
+func (response GetSource500JSONResponse) VisitGetSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceDataRequestObject struct {
+	SourceID openapi_types.UUID `json:"sourceID"`
+	Params   GetSourceDataParams
+}
+
+type GetSourceDataResponseObject interface {
+	VisitGetSourceDataResponse(w http.ResponseWriter) error
+}
+
+type GetSourceData200JSONResponse struct {
+	// HasMore Whether rows remain after this page
+	HasMore bool     `json:"has_more"`
+	Headers []string `json:"headers"`
+
+	// Offset Row offset of the first returned row
+	Offset int        `json:"offset"`
+	Rows   [][]string `json:"rows"`
+}
+
+func (response GetSourceData200JSONResponse) VisitGetSourceDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceData401JSONResponse ErrorResponse
+
+func (response GetSourceData401JSONResponse) VisitGetSourceDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceData403JSONResponse ErrorResponse
+
+func (response GetSourceData403JSONResponse) VisitGetSourceDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceData404JSONResponse ErrorResponse
+
+func (response GetSourceData404JSONResponse) VisitGetSourceDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceData500JSONResponse ErrorResponse
+
+func (response GetSourceData500JSONResponse) VisitGetSourceDataResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetSourceDialectRequestObject struct {
+	SourceID openapi_types.UUID `json:"sourceID"`
+}
+
+type ResetSourceDialectResponseObject interface {
+	VisitResetSourceDialectResponse(w http.ResponseWriter) error
+}
+
+type ResetSourceDialect200JSONResponse CSVDialectResponse
+
+func (response ResetSourceDialect200JSONResponse) VisitResetSourceDialectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetSourceDialect400JSONResponse ErrorResponse
+
+func (response ResetSourceDialect400JSONResponse) VisitResetSourceDialectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetSourceDialect401JSONResponse ErrorResponse
+
+func (response ResetSourceDialect401JSONResponse) VisitResetSourceDialectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetSourceDialect403JSONResponse ErrorResponse
+
+func (response ResetSourceDialect403JSONResponse) VisitResetSourceDialectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetSourceDialect404JSONResponse ErrorResponse
+
+func (response ResetSourceDialect404JSONResponse) VisitResetSourceDialectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetSourceDialect500JSONResponse ErrorResponse
+
+func (response ResetSourceDialect500JSONResponse) VisitResetSourceDialectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceDialectRequestObject struct {
+	SourceID openapi_types.UUID `json:"sourceID"`
+}
+
+type GetSourceDialectResponseObject interface {
+	VisitGetSourceDialectResponse(w http.ResponseWriter) error
+}
+
+type GetSourceDialect200JSONResponse CSVDialectResponse
+
+func (response GetSourceDialect200JSONResponse) VisitGetSourceDialectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceDialect400JSONResponse ErrorResponse
+
+func (response GetSourceDialect400JSONResponse) VisitGetSourceDialectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceDialect401JSONResponse ErrorResponse
+
+func (response GetSourceDialect401JSONResponse) VisitGetSourceDialectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceDialect403JSONResponse ErrorResponse
+
+func (response GetSourceDialect403JSONResponse) VisitGetSourceDialectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceDialect404JSONResponse ErrorResponse
+
+func (response GetSourceDialect404JSONResponse) VisitGetSourceDialectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSourceDialect500JSONResponse ErrorResponse
+
+func (response GetSourceDialect500JSONResponse) VisitGetSourceDialectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetSourceDialectRequestObject struct {
+	SourceID openapi_types.UUID `json:"sourceID"`
+	Body     *SetSourceDialectJSONRequestBody
+}
+
+type SetSourceDialectResponseObject interface {
+	VisitSetSourceDialectResponse(w http.ResponseWriter) error
+}
+
+type SetSourceDialect200JSONResponse CSVDialectResponse
+
+func (response SetSourceDialect200JSONResponse) VisitSetSourceDialectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetSourceDialect400JSONResponse ErrorResponse
+
+func (response SetSourceDialect400JSONResponse) VisitSetSourceDialectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetSourceDialect401JSONResponse ErrorResponse
+
+func (response SetSourceDialect401JSONResponse) VisitSetSourceDialectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetSourceDialect403JSONResponse ErrorResponse
+
+func (response SetSourceDialect403JSONResponse) VisitSetSourceDialectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetSourceDialect404JSONResponse ErrorResponse
+
+func (response SetSourceDialect404JSONResponse) VisitSetSourceDialectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetSourceDialect500JSONResponse ErrorResponse
+
+func (response SetSourceDialect500JSONResponse) VisitSetSourceDialectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnrichSourceRequestObject struct {
+	SourceID openapi_types.UUID `json:"sourceID"`
+	Body     *EnrichSourceJSONRequestBody
+}
+
+type EnrichSourceResponseObject interface {
+	VisitEnrichSourceResponse(w http.ResponseWriter) error
+}
+
+type EnrichSource200JSONResponse EnrichResponse
+
+func (response EnrichSource200JSONResponse) VisitEnrichSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnrichSource400JSONResponse ErrorResponse
+
+func (response EnrichSource400JSONResponse) VisitEnrichSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnrichSource401JSONResponse ErrorResponse
+
+func (response EnrichSource401JSONResponse) VisitEnrichSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnrichSource402JSONResponse ErrorResponse
+
+func (response EnrichSource402JSONResponse) VisitEnrichSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(402)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnrichSource403JSONResponse ErrorResponse
+
+func (response EnrichSource403JSONResponse) VisitEnrichSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnrichSource404JSONResponse ErrorResponse
+
+func (response EnrichSource404JSONResponse) VisitEnrichSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EnrichSource500JSONResponse ErrorResponse
+
+func (response EnrichSource500JSONResponse) VisitEnrichSourceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSourceRevisionRequestObject struct {
+	SourceID openapi_types.UUID `json:"sourceID"`
+	Body     *CreateSourceRevisionJSONRequestBody
+}
+
+type CreateSourceRevisionResponseObject interface {
+	VisitCreateSourceRevisionResponse(w http.ResponseWriter) error
+}
+
+type CreateSourceRevision200JSONResponse SourceRevisionResponse
+
+func (response CreateSourceRevision200JSONResponse) VisitCreateSourceRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSourceRevision400JSONResponse ErrorResponse
+
+func (response CreateSourceRevision400JSONResponse) VisitCreateSourceRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSourceRevision401JSONResponse ErrorResponse
+
+func (response CreateSourceRevision401JSONResponse) VisitCreateSourceRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSourceRevision403JSONResponse ErrorResponse
+
+func (response CreateSourceRevision403JSONResponse) VisitCreateSourceRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSourceRevision404JSONResponse ErrorResponse
+
+func (response CreateSourceRevision404JSONResponse) VisitCreateSourceRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSourceRevision500JSONResponse ErrorResponse
+
+func (response CreateSourceRevision500JSONResponse) VisitCreateSourceRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmSourceRevisionRequestObject struct {
+	SourceID openapi_types.UUID `json:"sourceID"`
+	Revision int                `json:"revision"`
+}
+
+type ConfirmSourceRevisionResponseObject interface {
+	VisitConfirmSourceRevisionResponse(w http.ResponseWriter) error
+}
+
+type ConfirmSourceRevision204Response struct {
+}
+
+func (response ConfirmSourceRevision204Response) VisitConfirmSourceRevisionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ConfirmSourceRevision400JSONResponse ErrorResponse
+
+func (response ConfirmSourceRevision400JSONResponse) VisitConfirmSourceRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmSourceRevision401JSONResponse ErrorResponse
+
+func (response ConfirmSourceRevision401JSONResponse) VisitConfirmSourceRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmSourceRevision403JSONResponse ErrorResponse
+
+func (response ConfirmSourceRevision403JSONResponse) VisitConfirmSourceRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmSourceRevision404JSONResponse ErrorResponse
+
+func (response ConfirmSourceRevision404JSONResponse) VisitConfirmSourceRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ConfirmSourceRevision500JSONResponse ErrorResponse
+
+func (response ConfirmSourceRevision500JSONResponse) VisitConfirmSourceRevisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSourceSheetsRequestObject struct {
+	SourceID openapi_types.UUID `json:"sourceID"`
+}
+
+type ListSourceSheetsResponseObject interface {
+	VisitListSourceSheetsResponse(w http.ResponseWriter) error
+}
+
+type ListSourceSheets200JSONResponse SourceSheetsResponse
+
+func (response ListSourceSheets200JSONResponse) VisitListSourceSheetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSourceSheets400JSONResponse ErrorResponse
+
+func (response ListSourceSheets400JSONResponse) VisitListSourceSheetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSourceSheets401JSONResponse ErrorResponse
+
+func (response ListSourceSheets401JSONResponse) VisitListSourceSheetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSourceSheets403JSONResponse ErrorResponse
+
+func (response ListSourceSheets403JSONResponse) VisitListSourceSheetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSourceSheets404JSONResponse ErrorResponse
+
+func (response ListSourceSheets404JSONResponse) VisitListSourceSheetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSourceSheets500JSONResponse ErrorResponse
+
+func (response ListSourceSheets500JSONResponse) VisitListSourceSheetsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SelectSourceSheetRequestObject struct {
+	SourceID openapi_types.UUID `json:"sourceID"`
+	Body     *SelectSourceSheetJSONRequestBody
+}
+
+type SelectSourceSheetResponseObject interface {
+	VisitSelectSourceSheetResponse(w http.ResponseWriter) error
+}
+
+type SelectSourceSheet200JSONResponse SourceSheetsResponse
+
+func (response SelectSourceSheet200JSONResponse) VisitSelectSourceSheetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SelectSourceSheet400JSONResponse ErrorResponse
+
+func (response SelectSourceSheet400JSONResponse) VisitSelectSourceSheetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SelectSourceSheet401JSONResponse ErrorResponse
+
+func (response SelectSourceSheet401JSONResponse) VisitSelectSourceSheetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SelectSourceSheet403JSONResponse ErrorResponse
+
+func (response SelectSourceSheet403JSONResponse) VisitSelectSourceSheetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SelectSourceSheet404JSONResponse ErrorResponse
+
+func (response SelectSourceSheet404JSONResponse) VisitSelectSourceSheetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SelectSourceSheet500JSONResponse ErrorResponse
+
+func (response SelectSourceSheet500JSONResponse) VisitSelectSourceSheetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSubscriptionCheckoutRequestObject struct {
+	Body *CreateSubscriptionCheckoutJSONRequestBody
+}
+
+type CreateSubscriptionCheckoutResponseObject interface {
+	VisitCreateSubscriptionCheckoutResponse(w http.ResponseWriter) error
+}
+
+type CreateSubscriptionCheckout200JSONResponse CreateCheckoutResponse
+
+func (response CreateSubscriptionCheckout200JSONResponse) VisitCreateSubscriptionCheckoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSubscriptionCheckout400JSONResponse ErrorResponse
+
+func (response CreateSubscriptionCheckout400JSONResponse) VisitCreateSubscriptionCheckoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSubscriptionCheckout500JSONResponse ErrorResponse
+
+func (response CreateSubscriptionCheckout500JSONResponse) VisitCreateSubscriptionCheckoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionStatusRequestObject struct {
+}
+
+type GetSubscriptionStatusResponseObject interface {
+	VisitGetSubscriptionStatusResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptionStatus200JSONResponse SubscriptionStatusResponse
+
+func (response GetSubscriptionStatus200JSONResponse) VisitGetSubscriptionStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionStatus400JSONResponse ErrorResponse
+
+func (response GetSubscriptionStatus400JSONResponse) VisitGetSubscriptionStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelSubscriptionRequestObject struct {
+}
+
+type CancelSubscriptionResponseObject interface {
+	VisitCancelSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type CancelSubscription200JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response CancelSubscription200JSONResponse) VisitCancelSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelSubscription400JSONResponse ErrorResponse
+
+func (response CancelSubscription400JSONResponse) VisitCancelSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelSubscription500JSONResponse ErrorResponse
+
+func (response CancelSubscription500JSONResponse) VisitCancelSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePortalSessionRequestObject struct {
+	Params CreatePortalSessionParams
+}
+
+type CreatePortalSessionResponseObject interface {
+	VisitCreatePortalSessionResponse(w http.ResponseWriter) error
+}
+
+type CreatePortalSession200JSONResponse struct {
+	Url string `json:"url"`
+}
+
+func (response CreatePortalSession200JSONResponse) VisitCreatePortalSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePortalSession400JSONResponse ErrorResponse
+
+func (response CreatePortalSession400JSONResponse) VisitCreatePortalSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreatePortalSession500JSONResponse ErrorResponse
+
+func (response CreatePortalSession500JSONResponse) VisitCreatePortalSessionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpgradeSubscriptionRequestObject struct {
+	Body *UpgradeSubscriptionJSONRequestBody
+}
+
+type UpgradeSubscriptionResponseObject interface {
+	VisitUpgradeSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type UpgradeSubscription200JSONResponse struct {
+	Message string `json:"message"`
+}
+
+func (response UpgradeSubscription200JSONResponse) VisitUpgradeSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpgradeSubscription400JSONResponse ErrorResponse
+
+func (response UpgradeSubscription400JSONResponse) VisitUpgradeSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpgradeSubscription500JSONResponse ErrorResponse
+
+func (response UpgradeSubscription500JSONResponse) VisitUpgradeSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTemplatesRequestObject struct {
+}
+
+type ListTemplatesResponseObject interface {
+	VisitListTemplatesResponse(w http.ResponseWriter) error
+}
+
+type ListTemplates200JSONResponse TemplateListResponse
+
+func (response ListTemplates200JSONResponse) VisitListTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTemplates401JSONResponse ErrorResponse
+
+func (response ListTemplates401JSONResponse) VisitListTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTemplates500JSONResponse ErrorResponse
+
+func (response ListTemplates500JSONResponse) VisitListTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTiersRequestObject struct {
+}
+
+type ListTiersResponseObject interface {
+	VisitListTiersResponse(w http.ResponseWriter) error
+}
+
+type ListTiers200JSONResponse []TierResponse
+
+func (response ListTiers200JSONResponse) VisitListTiersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookEndpointsRequestObject struct {
+}
+
+type ListWebhookEndpointsResponseObject interface {
+	VisitListWebhookEndpointsResponse(w http.ResponseWriter) error
+}
+
+type ListWebhookEndpoints200JSONResponse WebhookEndpointListResponse
+
+func (response ListWebhookEndpoints200JSONResponse) VisitListWebhookEndpointsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookEndpoints401JSONResponse ErrorResponse
+
+func (response ListWebhookEndpoints401JSONResponse) VisitListWebhookEndpointsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookEndpoints500JSONResponse ErrorResponse
+
+func (response ListWebhookEndpoints500JSONResponse) VisitListWebhookEndpointsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookEndpointRequestObject struct {
+	Body *CreateWebhookEndpointJSONRequestBody
+}
+
+type CreateWebhookEndpointResponseObject interface {
+	VisitCreateWebhookEndpointResponse(w http.ResponseWriter) error
+}
+
+type CreateWebhookEndpoint201JSONResponse WebhookEndpointResponse
+
+func (response CreateWebhookEndpoint201JSONResponse) VisitCreateWebhookEndpointResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookEndpoint400JSONResponse ErrorResponse
+
+func (response CreateWebhookEndpoint400JSONResponse) VisitCreateWebhookEndpointResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookEndpoint401JSONResponse ErrorResponse
+
+func (response CreateWebhookEndpoint401JSONResponse) VisitCreateWebhookEndpointResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWebhookEndpoint500JSONResponse ErrorResponse
+
+func (response CreateWebhookEndpoint500JSONResponse) VisitCreateWebhookEndpointResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookEndpointRequestObject struct {
+	EndpointID openapi_types.UUID `json:"endpointID"`
+}
+
+type DeleteWebhookEndpointResponseObject interface {
+	VisitDeleteWebhookEndpointResponse(w http.ResponseWriter) error
+}
+
+type DeleteWebhookEndpoint204Response struct {
+}
+
+func (response DeleteWebhookEndpoint204Response) VisitDeleteWebhookEndpointResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWebhookEndpoint401JSONResponse ErrorResponse
+
+func (response DeleteWebhookEndpoint401JSONResponse) VisitDeleteWebhookEndpointResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookEndpoint403JSONResponse ErrorResponse
+
+func (response DeleteWebhookEndpoint403JSONResponse) VisitDeleteWebhookEndpointResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookEndpoint404JSONResponse ErrorResponse
+
+func (response DeleteWebhookEndpoint404JSONResponse) VisitDeleteWebhookEndpointResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteWebhookEndpoint500JSONResponse ErrorResponse
+
+func (response DeleteWebhookEndpoint500JSONResponse) VisitDeleteWebhookEndpointResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveriesRequestObject struct {
+	EndpointID openapi_types.UUID `json:"endpointID"`
+	Params     ListWebhookDeliveriesParams
+}
+
+type ListWebhookDeliveriesResponseObject interface {
+	VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error
+}
+
+type ListWebhookDeliveries200JSONResponse WebhookDeliveryListResponse
+
+func (response ListWebhookDeliveries200JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries401JSONResponse ErrorResponse
+
+func (response ListWebhookDeliveries401JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries403JSONResponse ErrorResponse
+
+func (response ListWebhookDeliveries403JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries404JSONResponse ErrorResponse
+
+func (response ListWebhookDeliveries404JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWebhookDeliveries500JSONResponse ErrorResponse
+
+func (response ListWebhookDeliveries500JSONResponse) VisitListWebhookDeliveriesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HandleStripeWebhookRequestObject struct {
+	Params HandleStripeWebhookParams
+	Body   io.Reader
+}
+
+type HandleStripeWebhookResponseObject interface {
+	VisitHandleStripeWebhookResponse(w http.ResponseWriter) error
+}
+
+type HandleStripeWebhook200Response struct {
+}
+
+func (response HandleStripeWebhook200Response) VisitHandleStripeWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type HandleStripeWebhook400JSONResponse ErrorResponse
+
+func (response HandleStripeWebhook400JSONResponse) VisitHandleStripeWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HandleStripeWebhook401JSONResponse ErrorResponse
+
+func (response HandleStripeWebhook401JSONResponse) VisitHandleStripeWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type HandleStripeWebhook500JSONResponse ErrorResponse
+
+func (response HandleStripeWebhook500JSONResponse) VisitHandleStripeWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List the user's API keys, revoked ones included
+	// (GET /api-keys)
+	ListAPIKeys(ctx context.Context, request ListAPIKeysRequestObject) (ListAPIKeysResponseObject, error)
+	// Create an API key for programmatic access
+	// (POST /api-keys)
+	CreateAPIKey(ctx context.Context, request CreateAPIKeyRequestObject) (CreateAPIKeyResponseObject, error)
+	// Revoke an API key
+	// (DELETE /api-keys/{keyID})
+	RevokeAPIKey(ctx context.Context, request RevokeAPIKeyRequestObject) (RevokeAPIKeyResponseObject, error)
 	// Create a pending enrichment job and get a signed upload URL
 	// (POST /enrichment-signed-url)
 	UploadFileForEnrichment(ctx context.Context, request UploadFileForEnrichmentRequestObject) (UploadFileForEnrichmentResponseObject, error)
+	// Get an export and, once completed, its download link
+	// (GET /exports/{exportID})
+	GetExport(ctx context.Context, request GetExportRequestObject) (GetExportResponseObject, error)
 	// Cancel a running enrichment job
 	// (POST /jobs/{jobID}/cancel)
 	CancelJob(ctx context.Context, request CancelJobRequestObject) (CancelJobResponseObject, error)
+	// List the cell corrections of a job
+	// (GET /jobs/{jobID}/corrections)
+	ListJobCorrections(ctx context.Context, request ListJobCorrectionsRequestObject) (ListJobCorrectionsResponseObject, error)
+	// Correct an extracted cell
+	// (POST /jobs/{jobID}/corrections)
+	CorrectJobCell(ctx context.Context, request CorrectJobCellRequestObject) (CorrectJobCellResponseObject, error)
+	// Compare the results of two jobs on the same source
+	// (GET /jobs/{jobID}/diff)
+	DiffJobs(ctx context.Context, request DiffJobsRequestObject) (DiffJobsResponseObject, error)
+	// Download the comparison of two jobs as CSV
+	// (GET /jobs/{jobID}/diff/csv)
+	ExportJobDiff(ctx context.Context, request ExportJobDiffRequestObject) (ExportJobDiffResponseObject, error)
+	// Stream the row stage transitions of a job
+	// (GET /jobs/{jobID}/events)
+	StreamJobEvents(ctx context.Context, request StreamJobEventsRequestObject) (StreamJobEventsResponseObject, error)
+	// List the exports of a job
+	// (GET /jobs/{jobID}/exports)
+	ListJobExports(ctx context.Context, request ListJobExportsRequestObject) (ListJobExportsResponseObject, error)
+	// Export a job's results merged into its source rows
+	// (POST /jobs/{jobID}/exports)
+	CreateJobExport(ctx context.Context, request CreateJobExportRequestObject) (CreateJobExportResponseObject, error)
 	// Get progress summary for a job
 	// (GET /jobs/{jobID}/progress)
 	GetJobProgress(ctx context.Context, request GetJobProgressRequestObject) (GetJobProgressResponseObject, error)
+	// Enrich selected rows and columns of a finished job again
+	// (POST /jobs/{jobID}/rerun)
+	RerunJobRows(ctx context.Context, request RerunJobRowsRequestObject) (RerunJobRowsResponseObject, error)
 	// Get enrichment results for a job
 	// (GET /jobs/{jobID}/results)
 	GetJobResults(ctx context.Context, request GetJobResultsRequestObject) (GetJobResultsResponseObject, error)
 	// Get per-row progress for a job
 	// (GET /jobs/{jobID}/rows)
 	GetRowsProgress(ctx context.Context, request GetRowsProgressRequestObject) (GetRowsProgressResponseObject, error)
+	// Enrich a single entity and wait for the result
+	// (POST /lookups)
+	LookupEntity(ctx context.Context, request LookupEntityRequestObject) (LookupEntityResponseObject, error)
 	// Get the current authenticated user's profile
 	// (GET /me)
 	GetMe(ctx context.Context, request GetMeRequestObject) (GetMeResponseObject, error)
 	// Use AI to select the best key column from a source's CSV
 	// (POST /select-key)
 	SelectKey(ctx context.Context, request SelectKeyRequestObject) (SelectKeyResponseObject, error)
+	// List the database connections query sources may use
+	// (GET /source-connections)
+	ListSourceConnections(ctx context.Context, request ListSourceConnectionsRequestObject) (ListSourceConnectionsResponseObject, error)
 	// List sources for the authenticated user
 	// (GET /sources)
 	ListSources(ctx context.Context, request ListSourcesRequestObject) (ListSourcesResponseObject, error)
+	// Create a source from a read-only query on a named connection
+	// (POST /sources/sql)
+	CreateSQLSource(ctx context.Context, request CreateSQLSourceRequestObject) (CreateSQLSourceResponseObject, error)
 	// Get source details with all enrichment runs
 	// (GET /sources/{sourceID})
 	GetSource(ctx context.Context, request GetSourceRequestObject) (GetSourceResponseObject, error)
-	// Get the raw CSV data for a source
+	// Get a page of the raw CSV data for a source
 	// (GET /sources/{sourceID}/data)
 	GetSourceData(ctx context.Context, request GetSourceDataRequestObject) (GetSourceDataResponseObject, error)
+	// Drop a dialect override and detect the dialect again
+	// (DELETE /sources/{sourceID}/dialect)
+	ResetSourceDialect(ctx context.Context, request ResetSourceDialectRequestObject) (ResetSourceDialectResponseObject, error)
+	// Get the delimiter, encoding and header row a CSV source is read with
+	// (GET /sources/{sourceID}/dialect)
+	GetSourceDialect(ctx context.Context, request GetSourceDialectRequestObject) (GetSourceDialectResponseObject, error)
+	// Override the detected dialect of a CSV source
+	// (PUT /sources/{sourceID}/dialect)
+	SetSourceDialect(ctx context.Context, request SetSourceDialectRequestObject) (SetSourceDialectResponseObject, error)
 	// Start a new enrichment run for a source
 	// (POST /sources/{sourceID}/enrich)
 	EnrichSource(ctx context.Context, request EnrichSourceRequestObject) (EnrichSourceResponseObject, error)
+	// Upload a new revision of a file source
+	// (POST /sources/{sourceID}/revisions)
+	CreateSourceRevision(ctx context.Context, request CreateSourceRevisionRequestObject) (CreateSourceRevisionResponseObject, error)
+	// Make an uploaded revision the current file of a source
+	// (POST /sources/{sourceID}/revisions/{revision}/confirm)
+	ConfirmSourceRevision(ctx context.Context, request ConfirmSourceRevisionRequestObject) (ConfirmSourceRevisionResponseObject, error)
+	// List the sheets of an XLSX source and the current selection
+	// (GET /sources/{sourceID}/sheets)
+	ListSourceSheets(ctx context.Context, request ListSourceSheetsRequestObject) (ListSourceSheetsResponseObject, error)
+	// Choose the sheet and header row read from an XLSX source
+	// (PUT /sources/{sourceID}/sheets)
+	SelectSourceSheet(ctx context.Context, request SelectSourceSheetRequestObject) (SelectSourceSheetResponseObject, error)
 	// Create a Stripe checkout session for a subscription
 	// (POST /subscribe)
 	CreateSubscriptionCheckout(ctx context.Context, request CreateSubscriptionCheckoutRequestObject) (CreateSubscriptionCheckoutResponseObject, error)
@@ -1906,45 +5069,670 @@ type StrictServerInterface interface {
 	// List available subscription tiers
 	// (GET /tiers)
 	ListTiers(ctx context.Context, request ListTiersRequestObject) (ListTiersResponseObject, error)
+	// List the user's webhook endpoints
+	// (GET /webhook-endpoints)
+	ListWebhookEndpoints(ctx context.Context, request ListWebhookEndpointsRequestObject) (ListWebhookEndpointsResponseObject, error)
+	// Register a URL to receive signed job events
+	// (POST /webhook-endpoints)
+	CreateWebhookEndpoint(ctx context.Context, request CreateWebhookEndpointRequestObject) (CreateWebhookEndpointResponseObject, error)
+	// Delete a webhook endpoint and its delivery log
+	// (DELETE /webhook-endpoints/{endpointID})
+	DeleteWebhookEndpoint(ctx context.Context, request DeleteWebhookEndpointRequestObject) (DeleteWebhookEndpointResponseObject, error)
+	// List the delivery log of a webhook endpoint
+	// (GET /webhook-endpoints/{endpointID}/deliveries)
+	ListWebhookDeliveries(ctx context.Context, request ListWebhookDeliveriesRequestObject) (ListWebhookDeliveriesResponseObject, error)
 	// Handle Stripe webhook events
 	// (POST /webhooks/stripe)
 	HandleStripeWebhook(ctx context.Context, request HandleStripeWebhookRequestObject) (HandleStripeWebhookResponseObject, error)
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// ListAPIKeys operation middleware
+func (sh *strictHandler) ListAPIKeys(w http.ResponseWriter, r *http.Request) {
+	var request ListAPIKeysRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAPIKeys(ctx, request.(ListAPIKeysRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAPIKeys")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListAPIKeysResponseObject); ok {
+		if err := validResponse.VisitListAPIKeysResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateAPIKey operation middleware
+func (sh *strictHandler) CreateAPIKey(w http.ResponseWriter, r *http.Request) {
+	var request CreateAPIKeyRequestObject
+
+	var body CreateAPIKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAPIKey(ctx, request.(CreateAPIKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAPIKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAPIKeyResponseObject); ok {
+		if err := validResponse.VisitCreateAPIKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RevokeAPIKey operation middleware
+func (sh *strictHandler) RevokeAPIKey(w http.ResponseWriter, r *http.Request, keyID openapi_types.UUID) {
+	var request RevokeAPIKeyRequestObject
+
+	request.KeyID = keyID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeAPIKey(ctx, request.(RevokeAPIKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeAPIKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeAPIKeyResponseObject); ok {
+		if err := validResponse.VisitRevokeAPIKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UploadFileForEnrichment operation middleware
+func (sh *strictHandler) UploadFileForEnrichment(w http.ResponseWriter, r *http.Request) {
+	var request UploadFileForEnrichmentRequestObject
+
+	var body UploadFileForEnrichmentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UploadFileForEnrichment(ctx, request.(UploadFileForEnrichmentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UploadFileForEnrichment")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UploadFileForEnrichmentResponseObject); ok {
+		if err := validResponse.VisitUploadFileForEnrichmentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetExport operation middleware
+func (sh *strictHandler) GetExport(w http.ResponseWriter, r *http.Request, exportID openapi_types.UUID) {
+	var request GetExportRequestObject
+
+	request.ExportID = exportID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetExport(ctx, request.(GetExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetExport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetExportResponseObject); ok {
+		if err := validResponse.VisitGetExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CancelJob operation middleware
+func (sh *strictHandler) CancelJob(w http.ResponseWriter, r *http.Request, jobID string) {
+	var request CancelJobRequestObject
+
+	request.JobID = jobID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CancelJob(ctx, request.(CancelJobRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CancelJob")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CancelJobResponseObject); ok {
+		if err := validResponse.VisitCancelJobResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListJobCorrections operation middleware
+func (sh *strictHandler) ListJobCorrections(w http.ResponseWriter, r *http.Request, jobID string, params ListJobCorrectionsParams) {
+	var request ListJobCorrectionsRequestObject
+
+	request.JobID = jobID
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListJobCorrections(ctx, request.(ListJobCorrectionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListJobCorrections")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListJobCorrectionsResponseObject); ok {
+		if err := validResponse.VisitListJobCorrectionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CorrectJobCell operation middleware
+func (sh *strictHandler) CorrectJobCell(w http.ResponseWriter, r *http.Request, jobID string) {
+	var request CorrectJobCellRequestObject
+
+	request.JobID = jobID
+
+	var body CorrectJobCellJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CorrectJobCell(ctx, request.(CorrectJobCellRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CorrectJobCell")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CorrectJobCellResponseObject); ok {
+		if err := validResponse.VisitCorrectJobCellResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DiffJobs operation middleware
+func (sh *strictHandler) DiffJobs(w http.ResponseWriter, r *http.Request, jobID string, params DiffJobsParams) {
+	var request DiffJobsRequestObject
+
+	request.JobID = jobID
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DiffJobs(ctx, request.(DiffJobsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DiffJobs")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DiffJobsResponseObject); ok {
+		if err := validResponse.VisitDiffJobsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExportJobDiff operation middleware
+func (sh *strictHandler) ExportJobDiff(w http.ResponseWriter, r *http.Request, jobID string, params ExportJobDiffParams) {
+	var request ExportJobDiffRequestObject
+
+	request.JobID = jobID
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportJobDiff(ctx, request.(ExportJobDiffRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportJobDiff")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExportJobDiffResponseObject); ok {
+		if err := validResponse.VisitExportJobDiffResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// StreamJobEvents operation middleware
+func (sh *strictHandler) StreamJobEvents(w http.ResponseWriter, r *http.Request, jobID string, params StreamJobEventsParams) {
+	var request StreamJobEventsRequestObject
+
+	request.JobID = jobID
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.StreamJobEvents(ctx, request.(StreamJobEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "StreamJobEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(StreamJobEventsResponseObject); ok {
+		if err := validResponse.VisitStreamJobEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListJobExports operation middleware
+func (sh *strictHandler) ListJobExports(w http.ResponseWriter, r *http.Request, jobID string) {
+	var request ListJobExportsRequestObject
+
+	request.JobID = jobID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListJobExports(ctx, request.(ListJobExportsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListJobExports")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListJobExportsResponseObject); ok {
+		if err := validResponse.VisitListJobExportsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateJobExport operation middleware
+func (sh *strictHandler) CreateJobExport(w http.ResponseWriter, r *http.Request, jobID string) {
+	var request CreateJobExportRequestObject
+
+	request.JobID = jobID
+
+	var body CreateJobExportJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateJobExport(ctx, request.(CreateJobExportRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateJobExport")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateJobExportResponseObject); ok {
+		if err := validResponse.VisitCreateJobExportResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetJobProgress operation middleware
+func (sh *strictHandler) GetJobProgress(w http.ResponseWriter, r *http.Request, jobID string) {
+	var request GetJobProgressRequestObject
+
+	request.JobID = jobID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetJobProgress(ctx, request.(GetJobProgressRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetJobProgress")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetJobProgressResponseObject); ok {
+		if err := validResponse.VisitGetJobProgressResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RerunJobRows operation middleware
+func (sh *strictHandler) RerunJobRows(w http.ResponseWriter, r *http.Request, jobID string) {
+	var request RerunJobRowsRequestObject
+
+	request.JobID = jobID
+
+	var body RerunJobRowsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RerunJobRows(ctx, request.(RerunJobRowsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RerunJobRows")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RerunJobRowsResponseObject); ok {
+		if err := validResponse.VisitRerunJobRowsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetJobResults operation middleware
+func (sh *strictHandler) GetJobResults(w http.ResponseWriter, r *http.Request, jobID string, params GetJobResultsParams) {
+	var request GetJobResultsRequestObject
+
+	request.JobID = jobID
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetJobResults(ctx, request.(GetJobResultsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetJobResults")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetJobResultsResponseObject); ok {
+		if err := validResponse.VisitGetJobResultsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRowsProgress operation middleware
+func (sh *strictHandler) GetRowsProgress(w http.ResponseWriter, r *http.Request, jobID string, params GetRowsProgressParams) {
+	var request GetRowsProgressRequestObject
+
+	request.JobID = jobID
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRowsProgress(ctx, request.(GetRowsProgressRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRowsProgress")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRowsProgressResponseObject); ok {
+		if err := validResponse.VisitGetRowsProgressResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// LookupEntity operation middleware
+func (sh *strictHandler) LookupEntity(w http.ResponseWriter, r *http.Request) {
+	var request LookupEntityRequestObject
+
+	var body LookupEntityJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LookupEntity(ctx, request.(LookupEntityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LookupEntity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LookupEntityResponseObject); ok {
+		if err := validResponse.VisitLookupEntityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMe operation middleware
+func (sh *strictHandler) GetMe(w http.ResponseWriter, r *http.Request) {
+	var request GetMeRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMe(ctx, request.(GetMeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMe")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMeResponseObject); ok {
+		if err := validResponse.VisitGetMeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SelectKey operation middleware
+func (sh *strictHandler) SelectKey(w http.ResponseWriter, r *http.Request) {
+	var request SelectKeyRequestObject
+
+	var body SelectKeyJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SelectKey(ctx, request.(SelectKeyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SelectKey")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SelectKeyResponseObject); ok {
+		if err := validResponse.VisitSelectKeyResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListSourceConnections operation middleware
+func (sh *strictHandler) ListSourceConnections(w http.ResponseWriter, r *http.Request) {
+	var request ListSourceConnectionsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListSourceConnections(ctx, request.(ListSourceConnectionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListSourceConnections")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListSourceConnectionsResponseObject); ok {
+		if err := validResponse.VisitListSourceConnectionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListSources operation middleware
+func (sh *strictHandler) ListSources(w http.ResponseWriter, r *http.Request, params ListSourcesParams) {
+	var request ListSourcesRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.Params = params
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
-}
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListSources(ctx, request.(ListSourcesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListSources")
+	}
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
-}
+	response, err := handler(r.Context(), w, r, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListSourcesResponseObject); ok {
+		if err := validResponse.VisitListSourcesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// UploadFileForEnrichment operation middleware
-func (sh *strictHandler) UploadFileForEnrichment(w http.ResponseWriter, r *http.Request) {
-	var request UploadFileForEnrichmentRequestObject
+// CreateSQLSource operation middleware
+func (sh *strictHandler) CreateSQLSource(w http.ResponseWriter, r *http.Request) {
+	var request CreateSQLSourceRequestObject
 
-	var body UploadFileForEnrichmentJSONRequestBody
+	var body CreateSQLSourceJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -1952,18 +5740,18 @@ func (sh *strictHandler) UploadFileForEnrichment(w http.ResponseWriter, r *http.
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UploadFileForEnrichment(ctx, request.(UploadFileForEnrichmentRequestObject))
+		return sh.ssi.CreateSQLSource(ctx, request.(CreateSQLSourceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UploadFileForEnrichment")
+		handler = middleware(handler, "CreateSQLSource")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UploadFileForEnrichmentResponseObject); ok {
-		if err := validResponse.VisitUploadFileForEnrichmentResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateSQLSourceResponseObject); ok {
+		if err := validResponse.VisitCreateSQLSourceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1971,25 +5759,25 @@ func (sh *strictHandler) UploadFileForEnrichment(w http.ResponseWriter, r *http.
 	}
 }
 
-// CancelJob operation middleware
-func (sh *strictHandler) CancelJob(w http.ResponseWriter, r *http.Request, jobID string) {
-	var request CancelJobRequestObject
+// GetSource operation middleware
+func (sh *strictHandler) GetSource(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID) {
+	var request GetSourceRequestObject
 
-	request.JobID = jobID
+	request.SourceID = sourceID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CancelJob(ctx, request.(CancelJobRequestObject))
+		return sh.ssi.GetSource(ctx, request.(GetSourceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CancelJob")
+		handler = middleware(handler, "GetSource")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CancelJobResponseObject); ok {
-		if err := validResponse.VisitCancelJobResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSourceResponseObject); ok {
+		if err := validResponse.VisitGetSourceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -1997,25 +5785,26 @@ func (sh *strictHandler) CancelJob(w http.ResponseWriter, r *http.Request, jobID
 	}
 }
 
-// GetJobProgress operation middleware
-func (sh *strictHandler) GetJobProgress(w http.ResponseWriter, r *http.Request, jobID string) {
-	var request GetJobProgressRequestObject
+// GetSourceData operation middleware
+func (sh *strictHandler) GetSourceData(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID, params GetSourceDataParams) {
+	var request GetSourceDataRequestObject
 
-	request.JobID = jobID
+	request.SourceID = sourceID
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetJobProgress(ctx, request.(GetJobProgressRequestObject))
+		return sh.ssi.GetSourceData(ctx, request.(GetSourceDataRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetJobProgress")
+		handler = middleware(handler, "GetSourceData")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetJobProgressResponseObject); ok {
-		if err := validResponse.VisitGetJobProgressResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSourceDataResponseObject); ok {
+		if err := validResponse.VisitGetSourceDataResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2023,26 +5812,25 @@ func (sh *strictHandler) GetJobProgress(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetJobResults operation middleware
-func (sh *strictHandler) GetJobResults(w http.ResponseWriter, r *http.Request, jobID string, params GetJobResultsParams) {
-	var request GetJobResultsRequestObject
+// ResetSourceDialect operation middleware
+func (sh *strictHandler) ResetSourceDialect(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID) {
+	var request ResetSourceDialectRequestObject
 
-	request.JobID = jobID
-	request.Params = params
+	request.SourceID = sourceID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetJobResults(ctx, request.(GetJobResultsRequestObject))
+		return sh.ssi.ResetSourceDialect(ctx, request.(ResetSourceDialectRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetJobResults")
+		handler = middleware(handler, "ResetSourceDialect")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetJobResultsResponseObject); ok {
-		if err := validResponse.VisitGetJobResultsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ResetSourceDialectResponseObject); ok {
+		if err := validResponse.VisitResetSourceDialectResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2050,26 +5838,25 @@ func (sh *strictHandler) GetJobResults(w http.ResponseWriter, r *http.Request, j
 	}
 }
 
-// GetRowsProgress operation middleware
-func (sh *strictHandler) GetRowsProgress(w http.ResponseWriter, r *http.Request, jobID string, params GetRowsProgressParams) {
-	var request GetRowsProgressRequestObject
+// GetSourceDialect operation middleware
+func (sh *strictHandler) GetSourceDialect(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID) {
+	var request GetSourceDialectRequestObject
 
-	request.JobID = jobID
-	request.Params = params
+	request.SourceID = sourceID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetRowsProgress(ctx, request.(GetRowsProgressRequestObject))
+		return sh.ssi.GetSourceDialect(ctx, request.(GetSourceDialectRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetRowsProgress")
+		handler = middleware(handler, "GetSourceDialect")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetRowsProgressResponseObject); ok {
-		if err := validResponse.VisitGetRowsProgressResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetSourceDialectResponseObject); ok {
+		if err := validResponse.VisitGetSourceDialectResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2077,23 +5864,32 @@ func (sh *strictHandler) GetRowsProgress(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// GetMe operation middleware
-func (sh *strictHandler) GetMe(w http.ResponseWriter, r *http.Request) {
-	var request GetMeRequestObject
+// SetSourceDialect operation middleware
+func (sh *strictHandler) SetSourceDialect(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID) {
+	var request SetSourceDialectRequestObject
+
+	request.SourceID = sourceID
+
+	var body SetSourceDialectJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetMe(ctx, request.(GetMeRequestObject))
+		return sh.ssi.SetSourceDialect(ctx, request.(SetSourceDialectRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetMe")
+		handler = middleware(handler, "SetSourceDialect")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetMeResponseObject); ok {
-		if err := validResponse.VisitGetMeResponse(w); err != nil {
+	} else if validResponse, ok := response.(SetSourceDialectResponseObject); ok {
+		if err := validResponse.VisitSetSourceDialectResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2101,11 +5897,13 @@ func (sh *strictHandler) GetMe(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// SelectKey operation middleware
-func (sh *strictHandler) SelectKey(w http.ResponseWriter, r *http.Request) {
-	var request SelectKeyRequestObject
+// EnrichSource operation middleware
+func (sh *strictHandler) EnrichSource(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID) {
+	var request EnrichSourceRequestObject
 
-	var body SelectKeyJSONRequestBody
+	request.SourceID = sourceID
+
+	var body EnrichSourceJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2113,18 +5911,18 @@ func (sh *strictHandler) SelectKey(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.SelectKey(ctx, request.(SelectKeyRequestObject))
+		return sh.ssi.EnrichSource(ctx, request.(EnrichSourceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SelectKey")
+		handler = middleware(handler, "EnrichSource")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(SelectKeyResponseObject); ok {
-		if err := validResponse.VisitSelectKeyResponse(w); err != nil {
+	} else if validResponse, ok := response.(EnrichSourceResponseObject); ok {
+		if err := validResponse.VisitEnrichSourceResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2132,25 +5930,32 @@ func (sh *strictHandler) SelectKey(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// ListSources operation middleware
-func (sh *strictHandler) ListSources(w http.ResponseWriter, r *http.Request, params ListSourcesParams) {
-	var request ListSourcesRequestObject
+// CreateSourceRevision operation middleware
+func (sh *strictHandler) CreateSourceRevision(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID) {
+	var request CreateSourceRevisionRequestObject
 
-	request.Params = params
+	request.SourceID = sourceID
+
+	var body CreateSourceRevisionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListSources(ctx, request.(ListSourcesRequestObject))
+		return sh.ssi.CreateSourceRevision(ctx, request.(CreateSourceRevisionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListSources")
+		handler = middleware(handler, "CreateSourceRevision")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListSourcesResponseObject); ok {
-		if err := validResponse.VisitListSourcesResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateSourceRevisionResponseObject); ok {
+		if err := validResponse.VisitCreateSourceRevisionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2158,25 +5963,26 @@ func (sh *strictHandler) ListSources(w http.ResponseWriter, r *http.Request, par
 	}
 }
 
-// GetSource operation middleware
-func (sh *strictHandler) GetSource(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID) {
-	var request GetSourceRequestObject
+// ConfirmSourceRevision operation middleware
+func (sh *strictHandler) ConfirmSourceRevision(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID, revision int) {
+	var request ConfirmSourceRevisionRequestObject
 
 	request.SourceID = sourceID
+	request.Revision = revision
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSource(ctx, request.(GetSourceRequestObject))
+		return sh.ssi.ConfirmSourceRevision(ctx, request.(ConfirmSourceRevisionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSource")
+		handler = middleware(handler, "ConfirmSourceRevision")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSourceResponseObject); ok {
-		if err := validResponse.VisitGetSourceResponse(w); err != nil {
+	} else if validResponse, ok := response.(ConfirmSourceRevisionResponseObject); ok {
+		if err := validResponse.VisitConfirmSourceRevisionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2184,25 +5990,25 @@ func (sh *strictHandler) GetSource(w http.ResponseWriter, r *http.Request, sourc
 	}
 }
 
-// GetSourceData operation middleware
-func (sh *strictHandler) GetSourceData(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID) {
-	var request GetSourceDataRequestObject
+// ListSourceSheets operation middleware
+func (sh *strictHandler) ListSourceSheets(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID) {
+	var request ListSourceSheetsRequestObject
 
 	request.SourceID = sourceID
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetSourceData(ctx, request.(GetSourceDataRequestObject))
+		return sh.ssi.ListSourceSheets(ctx, request.(ListSourceSheetsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetSourceData")
+		handler = middleware(handler, "ListSourceSheets")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetSourceDataResponseObject); ok {
-		if err := validResponse.VisitGetSourceDataResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListSourceSheetsResponseObject); ok {
+		if err := validResponse.VisitListSourceSheetsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2210,13 +6016,13 @@ func (sh *strictHandler) GetSourceData(w http.ResponseWriter, r *http.Request, s
 	}
 }
 
-// EnrichSource operation middleware
-func (sh *strictHandler) EnrichSource(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID) {
-	var request EnrichSourceRequestObject
+// SelectSourceSheet operation middleware
+func (sh *strictHandler) SelectSourceSheet(w http.ResponseWriter, r *http.Request, sourceID openapi_types.UUID) {
+	var request SelectSourceSheetRequestObject
 
 	request.SourceID = sourceID
 
-	var body EnrichSourceJSONRequestBody
+	var body SelectSourceSheetJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -2224,18 +6030,18 @@ func (sh *strictHandler) EnrichSource(w http.ResponseWriter, r *http.Request, so
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.EnrichSource(ctx, request.(EnrichSourceRequestObject))
+		return sh.ssi.SelectSourceSheet(ctx, request.(SelectSourceSheetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "EnrichSource")
+		handler = middleware(handler, "SelectSourceSheet")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(EnrichSourceResponseObject); ok {
-		if err := validResponse.VisitEnrichSourceResponse(w); err != nil {
+	} else if validResponse, ok := response.(SelectSourceSheetResponseObject); ok {
+		if err := validResponse.VisitSelectSourceSheetResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -2427,6 +6233,114 @@ func (sh *strictHandler) ListTiers(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// ListWebhookEndpoints operation middleware
+func (sh *strictHandler) ListWebhookEndpoints(w http.ResponseWriter, r *http.Request) {
+	var request ListWebhookEndpointsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWebhookEndpoints(ctx, request.(ListWebhookEndpointsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWebhookEndpoints")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWebhookEndpointsResponseObject); ok {
+		if err := validResponse.VisitListWebhookEndpointsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateWebhookEndpoint operation middleware
+func (sh *strictHandler) CreateWebhookEndpoint(w http.ResponseWriter, r *http.Request) {
+	var request CreateWebhookEndpointRequestObject
+
+	var body CreateWebhookEndpointJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateWebhookEndpoint(ctx, request.(CreateWebhookEndpointRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateWebhookEndpoint")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateWebhookEndpointResponseObject); ok {
+		if err := validResponse.VisitCreateWebhookEndpointResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteWebhookEndpoint operation middleware
+func (sh *strictHandler) DeleteWebhookEndpoint(w http.ResponseWriter, r *http.Request, endpointID openapi_types.UUID) {
+	var request DeleteWebhookEndpointRequestObject
+
+	request.EndpointID = endpointID
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteWebhookEndpoint(ctx, request.(DeleteWebhookEndpointRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteWebhookEndpoint")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteWebhookEndpointResponseObject); ok {
+		if err := validResponse.VisitDeleteWebhookEndpointResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListWebhookDeliveries operation middleware
+func (sh *strictHandler) ListWebhookDeliveries(w http.ResponseWriter, r *http.Request, endpointID openapi_types.UUID, params ListWebhookDeliveriesParams) {
+	var request ListWebhookDeliveriesRequestObject
+
+	request.EndpointID = endpointID
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListWebhookDeliveries(ctx, request.(ListWebhookDeliveriesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListWebhookDeliveries")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListWebhookDeliveriesResponseObject); ok {
+		if err := validResponse.VisitListWebhookDeliveriesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // HandleStripeWebhook operation middleware
 func (sh *strictHandler) HandleStripeWebhook(w http.ResponseWriter, r *http.Request, params HandleStripeWebhookParams) {
 	var request HandleStripeWebhookRequestObject
@@ -2458,60 +6372,176 @@ func (sh *strictHandler) HandleStripeWebhook(w http.ResponseWriter, r *http.Requ
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xc3XPbNhL/VzC4m+ndHG05bXoPfnNtpVXOcT2S3T4kGQ5EriTEJMACoB2dx//7DT74",
-	"DUp0Esm+id9sEVgsdn+7wH6Q9zjiacYZMCXx8T2W0QpSYv485UmesnegSEwU0b9kgmcgFAXzPAYZCZop",
-	"ypn+l+VJQuYJ4GMlcgiwWmeAj7FUgrIlfgjwJz4P7Y/3+O8CFvgY/21UrT5yS4/e8vmVHvYQYEZSM7xD",
-	"awgdy78l9RBgAX/lVECMj99buo5KjbGPJdd8/gkipVeqUTm+x8DyVBNwnASY5ekcBA7wnPMECMMBjomq",
-	"k6qYPhVAFJyuILrhuZqCzDiT0BVs5EaEuUi8u5cgJeUspLHncWurDWKNqd7dGhZn+bxU7RT+ykEqD5eE",
-	"RZD085hHEUjZ+1xREIM2UAxskgzq6/t2MmaCRqt+7o1aZZjW4E0VpHIYqkqreAhwStnEznxV8kGEIGv9",
-	"cCF4GipIs4QocPttGA7+3fxBkkM0OUN8gdQKUDEBqRWV6BOfozsiUUJyFq0gRppogBZcfGBEKUHnuSYx",
-	"Iowka0UjiThL1ofoagWIRConCYo4W9AlohIpcgPMUPjAbmAdOkEgwmLUFgqizHIgrBjRgf13QSGJP7CY",
-	"g0SMK0QWC4gUgs8QGU4OPzBjGlscQrV8+FhfUuO8obnOwB5KpX4EvwsTmlLVVcw78pmmeYqsiWvVCH4n",
-	"keIoE1wD8RBd2j9AIpIk9jFdGJFIUIfYYEPTMNDoYYUyBUsQXcNtI3QTyvtciXZtQ4zMjetfIgWmPVae",
-	"eG2JLWgMLDIMkDimFtGXjVGbjOqNBtRpSWbCFnyD8ireQAguBgEGPitBIgVxWFi7n8++hex07TlXVCou",
-	"1oMdxric+pudOWZKrKtDrMLiDaz9rpTnIoItQG8Sa6lXU+7IoCLs3Z8XC1re/WhLQUqyhO1wKwZ61/DL",
-	"q7MYUdpLqtCdwNWapUEFT4nMr8ObACI506LbDSBawtuIjYoXn7p8AuroypLw7yXiwmhnwUVKFD7GMc+1",
-	"ZMu1HI/tLdiJBXte3t7y+aXgSwFSfoGLNIeDDOfrUCqH6j4EdZHX4UUqIrR4ifKLQRGVywFX45kdqJfg",
-	"iiSh5tHHgt/BN2a1N9hgsmSpR7CzkuHiTnw5vjibXPyKAzy9vriwf12eXM/GZzjApycXp+Pzc/v37+8u",
-	"z8dX4zPvLbm4/tcoQ3kE4QDTNMsVMWe0b/olWVJmHvuhuCIyTB3k3OTi6v4Q4PIq0NUoXywk9DwzQh2g",
-	"BTuupFWsF1Rc+aQ95XcFjPVF83s4gIf612d8IG+7eZZOZROvU343M+MeApxnOrIsPEjlLomCA0XTmsfs",
-	"OXXtLaCw9Rq5wed/yY7X7Gfj6WX4Znx1+psx87Px6WQ2+f0ifHdyNtZmPz350zqA8cV04gZVviDAb04m",
-	"5y1n4bPwKb+T2916VvqBbTJueQzn9wfDqW2d245e53pr/PkkPYMEIvUfWO8jgt0KVYN3d0qWwMtze6Bs",
-	"xFw1dcsu+/RIkiS8gfWjLjtb709mWYhDv4G3t1AfHVQMbbsZzeiSQXw9Pd+gQqaAqfZxp+CzGkXyVi+W",
-	"ZQmNDExGn2TPkbcCEoPo5uTw6ewPF9MjNyZAuYRYx7BLYCCIAkQYOpkgRlJACy5M+sEqTZ+1gwWeAFuq",
-	"lQkCaiHv1hC32n9JYoso+3BieZ4MgWiA/UmpFncuYVbQ9fJlHp6BIjTxqNck0x7jsE0ucrjrscvry1ie",
-	"psR/hj3Gdqu86lCjLnOotb26TfTLq8bwU3q1L1RPX7DwHLJZzSDDu6WtzOwtEnELtUKSmlb6EXROpdrm",
-	"Ch5rRhtsyDIY8ZypARusIuf6vP7N9NvCFwK0l9EAJ0SBVKEe9QV6Lsox2zH0NE5nk6hrJQ27oQ31F1tZ",
-	"ICrMQFAeh8Bif7gY5UIAa4/7Mrtr0TKm/OXUFIVhcZniN8BkSFmU5DE0+adM/fs1DrwBr5mlLxODZnTi",
-	"4Gp6l4XArwGfWq9coeQbHiQFye6B0nYLLTffDXmZomod9sA7wAPNY/Dp0Oavt3rK7xjE4Xw9DCADSq2F",
-	"zLzFVrMrV3Gti6wpoNKo69sNhlVBelT21RXrfgFm+u7cp/dvWpyultq0880HYlFOlI82gq8/Dau1t5+H",
-	"DRjVq+1rqaAqo+IA5xJEGMOCaiCXv/uCoysKGyoWMZVZQtZhr6Z7LnuFrwqt7xroNVPO1CpZh5mgEYRR",
-	"0XMxYCa/BUGWUJ8ZxhDRlAwIZIwBNnbqZ6W7rc0L+1R4nS0FiYd1EDy6BcC7oNykX0hdZNbR4IIKqfr1",
-	"npD+py3uapTq8wK3eJdpk4GIckHVeqbtzbL6CxAB4iS3UfTc/PemQMbbP6/0jdmM1lcQ87RCykqpDD88",
-	"GGDapHczEXCSZgmcEUVQlUpHJ5cTTYEq7QFrQ+zvtyCknfzq8OjwyHk9RjKKj/FPh0eHP5kklloZ5kcV",
-	"3QNpgvUDF2Nn3Kq+9GM6RMfXWcJJ/IYm8IaLcT2971oNfuHxupYiMZmgdi6kbFfaesFvZ2IemjrU7t/8",
-	"YGFkdvTj0dEu1ndANQw0dWQHoevpeZmbibXUX39DRpr1Ww8Tv5C4aPawa7/a39rXjORqxQX9r934z/vc",
-	"+IQpEIwkSIK4BYFsYcOYahGeueYoRFAGLKZsWTemT3xuWmiWoBBB1gRQblCuVaoNjSyli38l/qgpj/Sf",
-	"o/tPfD45exjZO2+/yZya52/53NidICkok/R7f4+p3oG2xeKidYwNUdwGeVATV9upffxKA/jmrQBdJb3l",
-	"c2TFlBS28Xp/ENGrM67QgufsmQLUyAYRJHLGugAdAMLMVTI0u0vwoPBXULWq+jOF4paERqd41KPsUhhP",
-	"jLSGjn8FVTKG3M8mcU8GqliYLq5tGp66UbtRcODo/JWDWFeEbM6jPjGGBTE9Z0e+nIKfSlFTH0jla8E2",
-	"rObcbqLrVgk7KKjmoEJnz9HpaEBCh9XHQdJljvvwWK/47heRZaPGziD581GAU9tpio9fHT2CaNm00yWK",
-	"SZL4SrM9hHif1dWaBExRw0Nzl77aW+n3wHTK71rO+umuzM/SPjMQB6ImpG3WacPePnN8Z9vudqT0RkDv",
-	"CxMkCL2RBU3giWOUjpzVCpDL4yM9EJjSjOhQQIL4QZZ8V1LXDwqx206DA9eV4A8DypaJXcXK7caTfcfK",
-	"nZYQX6zsWjKQFtX3HCO/Pvppf4u/4WJO4xjY3i/EtlD6zKOvawnoZIIUR9aOjTeYg1QapUUfzkLwFBHX",
-	"YfODRKezP/wuuFbE9vrhcyrVrKw0+65ET3KV+ebX6+2F+0btw6M7/RzxBSok+pLXagHXCMhJp+wB6x5f",
-	"NZwWomxAdXTveqXOHjbdHmZFc9n2a3xBb+NNfls/4u4B6Pq/+v1W7Aa8HBYvh0Xttijr6JDojqqVeY+w",
-	"Hk3npgo31O5GRd17s/Gd2Vd8/k8MsPX+RtXs+oh24HZD9/CpG3u5C2bcCkNy2FNypw99VDSVvHiEF4/Q",
-	"iB9FDSAuTi/7sQf7AetC+qNIm1t8kqP424eszVf99xyvtt7A3pzHdf2533nI+uP+Fr8ka5eVdnh4cXrP",
-	"yunNtEEgghjcta49A12f7TOaw4a6eeeTJsUXWHaUQev/hsqeXVPP92Y8qirGIPd5GOQ6q1+S6X0tIDMl",
-	"aAYoagvOobam/Bp25zRJzNtideyW7ai9d/ZO6/ou0+8bGuV9TqY2GsnyRYG9YsYUBDaVy+tZeZeHlx6+",
-	"h+hpYJfOrKn/595X09Biq8Fmj4q84IhEit5C036ecafNFmQNg1TGhft6wKYD7NKMmlk3MyzjK0DlgrnP",
-	"ZT1VI9jgVy2HANUKoXS219PzJ/Y2iAuUUikpW5ZHQi4VT23b9vM/vxyzKGtIdhhwc9vrvanLt9MMvqM7",
-	"14a28x1cuvbtkp2gX65jnZKbFczWE15xRNCKLlcgkHkzrg/fjTdleutuV7V3WnZ2EfO+2rOhvFWx/lLg",
-	"8hW4SJJUMkL/sO8UoX8ZvBy4V4n+WQNGJU8HDerSzv2woC4XvPN2wsZLTQNaCUuMGA59wrkl1LwL17Ib",
-	"tyO/sdzBfMX5jRxJc5j0nwO/ERYnYI+cP+2knhuMTahXVxg752BGl4yo3H3v61EXmSFnDY8UqAOpBJC0",
-	"qY0yozmnjJi7VXuRoYdLUyFOCsWXJL+zlOCE3ZKExuY1CavWZ+Q13JtZ+Pj9x7qZWAwX9yYHfQS37s05",
-	"j4U0iTVf8Hr/UaPTLm7hb+7JeEQyOrp9hR8+PvwvAAD//4k336yaWQAA",
+	"H4sIAAAAAAAC/+y9e3MbN7Io/lVQ8/udcnzviJKzyd49Tt0/HEnZyMd2fCU72VtLFwucaZKIZgAGwIji",
+	"uvzdbwENzIODeUjWa0/4l2USBBqNfqG70f05SkS+Fhy4VtHLz5FKVpBT++er92f/Bds3TOlzUGvBFZhP",
+	"11KsQWoGdswlbO2/TENu//j/JSyil9H/d1hNe+jmPMQJy8m+xJHeriF6GVEp6Tb68iWOJPxRMAlp9PKf",
+	"OPencpCY/w6JNr/amacFVCKBakhnVJv/LYTMzV9RSjUcaJZDVM6ptGR8aeaE6zWToPp+w4sso/MMopda",
+	"FhCYg6WN3xYFS0NLXcLWjEtBJZKtNRM8ehl9WAG5hG1MFHBNqCLT6FWhV0Kyf1Ez5CX5EagESabF0dFf",
+	"kkvY2j9gGk3ILzzbEgm6kBxSslkBJxqnI0wRh40fyO+F0oRpRVZUrcw3SgsJ6SQEY0aVnhUK0q9CCKe5",
+	"PZ7WF2sJC3bdxsKFplITsfDwx0QLwlLgmi22hOkQqBKuxOVXAqoSsYabEvKF+dEgFVsasIgot12uF9dJ",
+	"tZvScaGXnyPgRW7mlEDTA8GzbRRHwCVLVlEczVmWme18Cuzv+OLXE0YzSPQ5/FGA0m2mSSFjOdMgA6fC",
+	"+DIDsmCQpUTBmkqqhSTJikqaaJA/kBQ0JNoTn8iZ1pCOQTzwRKTm79aiv3AwhFDoxcHfYvvPi79m4P+a",
+	"Q0w2jKdiow5efPv9t0RIwpQ4+Nvfvv/PgxdfAdAKaApyJsWmDdKLgzlVkBIJiZApWYnMgG5pNRFZkXOC",
+	"v1bd6+eMs9wc4otOWBjXsARpCalFEPWD7BJ/c5G3Yf9tBXoF0gK7YBkQZVhNkQ3TK0LJfKuBCJmCJDmV",
+	"lxWfzYXIgHKzdoNCek/yfrAatVEURznlBc3695sixsiGKqJAk/mWrChPiaRuCOXlgQV2vsPQFRpqm44t",
+	"1hsbLWELMfYxZNmxkBIShLelwuzOg7i8jXYbqZl+F/MZDm19xWEzu6JZYemtSblf4khkac+3UmxmTum1",
+	"5i0UyPCaITnqAKymjD2m6jDUoa1WGBS2zTPpt3uSctx4rbFz5kOKo77EMLidcr2ipCaDvOIEVQekns+c",
+	"3v1dzIN6tucUy7NvGzVuG8QOiUki+BVIIxm1qPH4M0XMpD8QQzyVCZNAlhlzhXBByrNtktcO1gKEgT8c",
+	"g8Lh0775GTuZ1/ejc7F5L8VSglJnGvIeSkDK79zLCVssAvqAKpglgi9YCjyBptQQhUFlOR8v8jkKVvur",
+	"jnP91XxMGJ6RGehopsX5yYryJQzt34B9jCO/xL2i76abqH4wSyHTtL2VY5GvqYTU7OCZIioREkjOeKEa",
+	"m3umYqTKudArHJWWFBrFY2AZg8ukBs0gqZcE7rDcOLNesmeahtXOH4XQARjfU6XoEryAWJu/VbFeC6m9",
+	"qvartgW8zEITLqH6mVXMml4CJwsp8tAsVyDZgkHar+gt+Ha2hSh46rFq4U0E18A1WYC2Mm8h8Deoqgd0",
+	"vtlE7LBTAyaIXHsqhqQvijynctvGMk1TqGu8mjmDR9n1ZTdn5ED5LETtIwhTQi6uutYseA9IXQSJG6xm",
+	"rvZVn7Abe29B05Rq2kZd4+w/j7hfXK+NWHXDG7+Ozh3olhRSkOyqVIVqQoxgKIyeMiTpKeWZIsJQ25S7",
+	"cUTwBJwizQ15GQ7OQIOKCUyWkymfmgsq8ALIIYF8nYktGGlccD2NzK1lGm2Bym+0SOn2m+fPyQHSLqQz",
+	"8/k0mkz5RwXkn4gZ8o7m8MlCbO6URkhRTagEwoUm64wy7u/LDKSaTPkoxwVfF3rmtjSAqCSjSpnbeImp",
+	"C1HIxCtyRTYroRxjKwPZlFuXhlP3uUghQ9yQf07rC02jT2ZQQjUsjdsDCOWE8bRQWm4nU368szBRl2xN",
+	"NjAnCqhMVsSY9IlQmmSgFFmDtMIZUVAaaK29dyDH2WRocuJn/UrstZh/2KI/oNPtMWYePGecaoe/nBfB",
+	"zlIDrJuPPmybfgMHSeyZPy4FXmzvD1EcZUzpsAvBGs/e9dZhbDa9aC1BXfmllBZrRTZCXjK+nJD/gi1e",
+	"RUWhieBAUmEJGuebRPHIe47HfHPlN3QOGdFg/SMlCHRNpa6421wTPHurqNdHtLsvqstJc7olqZiQE1jQ",
+	"ItPKUHTprZnUCfGu/Et2y0ESsAd2vILkUhR9Vxk3YuY0dXvjKEBH3dIakzV+2g3iRTEv8dl9jaE8gawb",
+	"xiJJQKnO7zUbec30A5tTxvX1u3fyG8xXQlye8nQtGO/2tcGVd7c3SenUfo40kwC7giYh0SxzJlg+mpQ8",
+	"SGZmL592RVzQRPv5w4f3F+Tj+RuC0FolsxYKr25RPIDHLkSdUA3vJSTM62Qvm1K6jeJoA2CcT7ngemUt",
+	"LirR02KUYVAu1S4PtdluaoFU851aXT5wnVazvGaijLv9Ny2bL9YXeIa/fNE+E2uuXvco5R2t+43VqAnT",
+	"29joRMU0xKX2fB5QymuqFKSEZoIv0QsINFkZI8eQWsoUzedsWVCNhjpwzfR2Qj6sYIsTMJ5kRQqllV3T",
+	"wnCtJbUXVrKWIl9rhcqZcjK3kjYlVE35OqMJGH8fSGVm+aMAuSVrqjVI7gwo8h9uN8ZW+o/P9rrGt+SY",
+	"6e2XIdWe02uP4KNhRW80wUwbE41qcLJixyVt/6DZhJyd+LuQ/wHRK6bMtc1ePzJqiMwZj7Gxm6acai3Z",
+	"vDBTHFJOs61mibEfM8QqoYkuaEasEb8krH4jmvJL2HpScHZOkwzxEJgiEgmXHOB/rc9+ylMByipUulhA",
+	"YvQqJBaSkQbiovjXv7YzvZKgzHkFvEmZEiQHubRq0BuB3CjtjP0LTcfcRJ6ugFBjoVGlLYRT/ppKcfAb",
+	"45cZSKJYzjIqmd46ij6a/Of3z12IqzbzgkkzwUZMeRmGUIQuJYAVVP4iPSFn+TpjoCpYjHvIGcbty1FO",
+	"r0v/fOmr7yae6hLFeCIhB65DzmgLPV4SDIM5q30DEogVVIa2cV/WEi+Z2smpKVeMJ8iHyrL9M0Ni2pyz",
+	"v29Yl4HxUZg1chPps7i2vKZXxginOZoodTIqPYBwZVjPEU95DULCdDceC/glwBqhR3+JBGW1k5nM30JM",
+	"IMpw+JKyfvqqxRYq+p7d9IpX/bTpir2xpZ+JhGYB8/HH4/fku/9F8Gui6dKR5jQCfvDxYhrFxFxkDk5O",
+	"p9Fzh3G0+rwgNEeIpIKYSqm9IKrCyEtFUkhYTrMqtIajTk4O374lV4q8fXt4cjKZ8rotYJceyb1N0m9v",
+	"8K3lW3u+SIWW0YxhiSRHcy//yRwWQvoLL+PL6pZ7oSVbAyID/47JGU8m08huZhoRZT8l08jZ+4ZetGR5",
+	"Dmk85QlVcLAwyiCNcejaMMaCZLA0uCkWC3YNiJmP52/w5xLSIrH4nnK9AiZJKnJDdsTuKcVN0RJiQ5Y8",
+	"9RRq7+7m/87amvJyu46ux5Kv8TrbqFAAuShRCJ6/2RBKAGFUozEwJ+Q9/mE2l2X4NVtYVlKgJzePGrZ9",
+	"MjV7JWSUeZun64rQGRfaWcyN617CCMhzi9qQZVV37tI0Zaht3zdG9ZlYPxlld1xOc8YXoofvK9hASiFH",
+	"upMcP8+87ReGs2uh0i6arZjSQm4bEqtvb6flT3/GX55yLbcha74rRoOaY0BGDiXkRC0cVBMH9xekBYPv",
+	"bmrLwXqbh8nNDwyucb0WUvcH8cCOUTc4AzN+dP6Sn74bvM5bhjdMjDCxMj96GSXqKop3hMt1pq6NrjYq",
+	"o4rmQZYpE+SuOMrE3fIcL5cryNHex4CDH2IUlhI8JomLDljJ6M42tv+haaoIJY4AiVoB2GSdmrlPtTGI",
+	"tZoQnv6u3CRrKv8oQHvjAWyoT9lfNiOQinyDYjImTrjGVlfGxLilntvJNpLpBtw2GKOMHq2rWAe4+dj8",
+	"1klyfz1EXBrsRXGEkEZx5OAMXgvdbWfmsaMah7OgmYLdw3mVpi5bC3dn/65mML4EVI09YzCiUZpsCw0S",
+	"72k7mJuQj9yHJTAMgmovg4UmotCTYEZHuamG6B3eFQ3CXB0Jft4Dbi80pTgeAQiv+d7xh37xksSRrgm1",
+	"V9sFZRmkvcvX5ORNAfBE50AwhOc9jiYGpaortrkkloJ0J+pVD0J1Co7uULW7D3xVPtxtckxSseGZoOns",
+	"LlIpy8mCvqkLtuSQkozxSx9XMDlNsc3uEf6qhPLXpkB6pIxZerw1UEnp2ybcBKXKWD4dwUGjqLw9qCcP",
+	"yFi6NnoVDhcqTXWh6p6496fvTs7e/T2Ko/OP797hX8e/vH3/5vTD6UkURz+9OntzehKQub35Pw6z5YJ1",
+	"wIL4au88hP1dFA7mDHWYZe2QL+rFmXMYdIR3H80A/jqzFiVsV+7f19udO8jrNUErWELHFUJQW4TW0iN6",
+	"Pbp+nElwLHLKZ91ZCheYdkiJhCsGG5MzWwsac7gCScQVSGvdqFBGRY0913X/eW9iTcPZXp5U+JgSIcel",
+	"1eycDv6wnDuE9tdibtz0PSmrJnGlR+p0esHR808s47uYr8tlMxj11+mYSFhSmdrAsHPcom/N6A0MMNzA",
+	"iV9P7vgSjhh37GNNl4yPIq735UjPxWYjo68q52JjoBxkrTria+LV49ut2gC844B9BtstvAi4ymy+nSnt",
+	"Ln5d0q8tNVuw2MTm0nZp03mpoQai+Rc40CwhNM1mHv8DDpcSh7Vf7W6wAWQJUgdiL0ap1PevPl5YfXr8",
+	"6t3x6Zs3pycNPRu60viMhdrMlUwyHGESYKhLO3T5MYY2XB5GcM43QlwW6xtFz3YYGkcYs27BeDohrbQP",
+	"DuCvd869V/M9u6w0fNVyy7hch0OlndrqfKLWG2vw5iJWFKMPfEvqCRthl/Wut7sntYD5iNg0qs8/jUIr",
+	"DPiyjRPbhWjQLem90pify81oVQsXojfbXKlCixmj3sT8FSSCpwEp/Xd2BYoUa6KE4D7jHmOG8sqmXWSW",
+	"boibacflOcBx9XTfAUenJ8/u29M9mWDdPsfdE9/Wwq1Gr2UpBlUqF3Z0947JO/QZlokToavFOIdiw2yv",
+	"TDs/b+hgd9Rl62BXVM1yZ+C0DarSdd9WL2KxUNDxnZXwI1QCjivn8uvFFVShLZ2DLIbS+kM5JLsuNRuL",
+	"OpAF/8HaQy2XW/2Fksk3aeRBOuZPfDIkLbTIqWYJzXbymQbJgnGlZZHUnGcNsA0RkPoYFxp193ufR+Cs",
+	"N9xRiBNsELrpOZ0bDO56b8yH1iOpMGhqTXBMRzYPDswiK7ZcWYvSk+MPRIJNWti11fElpReYDRjRHVvz",
+	"PTpo3ExBLeoeMQTQZANnfn4XR3KBZRtvvcGJhB9OqD5a7Hlwm7JQQtMxftFAh1AmXqxJLpSekGPrrHYv",
+	"HCBtXpIs9alLDAVS7iShDd41cgIZ13/9Lvg8bMDmbEP8rhmmm4Nx4O1S26Dt56w+j5cgSp2F3kampZeb",
+	"PCoKW/pdUj2sPe2iHXA2Hqf8CeJ2Y/0lTziON5TrUF60Bi6RF3acyRFcpzf0CAfpzN9/atONDhuW4ASv",
+	"Qhen5+9nP51+OP7ZXn1OTo/PLs5+eTd7++rk1FyFzl/9hpei03fnZ8c/79yPSj9k/QIVEs3nYqOGr7oP",
+	"ec9vPh0blvIjrvMX/+cNphf2mB+cVy/idkQoze1TIerM+wPFUjC3C2pfU9V+OjqDG6Gx950fyBI4SNp4",
+	"mhGyZcb4+m3GYejBg8vYJhenb06PPxBzQ7fZXe7WZHWDYYJaTlZoN9ZEmM1pcjnTCEjrHmk+xoyq0jIr",
+	"k6okkGKt8Lkk41oQUWXQVy6agV22EkJq6Mf9D5BAF5HjtmejQh07QFQ/Da4NGSS6753BHWbhDkrKB9hl",
+	"F4Zpls1aFVYGDe0Bd7xdFtLZKOugMTquABpytF+sADTusC+rf1RdALEZKLVQZcW9uGGyVBzZJIZZh9Qx",
+	"3/lcPvc0WUgC+VpvCWJGueCjVBrzIUbxYxtdNqD58fxNn8DVPoW/pv9MjvghZjPQ9TpjiRXqhy6hof7R",
+	"9QFPAx9f8XQi1sCv8wyJWx2IxYIlkIqkMBJvotZm+3ZzeTax/wY1Ix7Ieego//Hm4h8u0/kuTrV9iu5n",
+	"Xe5EPy35xrxge33xy7sYzy2jWgM3msTWNsEndS4t0yQ10jQ1qnVi8uqn0fO4zO70OohQTl6d2R+WL0qR",
+	"7af8RrfjDPhSr8zQ/p1a/L8LkmsNyzuUW0dmm14H9UVJeCWYn/ppuF9jnI2LjYef8gQf5pbzBuGyXx6X",
+	"ak/1uv94qKrDzW7S9Vm6AToBTVl2N8Wrfhfz8SYjLm8CC90hLHMBDz+aPS6kBK6JH2ENPU6KtcnWgNTV",
+	"lvEG0Qj5ewP16j/4PFrvul80wvgOXd0nU0PNUKCyiZufhCS1dwAmRV7FvqSGtxydbWeT/xMqJcPHERsq",
+	"R1msD2j5BJ4g3f7CeUuy7nLePK33AoNrOorsZqvzGjtVOgS5yROQkeVBJ1cz4nnLCmgPFRYtE3Ya8dGB",
+	"NBtkzP5s3pBfZFgO9ghBBLAz2SkodFTU/F33ZvyJj7X4gtXRXAqvtg/sbVItTRJYa/dWH6WympC35kFQ",
+	"TnWyco+B/CsiJLDtGsg35jH2XIhLY+JyoZ9Pg/fzcXbKrW2IHdR0nXU3I1X+Wxs1AJ6iAxfH44Mmx1zo",
+	"/DdcZYYwraY8cdrNoqXgmmXOde240+YS8gWTOaQuvdV8j3ieTHmQPftSGM37Wi3cBHYuDptqvZEPbWsK",
+	"uxur1ibsMX6ad7Eb3pTcNRFtyt0qTjVzkzDl5diwSLIAf4Uh5iZoVGXrwU+Xzr+t6upJk8THe9aQuIXg",
+	"9WcwVu08tHHVJ/tqb/1xQz3WOD65p3q2BslEOgOehuO3jm13xt0y9bk5l9Wtt59NMxgXodDiEria+UfV",
+	"jRW7w1vuV4Ua+YtWYLr6eRuEOHwCoWP94F5B36Gnzk85nJ+zYwDqdmVKzfR21kHeNynbO85u3IWvsxyM",
+	"2HBIZ/PtOAIZUTvG4yxYPaZeiLaOsiaCSqaub3dkdk3HkX11EaduBK5B0s5zv9NqO9VSfTvvt1B9rQB1",
+	"Yyb4evO0WnvYQG2QUb180FZpqGok+NqaKSyYIeTy85B38AODnid/KVPrjG5nnSfdcQ30smqGsmuk1LTV",
+	"RbLtbC1ZArPE12IZ8UtxBZIuof7LmXu9PbKCaWOnYVDa2+pfOHSEH9dLSdNxpXVuXBsnuKDqO1/Ina+r",
+	"/YbG2Ibd557R7m93oKvNVP9d7BYPAe2K45xAxkz9g37uTXEUuwH77szf/XL0azLDA+WJHZiDcd4u+Lqe",
+	"r3SkYN/qtRiu+ZVeC3CVlsaauANZl66mhttt9VhwGI4rKIHo+HKMNgoVa/r60s1rujW3y6Gsl0B+85Xr",
+	"RVCWfXK5qYj0KPgUCEmodq1pl5Ui+KW/pDexjkVF7CTjHMh96fGnH87/L/55cvrm7NfT85s/OauTWO2g",
+	"G8daeznhkV1zdJXMM+jm2qkf1i+PPGA3FkdVebKxD9nLlUYAfcdtOa7gNjvsq3Y2kqEUJBJ0MAPTE+7P",
+	"b18dH1z8/Orb7/9KFFtyqgtZlvL9x8Er8+jUWMAHF+WX6Imod/nQ/xvfUhecXdv8d6VpvrafQXz1wn27",
+	"gmuSsqXhE7Egz/DDndET/HQuUtcb5NlgixB/srU+IZNbx+IsHtEd5Y5tNMFfBULbv4v5xHm2MaNyUspj",
+	"81X9Ta/9v72rZsGqbnichWR6e2EoBekRG6qYLivmf3P7v588Wbz+7UMrZfgV+U3Iy18urJNVKWKts5gI",
+	"aaPA789sXs43Fmbmi6pNI3o5M3WBjD8WJ1MTP9g9ZnGv1F3Kka0zaYebz1Ts83vNJy7l2NewMavYeWtm",
+	"HpGi0L5iQ0I5F8bzy+kSylXRC+qmxeWcxamaxSotW1lfi0VORRkrrdfRly/WAsd0+x1MmbM5oZrWXzm+",
+	"en9mZmA6g8YQ/PwKpHKJH5OjyZG73nG6ZtHL6C+To8lfrHTVK3t2h3TNDnxqzBL5tLyimQBzZIQn1tHE",
+	"bBWUSnb8t0dHNQe7+bOVO1F2QhpXqrMhqS1e2mnbsXHtGga2hqrZ33dHL+4MjmZNlQAIHzl1/YQgNYt/",
+	"f3T0cIufcQ2S08xlBBK0wyxfeq+rPbB6JVZPrTFxHX6I4KBIzT2l6VLZ98GeFj4Za0eoADXUq9dGKLZA",
+	"6R9Fur0zJIQK5H5pykjfD6NJjC/umBgHCLEU9FYKeFhsMo6q+i5hITmGVQPVSmy4K+iGhPugtHNFM4bJ",
+	"ObETV0JiaeDtno122QjJsK6RjIZYS7GUNLdPd5z6CjPQl7iSrYefL2F7dvLF3X5BQ5uxzi1vloy1ppLm",
+	"oG0u1j8/R8xAbYS2dz2+jOyU0S5XxDUUDSVZfmpx0HdhM83JjUenke+O/vJwi/8k5JylKXBc+buHW/md",
+	"0Fi7/knyBRJqjS+66b+yWQ6UDdMeOPs3rFs+2uDtTyyDn4Q8rb+gvg8100oVHaVjju5j/e4DqUW3y2z9",
+	"B1ccP9LUVzTdq4kuNVGmSNQMdZNsZG4PS9DmEQeepUtR+Hj+psY4mEmHTIPV7g4/4x9ObQRN87+DxjJW",
+	"oxSGn++OdcYdnsdOWcD2geAI5/baq6O9OrI8YHQRErfhthgLl5Vujdi2avV10GytszDjmT8PP/8u5mcn",
+	"Xw7RC9Ktq47t96/FfBTr2Ul7+e6u+ezOK4G2T+e1mJPKWfTQVGlW50+aMpFECCWy4LytGcYQYbM5Yqd7",
+	"5rWYH9eG3g9FxsFi7Jl3NtRgRbcuU64lmF0dH8iVy1fdBe+PBca3FxzyOtWQ+8ScT3sV9JQcbrbL5g4j",
+	"0DCvV/61Vn07Vc3lAoW+jMMz5fv8GY94rU7si8mRNTRNy2FFmCa2jN6BL6MXEyVsdFAaWYRubwlaMnCN",
+	"CJhGJ5ar5caUayIMaVX+VWSudARsEIpJFO/IIscnRhxhN8d704z34HUMdoF94DthRx/VkEiqkRqkj+ZL",
+	"TBotWvei8M8uCh3xokXuqzK71q5D1k7qqrgsQ9HiVxlbcuVqyWyx75yVYvayHNcqVnqXu6shVDWHiYlr",
+	"YhX7fjBESFI2sSJVbxjfrDZuCVrbDlS1JZ+pHPPa7Ksl89rZICiIUSvcj2VmVgEqM4br+GoHtiGHe7Oh",
+	"hW/tg+EIpTssNYOLO7AT/WJ6BQpqNRdCS5alzatFxj9cCK6OZOIK+nuNpry+64TDtwMOwDG+DXMbwNBa",
+	"ZUmzaq2y7tZRKP88PI0viBaY5fujelemo9Ck92lx75auDQgY8z1I4LZPguV0IVOQWMzK3BYeT8sZ4mVq",
+	"r+X2Wm5b9Tp3tjm+QzZ33o2wT5UD8nak+rMVKLpUoHmqmDEOTte5Buf2nlAa6Tbk7PUgyq8YW637GqQ8",
+	"rekzl92y08DEKrm2jkPnq+PjvaLbK7p+RTesTMqaKy8/hyIQc8aphXIX8e3L0MWvPpUwrXRI1Chncozr",
+	"HpwwtRaK+QTx7uP9stc2e23zFLTNiQ9doJj2xNHQOFSR44tfR2iZKg84qGMusNDcBXBNXBNlpSXQ3Kw2",
+	"NW7baeT6GMfYhIeSc7GxQwnjtiQQyh0TdDk7Ia4CrP0JOTuZ+FnxzfcbqvSB/eTg7MQmgGIeu9IznyBe",
+	"NrN1yiZ2lXTN4x9bJkUqbf1bEny9GL4kScbsMjlTNvPKtFj8oWyMDkyv3Itztzuba+okJrbwdJVoEXAn",
+	"XEx6LDnedfLZe6ixViUo0DZLiPoirQZWn6JsKjWBb0wtJuQYO4lZna4aI1dApZ4D1bakbq1yBVOEpVnA",
+	"83Zht/FazE99wvCDhACq9r3mzByyXBPI1CsbFMLVuo1Dj+5uPdseuDx5WwnQpe2aY/GqIKwBGyT3lVEJ",
+	"q9bsXAdIXQN6Jg41L3eE+WhXnhp+z072emivh7yM8RWzia1AS7SkHK2pvmhHWw1VHSP7opqnbtjTjLEP",
+	"57IMBRbd/vZBxT23dQYVHavcIpb4Y8F8Krqtw+MeVJkquktpNv4DWYssq63iSvWwZrM/rNOo23k0gQig",
+	"BKqh5Nx/rxBgs5fsqNDft4+S/CYfM+TnKOWpJITuhdUTEFaONK18eqZKn2iOveNtxW0jPGrNpkbYCGtX",
+	"j70v/bXWL+3f0EgIdXvrSHUrkfHIeXat3EsPGHEfu/vvODNQgix4PclyxxuBt/Ja3pyZ3D7utHd3XywN",
+	"3Z1WQVZKy9wFfXZimYltKVI1HPeWOn0bGPfAd7frjL2dV/1Sdl/uyIK/FvNzsblXIrx7hdfojPTA+q7Z",
+	"CSdAeOeI/8dWd8rXXvfeKeeHUZplmU/tfAJa8NuHRI0qTGlzZjjSt+bZq+Inoorda3QvG8vOjr61hxWT",
+	"C8aZWnnB6BpeDUtrKzEH9PG5G3VvLsiQ/85KifvMnriPdIlxfY5K5YeIDUTa2jeF8jdeyz3ZpxvQAvVm",
+	"BoTY9NJjvcvQw1Lkk0jo6eaWJYQnjSjm6o1kO9HFdbXGVLaweHjOUARbbFwcppFDiHnPhjRs1Mk8Oyib",
+	"K3b49NcSFPAmfF8bXvfAmShSAL5v6FzZeJG0lXuf3wxgE6wy0NwNwFUfOCJt8N9ElnD9lznjk0lOr02u",
+	"JEggObMlmW1JCMWuICY5vTafwLX7xKoQFzfL6ZbMgWSw0EQU2nXTTUC8PJp8P5kcTf5mECDhCngBL80H",
+	"37v0crs6+R9YQRtcOieCQLlvxfRMEdsKHSFC4CfTLqQ1e5ze7UHbRgNWctni4JRxvDqYCE9M2JILaYON",
+	"mDkSgs7+eOZ/HN08naQGCDaruhUYWIP0K4GwPKlJBtR6JpkiuTm0qukcqRXoCtM3n9WGVFCUFdA7ZNgQ",
+	"SLm4LUT0+hYQ3adLINgWL3Q7Epsdn8DjPQh/kobFGuSBrCFpyKzAxtmq2xlxXji2q5ksYkEEx3CYd8+7",
+	"dYwgz3wJGK8wvANMSMwud+9wCmk77lFNNlTh1WJCfMdXKm32lnWkZcwUXqiWm9tGBDYrARtyu7C3KHSG",
+	"TApM+l7gdialRaMHrJu57dTA+U5tQeN7KsDQbHH/wC9tdhqYBwgNR5Q3pQlxbbONWlrQTEFcmSmGBuN6",
+	"NbjupuOPV/enlhtqKNB1Xdl7Lp6IBDNQPKAXwyboIomnDKkUSd2GCVkOYb9CKdkcjRtBsqFMl03b8BZX",
+	"k7FesKKYzaHvuvYW7rPEXKO0cog0FdgCTyZY+sh80VJnBrW+qYoZaNCf2N6trsqbh7tCvPnCox3dQQeu",
+	"VWW4pEHZR/O+Cu7sdiN96II7rT6hoYI73m/2GO9NnlKhnT+JM9c3RH7SPt2PCkxzTC2cWxfTOEDpWgNj",
+	"7OHsTcxnPfnAOOJgp0djZxpWq+3jfYro7h6TwbIMfhg2HH1KMrtM3wm061YupxkPQll/SqHqott90ziw",
+	"MafU5eN8FN/kg96bA43tQjY9w7rPHqP7amYhsvV06U26tr0xRKqH6o++2kkSqIayMfp9mRu7vfcfuIZs",
+	"u/F7t/pxlWQf3OD4yC+5qUhbySZiW6/ihRHZfc8jXRX/fG9RVLxV4XGU7oITavVSWkPvION8xj8Gqv6V",
+	"jDMc0PLzPdmqf40uzt0skroBe7N4bxbX7sWqTh0+IpBljbhywdUN+O7Q91rrZz5Tcf/hGLAVBDly7f8Z",
+	"T+HaP4rC/qAGfusj1sJ5l6P4NmbgzaIybzESTXjZN9aGaEoYyDc+UPPi6OjoeRTf1KQ0P2sGvI/i/t65",
+	"d1tDcUXVLBcSQl2PwMZF3SPAnDJe9rRliqwx2N7utll7nTy+CaI7tHZ4wgYFzHdNaih7lWAxvnbfH59C",
+	"UYIwHpbeZjfVgzuX71uSW4nJMVUmz+nGXGWJb1q5l/576V8VfrXM5Qle1kjFh+F2q28MS39GM0h0f6l+",
+	"VWoBN/q/hSV2fPGr209vlR7Q6CH1mHroS4ujTtdSg9ojfwIu+710eFJFE6RYE+pplIgrkJKlmMaUWgp2",
+	"5ULw+90U2EpMxEN24J9PAhy7ONReAOwFwJM2DyyHg71TgIwJ8ETYFg1GCKB5au9JSEGqpCoJFOvedgiE",
+	"dRGMnj6qQLiHgrg1WfA4xXDHmSM4hHCxIYzbOMpTkUf+xZBXMkx51+peUu0lVSmpfvG2CYqrpnWNiX6V",
+	"fLrJVQZ9YN1xGEzpeRRf8j083rebeSRR5Rfvebxf+SMf60Xj08ouecCsv/d06x4YOXrYC8AnVliI2uIB",
+	"HDY7fvtbe3EkXDHlU1t6o9AuRIvD/+2lYHM7j5VotwPEqPZ2PtnAEIE/vcey5FIBzpZLEljrEh7lLTpr",
+	"3ZkjdfVG973I9vKslq6HHf5og5b9u+sMvkacHX72f5ruUHzBZN4j4XDAY4m4ODi1rOAYfGPbF8oLtKr1",
+	"WyQOM49gY5UguMugbwoppC2AY49/RZV7FALcdYPc99bdC5CaAHlLscGuJ45KiNSfAaCLYXEr+0itAPSY",
+	"jNILHPjfKNMId9RLI3YEFs1wuC6rwDy6c2kj5OVciEtvipSQ7d1Le2HSW0gSed5KDE7+8ebiH97lXbaA",
+	"aBH7jfzf5kc1Fvv3v0uZXVx4XDzqXWovs/Yy688ks45XQiiopNZuxM5G6DDvuiHKuk2gYm5gmMOgP6iY",
+	"l8AeryC5FIW+pwcK7QUfK9BmAfG77Y38uzFEgcKb1iM9XHjqFSjKNwIXWrI1kGQXcc6xWTv8Gu2aSpNG",
+	"tTVod+1b1nTmo9TGXWCH/PtUTK3VeqVObXSjff9DPnZRIHtLmdZtIPeqWgXgHnNOI/vHXzTP/6l3fG+c",
+	"4k7r96OHLMBIaKJNUaoGazzhHvADlDWOpNZCajr4rO69HXWBYmbcc1BMEZ8VMnvQyshNgjWrDxKrGTSG",
+	"UBEJpbD9eP7mkaWNsXxdebdSJRRKixzkv4f+csCSdQOz4wi3WC8lTXtMr484oCUN797mCqx0j0bXQ4tk",
+	"h+i9OdaOyFjEDGp4LQglK7a03cEYyE761pCvM6oHHuV/KEfdoyHmFxn79r0Cff+yN+QqM68HSxyRb9RW",
+	"acjJ/7T0cpDCgnFIn9cIoxzrSYOBHCAL5t5F3XvxYLNShZThwsEljVgIQ8i5oiyj8wx2+MbtKMwsG5iv",
+	"hLg8AJ6uBeMDcYffcPRpOfgeeWdnrcF2TR6mJ9aw6en6nJ2QdRRAoHaonlbcd81GRiHLduew7tUrs7PW",
+	"I5WQaEExTJreI4MFdz1kZCVMJyjbg4UtubFCFSQS9ONVIzQ5P0Jii8E9A+0y0DksmdJgfEQGUVr4Lpf2",
+	"/FzdfriCTlYKyt3Dz/5PV1+i64Xjif28zXDDAZ1qgTsOAwfyPUqax23sQwj7Bhgl7Zqo047SsUEE28MO",
+	"MmZ7/GZieVvuOXSTMBhlzZxUox+Qi8KlszOjnCvw8fU0U653VylUQp6i38V8qD/uU26H8On+TUl30Nsh",
+	"U7IiiH3zz70M67Sf66IKc712pdqgAFOHyvrwut1vP1OeZoCePkfGHVJqt5U3/ubApHFTXUi4n6ZmItEQ",
+	"7qVdCsA549TKiUB37VE+vZ2KLg7JaykSUOpP9linbH1WHusT4hFICsn0Nnr5z091jkEa9u7qkkl2LeSa",
+	"Y6I52efoR6AS5KtCr8zchjpxcSR/G56IDumaHV69iL58+vL/BgCEqgki8BEBAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,63 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+// APIKeyAuthenticator resolves the API keys sent in place of a JWT to the
+// key and the user who owns it.
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (*models.APIKey, *models.User, error)
+}
+
+const apiBasePath = "/api/v1"
+
+// billingPaths are the routes that change or reveal a user's subscription.
+var billingPaths = []string{"/subscribe", "/subscription"}
+
+// apiKeyPaths manage the keys themselves. Keys cannot reach them, so a
+// leaked key cannot mint others or outlive its revocation.
+var apiKeyPaths = []string{"/api-keys"}
+
+// requiredAPIKeyScope returns the scope an API key needs for a request, or
+// false when API keys may not make it at all. Billing routes need billing;
+// other reads need read-only, which enrich implies, and other writes need
+// enrich.
+func requiredAPIKeyScope(method, path string) (models.APIKeyScope, bool) {
+	path = strings.TrimPrefix(path, apiBasePath)
+	if matchesPath(path, apiKeyPaths) {
+		return "", false
+	}
+	if matchesPath(path, billingPaths) {
+		return models.APIKeyScopeBilling, true
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return models.APIKeyScopeReadOnly, true
+	default:
+		return models.APIKeyScopeEnrich, true
+	}
+}
+
+func matchesPath(path string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func workOSUserFromUser(u *models.User) *WorkOSUser {
+	return &WorkOSUser{
+		ID:                u.ID,
+		Email:             u.Email,
+		FirstName:         u.FirstName,
+		LastName:          u.LastName,
+		ProfilePictureURL: u.ProfilePictureURL,
+	}
+}
@@ -0,0 +1,93 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+// fakeAPIKeys maps each valid key to its scopes.
+type fakeAPIKeys map[string][]models.APIKeyScope
+
+func (f fakeAPIKeys) AuthenticateAPIKey(ctx context.Context, key string) (*models.APIKey, *models.User, error) {
+	scopes, ok := f[key]
+	if !ok {
+		return nil, nil, errors.New("invalid API key")
+	}
+	return &models.APIKey{Scopes: scopes}, &models.User{ID: "user-1", Email: "user@example.com"}, nil
+}
+
+func TestRequiredAPIKeyScope(t *testing.T) {
+	tests := []struct {
+		method      string
+		path        string
+		wantScope   models.APIKeyScope
+		wantAllowed bool
+	}{
+		{http.MethodGet, "/api/v1/jobs/j1/progress", models.APIKeyScopeReadOnly, true},
+		{http.MethodPost, "/api/v1/sources/s1/enrich", models.APIKeyScopeEnrich, true},
+		{http.MethodDelete, "/api/v1/webhook-endpoints/e1", models.APIKeyScopeEnrich, true},
+		{http.MethodGet, "/api/v1/subscription", models.APIKeyScopeBilling, true},
+		{http.MethodPost, "/api/v1/subscription/cancel", models.APIKeyScopeBilling, true},
+		{http.MethodPost, "/api/v1/subscribe", models.APIKeyScopeBilling, true},
+		{http.MethodGet, "/api/v1/subscriptions-report", models.APIKeyScopeReadOnly, true},
+		{http.MethodGet, "/api/v1/api-keys", "", false},
+		{http.MethodDelete, "/api/v1/api-keys/k1", "", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			scope, allowed := requiredAPIKeyScope(tt.method, tt.path)
+			if scope != tt.wantScope || allowed != tt.wantAllowed {
+				t.Errorf("requiredAPIKeyScope() = (%q, %v), want (%q, %v)", scope, allowed, tt.wantScope, tt.wantAllowed)
+			}
+		})
+	}
+}
+
+func TestMiddlewareAPIKey(t *testing.T) {
+	apiKeys := fakeAPIKeys{
+		"ak_valid":   {models.APIKeyScopeReadOnly},
+		"ak_enrich":  {models.APIKeyScopeEnrich},
+		"ak_billing": {models.APIKeyScopeBilling},
+	}
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		key        string
+		wantStatus int
+	}{
+		{"read with read-only key", http.MethodGet, "/api/v1/sources", "ak_valid", http.StatusOK},
+		{"write without enrich scope", http.MethodPost, "/api/v1/sources", "ak_valid", http.StatusForbidden},
+		{"enrich key writes", http.MethodPost, "/api/v1/sources", "ak_enrich", http.StatusOK},
+		{"enrich key reads", http.MethodGet, "/api/v1/sources", "ak_enrich", http.StatusOK},
+		{"enrich key without billing scope", http.MethodGet, "/api/v1/subscription", "ak_enrich", http.StatusForbidden},
+		{"billing key does not read", http.MethodGet, "/api/v1/sources", "ak_billing", http.StatusForbidden},
+		{"key management", http.MethodGet, "/api/v1/api-keys", "ak_valid", http.StatusForbidden},
+		{"unknown key", http.MethodGet, "/api/v1/sources", "ak_unknown", http.StatusUnauthorized},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *WorkOSUser
+			next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got, _ = GetUserFromRequest(r)
+			})
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Header.Set("Authorization", "Bearer "+tt.key)
+			rec := httptest.NewRecorder()
+
+			Middleware(&JWTVerifier{}, apiKeys)(next).ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusOK && (got == nil || got.ID != "user-1" || got.Email != "user@example.com") {
+				t.Errorf("user in context = %+v, want the key's owner", got)
+			}
+		})
+	}
+}
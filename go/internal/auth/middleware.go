@@ -105,7 +105,10 @@ func (v *JWTVerifier) VerifyToken(tokenString string) (jwt.Token, error) {
 	return token, nil
 }
 
-func Middleware(verifier *JWTVerifier) func(http.Handler) http.Handler {
+// Middleware authenticates requests with a WorkOS JWT or, for scripts, an
+// API key sent the same way as a bearer token. API keys are checked against
+// the scope the request needs and resolve to the user who owns them.
+func Middleware(verifier *JWTVerifier, apiKeys APIKeyAuthenticator) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 			var workosUser *WorkOSUser
@@ -134,6 +137,16 @@ func Middleware(verifier *JWTVerifier) func(http.Handler) http.Handler {
 
 				accessToken := parts[1]
 
+				if strings.HasPrefix(accessToken, models.APIKeyPrefix) {
+					u, ok := authenticateAPIKey(w, r, apiKeys, accessToken)
+					if !ok {
+						return
+					}
+					ctx := context.WithValue(r.Context(), userContextKey, u)
+					next.ServeHTTP(w, r.WithContext(ctx))
+					return
+				}
+
 				token, err := verifier.VerifyToken(accessToken)
 				if err != nil {
 					log.Printf("Failed to verify token: %v", err)
@@ -159,6 +172,27 @@ func Middleware(verifier *JWTVerifier) func(http.Handler) http.Handler {
 	}
 }
 
+// authenticateAPIKey resolves an API key to its owner, writing the error
+// response when the key is invalid or lacks the scope the request needs.
+func authenticateAPIKey(w http.ResponseWriter, r *http.Request, apiKeys APIKeyAuthenticator, key string) (*WorkOSUser, bool) {
+	apiKey, u, err := apiKeys.AuthenticateAPIKey(r.Context(), key)
+	if err != nil {
+		log.Printf("Failed to authenticate API key: %v", err)
+		http.Error(w, "Unauthorized: Invalid, revoked or expired API key", http.StatusUnauthorized)
+		return nil, false
+	}
+	scope, allowed := requiredAPIKeyScope(r.Method, r.URL.Path)
+	if !allowed {
+		http.Error(w, "Forbidden: API keys cannot access this route", http.StatusForbidden)
+		return nil, false
+	}
+	if !apiKey.HasScope(scope) {
+		http.Error(w, fmt.Sprintf("Forbidden: API key is missing the %q scope", scope), http.StatusForbidden)
+		return nil, false
+	}
+	return workOSUserFromUser(u), true
+}
+
 func getStringClaim(claims map[string]interface{}, key string) string {
 	if val, ok := claims[key]; ok {
 		if str, ok := val.(string); ok {
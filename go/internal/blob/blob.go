@@ -0,0 +1,62 @@
+package blob
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"time"
+
+	"github.com/blagoySimandov/ampledata/go/internal/config"
+)
+
+// Backends selectable through BLOB_BACKEND.
+const (
+	BackendGCS   = "gcs"
+	BackendLocal = "local"
+	BackendS3    = "s3"
+)
+
+// Store is the object storage behind uploaded sources and generated files.
+type Store interface {
+	// SignedUploadURL returns a URL that accepts a single PUT of the object
+	// with the given content type until it expires.
+	SignedUploadURL(ctx context.Context, key, contentType string, expires time.Duration) (string, error)
+	// SignedDownloadURL returns a URL that serves the object as an
+	// attachment named filename until it expires.
+	SignedDownloadURL(ctx context.Context, key, filename string, expires time.Duration) (string, error)
+	NewReader(ctx context.Context, key string) (io.ReadCloser, error)
+	Write(ctx context.Context, key, contentType string, r io.Reader) error
+	Close() error
+}
+
+// contentDisposition makes browsers save a download under filename.
+func contentDisposition(filename string) string {
+	return mime.FormatMediaType("attachment", map[string]string{"filename": filename})
+}
+
+// New creates the store selected by the configuration.
+func New(ctx context.Context, cfg *config.Config) (Store, error) {
+	switch cfg.BlobBackend {
+	case BackendGCS, "":
+		return NewGCSStore(ctx, cfg.BlobBucket)
+	case BackendLocal:
+		// URLs signed by one replica are redeemed on any other, so the
+		// secret comes from the configuration rather than the process.
+		if cfg.BlobSigningSecret == "" {
+			return nil, fmt.Errorf("BLOB_SIGNING_SECRET is required for the local blob backend")
+		}
+		return NewLocalStore(cfg.BlobLocalDir, cfg.BlobLocalBaseURL, []byte(cfg.BlobSigningSecret), int64(cfg.BlobMaxUploadMB)<<20)
+	case BackendS3:
+		return NewS3Store(S3Options{
+			Endpoint:        cfg.S3Endpoint,
+			Region:          cfg.S3Region,
+			Bucket:          cfg.BlobBucket,
+			AccessKeyID:     cfg.S3AccessKeyID,
+			SecretAccessKey: cfg.S3SecretAccessKey,
+			PathStyle:       cfg.S3ForcePathStyle,
+		})
+	default:
+		return nil, fmt.Errorf("unknown blob backend %q", cfg.BlobBackend)
+	}
+}
@@ -0,0 +1,86 @@
+package blob
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"google.golang.org/api/option"
+)
+
+// GCSStore keeps objects in a Google Cloud Storage bucket.
+type GCSStore struct {
+	client     *storage.Client
+	bucketName string
+}
+
+func NewGCSStore(ctx context.Context, bucketName string) (*GCSStore, error) {
+	client, err := newGCSClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create GCS client: %w", err)
+	}
+	return &GCSStore{
+		client:     client,
+		bucketName: bucketName,
+	}, nil
+}
+
+func newGCSClient(ctx context.Context) (*storage.Client, error) {
+	if json := os.Getenv("GCP_SERVICE_ACCOUNT_JSON"); json != "" {
+		return storage.NewClient(ctx, option.WithCredentialsJSON([]byte(json)))
+	}
+	return storage.NewClient(ctx)
+}
+
+func (s *GCSStore) SignedUploadURL(ctx context.Context, key, contentType string, expires time.Duration) (string, error) {
+	url, err := s.client.Bucket(s.bucketName).SignedURL(key, &storage.SignedURLOptions{
+		Expires:     time.Now().Add(expires),
+		Method:      "PUT",
+		ContentType: contentType,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to generate signed URL: %w", err)
+	}
+	return url, nil
+}
+
+func (s *GCSStore) SignedDownloadURL(ctx context.Context, key, filename string, expires time.Duration) (string, error) {
+	signed, err := s.client.Bucket(s.bucketName).SignedURL(key, &storage.SignedURLOptions{
+		Expires:         time.Now().Add(expires),
+		Method:          "GET",
+		QueryParameters: url.Values{"response-content-disposition": {contentDisposition(filename)}},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to generate signed URL: %w", err)
+	}
+	return signed, nil
+}
+
+func (s *GCSStore) NewReader(ctx context.Context, key string) (io.ReadCloser, error) {
+	reader, err := s.client.Bucket(s.bucketName).Object(key).NewReader(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create object reader: %w", err)
+	}
+	return reader, nil
+}
+
+func (s *GCSStore) Write(ctx context.Context, key, contentType string, r io.Reader) error {
+	w := s.client.Bucket(s.bucketName).Object(key).NewWriter(ctx)
+	w.ContentType = contentType
+	if _, err := io.Copy(w, r); err != nil {
+		w.Close()
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	return nil
+}
+
+func (s *GCSStore) Close() error {
+	return s.client.Close()
+}
@@ -0,0 +1,216 @@
+package blob
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// LocalUploadPath is where the API serves the handler of a LocalStore, which
+// takes signed uploads and serves signed downloads.
+const LocalUploadPath = "/uploads/"
+
+// LocalStore keeps objects as files under a directory. Signed URLs point at
+// the API's own handler and carry an HMAC over the method, key, content type
+// or download file name, and expiry. The secret must be shared by every
+// replica and survive restarts, or URLs signed by one process fail on others.
+type LocalStore struct {
+	root          string
+	baseURL       string
+	secret        []byte
+	maxUploadSize int64
+	now           func() time.Time
+}
+
+// NewLocalStore creates a store under root. Uploads larger than
+// maxUploadSize bytes are rejected.
+func NewLocalStore(root, baseURL string, secret []byte, maxUploadSize int64) (*LocalStore, error) {
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("a signing secret is required")
+	}
+	if err := os.MkdirAll(root, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create blob directory: %w", err)
+	}
+	return &LocalStore{
+		root:          root,
+		baseURL:       strings.TrimRight(baseURL, "/"),
+		secret:        secret,
+		maxUploadSize: maxUploadSize,
+		now:           time.Now,
+	}, nil
+}
+
+func (s *LocalStore) SignedUploadURL(_ context.Context, key, contentType string, expires time.Duration) (string, error) {
+	if _, err := s.path(key); err != nil {
+		return "", err
+	}
+	expiry := strconv.FormatInt(s.now().Add(expires).Unix(), 10)
+	query := url.Values{
+		"expires":   {expiry},
+		"signature": {s.sign(http.MethodPut, key, contentType, expiry)},
+	}
+	return s.baseURL + LocalUploadPath + (&url.URL{Path: key}).EscapedPath() + "?" + query.Encode(), nil
+}
+
+func (s *LocalStore) SignedDownloadURL(_ context.Context, key, filename string, expires time.Duration) (string, error) {
+	if _, err := s.path(key); err != nil {
+		return "", err
+	}
+	expiry := strconv.FormatInt(s.now().Add(expires).Unix(), 10)
+	query := url.Values{
+		"expires":   {expiry},
+		"filename":  {filename},
+		"signature": {s.sign(http.MethodGet, key, filename, expiry)},
+	}
+	return s.baseURL + LocalUploadPath + (&url.URL{Path: key}).EscapedPath() + "?" + query.Encode(), nil
+}
+
+func (s *LocalStore) NewReader(_ context.Context, key string) (io.ReadCloser, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create object reader: %w", err)
+	}
+	return f, nil
+}
+
+// Write stores the object through a temporary file so readers never see a
+// partial object.
+func (s *LocalStore) Write(_ context.Context, key, _ string, r io.Reader) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := io.Copy(tmp, r); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to write object: %w", err)
+	}
+	return nil
+}
+
+func (s *LocalStore) Close() error { return nil }
+
+// Handler accepts PUT requests to signed upload URLs and GET requests to
+// signed download URLs. It expects to be mounted with LocalUploadPath
+// stripped from the request path.
+func (s *LocalStore) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimPrefix(r.URL.Path, "/")
+		switch r.Method {
+		case http.MethodPut:
+			contentType := r.Header.Get("Content-Type")
+			// Browsers may append parameters such as charset to the content type.
+			if mediaType, _, err := mime.ParseMediaType(contentType); err == nil {
+				contentType = mediaType
+			}
+			if err := s.verify(http.MethodPut, key, contentType, r.URL.Query()); err != nil {
+				http.Error(w, err.Error(), http.StatusForbidden)
+				return
+			}
+			body := http.MaxBytesReader(w, r.Body, s.maxUploadSize)
+			if err := s.Write(r.Context(), key, contentType, body); err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					http.Error(w, fmt.Sprintf("upload exceeds %d bytes", tooLarge.Limit), http.StatusRequestEntityTooLarge)
+					return
+				}
+				http.Error(w, "failed to store upload", http.StatusInternalServerError)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		case http.MethodGet:
+			filename := r.URL.Query().Get("filename")
+			if err := s.verify(http.MethodGet, key, filename, r.URL.Query()); err != nil {
+				http.Error(w, err.Error(), http.StatusForbidden)
+				return
+			}
+			s.serveDownload(w, r, key, filename)
+		default:
+			w.Header().Set("Allow", http.MethodGet+", "+http.MethodPut)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		}
+	})
+}
+
+func (s *LocalStore) serveDownload(w http.ResponseWriter, r *http.Request, key, filename string) {
+	path, err := s.path(key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		http.Error(w, "object not found", http.StatusNotFound)
+		return
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		http.Error(w, "failed to read object", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Disposition", contentDisposition(filename))
+	http.ServeContent(w, r, filename, info.ModTime(), f)
+}
+
+// verify checks a signed URL; detail is the upload content type or the
+// download file name.
+func (s *LocalStore) verify(method, key, detail string, query url.Values) error {
+	expiry := query.Get("expires")
+	unix, err := strconv.ParseInt(expiry, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid signed URL")
+	}
+	if s.now().Unix() > unix {
+		return fmt.Errorf("signed URL expired")
+	}
+	want := s.sign(method, key, detail, expiry)
+	if !hmac.Equal([]byte(want), []byte(query.Get("signature"))) {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
+
+func (s *LocalStore) sign(method, key, detail, expiry string) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(method + "\n" + key + "\n" + detail + "\n" + expiry))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// path resolves a key inside the root, rejecting keys that would escape it.
+func (s *LocalStore) path(key string) (string, error) {
+	cleaned := filepath.Clean("/" + key)
+	if key == "" || cleaned != "/"+key {
+		return "", fmt.Errorf("invalid object key %q", key)
+	}
+	return filepath.Join(s.root, filepath.FromSlash(cleaned)), nil
+}
@@ -0,0 +1,141 @@
+package blob
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLocalStore_UploadHandler(t *testing.T) {
+	const body = "name\nAcme\n"
+	store, err := NewLocalStore(t.TempDir(), "http://localhost:8080", []byte("secret"), int64(len(body)))
+	if err != nil {
+		t.Fatalf("NewLocalStore() error: %v", err)
+	}
+	handler := http.StripPrefix(strings.TrimSuffix(LocalUploadPath, "/"), store.Handler())
+
+	signed, err := store.SignedUploadURL(context.Background(), "abc.csv", "text/csv", time.Minute)
+	if err != nil {
+		t.Fatalf("SignedUploadURL() error: %v", err)
+	}
+	target, _ := url.Parse(signed)
+
+	tests := []struct {
+		name        string
+		path        string
+		query       string
+		contentType string
+		body        string
+		wantStatus  int
+	}{
+		{"valid upload", target.Path, target.RawQuery, "text/csv", body, http.StatusOK},
+		{"content type with charset", target.Path, target.RawQuery, "text/csv; charset=utf-8", body, http.StatusOK},
+		{"wrong content type", target.Path, target.RawQuery, "application/json", body, http.StatusForbidden},
+		{"different key", LocalUploadPath + "other.csv", target.RawQuery, "text/csv", body, http.StatusForbidden},
+		{"missing signature", target.Path, "", "text/csv", body, http.StatusForbidden},
+		{"too large", target.Path, target.RawQuery, "text/csv", body + "Globex\n", http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPut, tt.path+"?"+tt.query, strings.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d (%s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+		})
+	}
+
+	r, err := store.NewReader(context.Background(), "abc.csv")
+	if err != nil {
+		t.Fatalf("NewReader() error: %v", err)
+	}
+	defer r.Close()
+	data, _ := io.ReadAll(r)
+	if string(data) != body {
+		t.Errorf("stored content = %q", data)
+	}
+}
+
+func TestLocalStore_ExpiredURL(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir(), "http://localhost:8080", []byte("secret"), 1<<20)
+	if err != nil {
+		t.Fatalf("NewLocalStore() error: %v", err)
+	}
+	signed, _ := store.SignedUploadURL(context.Background(), "abc.csv", "text/csv", time.Minute)
+	target, _ := url.Parse(signed)
+	store.now = func() time.Time { return time.Now().Add(2 * time.Minute) }
+
+	if err := store.verify(http.MethodPut, "abc.csv", "text/csv", target.Query()); err == nil {
+		t.Error("verify() accepted an expired URL")
+	}
+}
+
+func TestLocalStore_DownloadHandler(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir(), "http://localhost:8080", []byte("secret"), 1<<20)
+	if err != nil {
+		t.Fatalf("NewLocalStore() error: %v", err)
+	}
+	if err := store.Write(context.Background(), "exports/abc.csv", "text/csv", strings.NewReader("name\nAcme\n")); err != nil {
+		t.Fatalf("Write() error: %v", err)
+	}
+	handler := http.StripPrefix(strings.TrimSuffix(LocalUploadPath, "/"), store.Handler())
+
+	signed, err := store.SignedDownloadURL(context.Background(), "exports/abc.csv", "leads.csv", time.Minute)
+	if err != nil {
+		t.Fatalf("SignedDownloadURL() error: %v", err)
+	}
+	target, _ := url.Parse(signed)
+	renamed := target.Query()
+	renamed.Set("filename", "other.csv")
+	upload, _ := store.SignedUploadURL(context.Background(), "exports/abc.csv", "text/csv", time.Minute)
+	uploadTarget, _ := url.Parse(upload)
+
+	tests := []struct {
+		name       string
+		path       string
+		query      string
+		wantStatus int
+	}{
+		{"valid download", target.Path, target.RawQuery, http.StatusOK},
+		{"different file name", target.Path, renamed.Encode(), http.StatusForbidden},
+		{"different key", LocalUploadPath + "exports/other.csv", target.RawQuery, http.StatusForbidden},
+		{"upload signature", uploadTarget.Path, uploadTarget.RawQuery, http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path+"?"+tt.query, nil))
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (%s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			if got := rec.Header().Get("Content-Disposition"); got != `attachment; filename=leads.csv` {
+				t.Errorf("Content-Disposition = %q", got)
+			}
+			if rec.Body.String() != "name\nAcme\n" {
+				t.Errorf("body = %q", rec.Body.String())
+			}
+		})
+	}
+}
+
+func TestLocalStore_RejectsEscapingKeys(t *testing.T) {
+	store, err := NewLocalStore(t.TempDir(), "http://localhost:8080", []byte("secret"), 1<<20)
+	if err != nil {
+		t.Fatalf("NewLocalStore() error: %v", err)
+	}
+	for _, key := range []string{"", "../etc/passwd", "a/../../b", "/abs.csv", "a//b"} {
+		if err := store.Write(context.Background(), key, "text/csv", strings.NewReader("x")); err == nil {
+			t.Errorf("Write(%q) succeeded, want error", key)
+		}
+	}
+}
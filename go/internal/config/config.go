@@ -26,8 +26,12 @@ type Config struct {
 	MaxEnrichmentRetries          int
 	MaxOrganicResults             int
 	ConcurrencyRowEnrichmentLimit int
+	// LookupTimeoutSeconds bounds how long a single-entity lookup may run.
+	LookupTimeoutSeconds int
 
 	CreditsPerCell int
+	// ClassifyCellsPerCredit is how many classify cells one credit buys.
+	ClassifyCellsPerCredit int
 
 	SerperCost              int
 	TknInCost               int
@@ -38,6 +42,23 @@ type Config struct {
 
 	StaticDir string
 
+	// Blob storage: BlobBackend is "gcs", "local" or "s3".
+	BlobBackend       string
+	BlobBucket        string
+	BlobLocalDir      string
+	BlobLocalBaseURL  string
+	BlobSigningSecret string
+	BlobMaxUploadMB   int
+	S3Endpoint        string
+	S3Region          string
+	S3AccessKeyID     string
+	S3SecretAccessKey string
+	S3ForcePathStyle  bool
+
+	// SourceConnections is a JSON object of named databases that query
+	// sources may read from, e.g. {"crm": {"dsn": "postgres://..."}}.
+	SourceConnections string
+
 	// Stripe Price IDs – populated by Terraform, set as environment variables.
 	// Run `terraform apply` in terraform/stripe and export the outputs.
 	StarterBasePriceID       string
@@ -80,8 +101,10 @@ var cfg Config = Config{
 	MaxEnrichmentRetries:          getEnvInt("MAX_ENRICHMENT_RETRIES", 2),
 	MaxOrganicResults:             getEnvInt("MAX_ORGANIC_RESULTS", 4),
 	ConcurrencyRowEnrichmentLimit: getEnvInt("CONCURRENCY_ROW_ENRICHMENT_LIMIT", 10),
+	LookupTimeoutSeconds:          getEnvInt("LOOKUP_TIMEOUT_SECONDS", 90),
 
-	CreditsPerCell: getEnvInt("CREDITS_PER_CELL", 1),
+	CreditsPerCell:         getEnvInt("CREDITS_PER_CELL", 1),
+	ClassifyCellsPerCredit: getEnvInt("CLASSIFY_CELLS_PER_CREDIT", 5),
 
 	// Token costs are stored in nano-dollars per token (billionths of a dollar).
 	// To convert: price_per_million_tokens * 1000 = nano_dollars_per_token
@@ -100,6 +123,20 @@ var cfg Config = Config{
 
 	StaticDir: getEnv("STATIC_DIR", "web/ampledata-fe/dist"),
 
+	BlobBackend:       getEnv("BLOB_BACKEND", "gcs"),
+	BlobBucket:        getEnv("BLOB_BUCKET", "ampledata-enrichment-uploads"),
+	BlobLocalDir:      getEnv("BLOB_LOCAL_DIR", "data/uploads"),
+	BlobLocalBaseURL:  getEnv("BLOB_LOCAL_BASE_URL", "http://localhost:8080"),
+	BlobSigningSecret: getEnv("BLOB_SIGNING_SECRET", ""),
+	BlobMaxUploadMB:   getEnvInt("BLOB_MAX_UPLOAD_MB", 100),
+	S3Endpoint:        getEnv("S3_ENDPOINT", ""),
+	S3Region:          getEnv("S3_REGION", "us-east-1"),
+	S3AccessKeyID:     getEnv("S3_ACCESS_KEY_ID", ""),
+	S3SecretAccessKey: getEnv("S3_SECRET_ACCESS_KEY", ""),
+	S3ForcePathStyle:  getEnvBool("S3_FORCE_PATH_STYLE", false),
+
+	SourceConnections: getEnv("SOURCE_CONNECTIONS", ""),
+
 	StarterBasePriceID:       getEnv("STRIPE_STARTER_BASE_PRICE_ID", ""),
 	StarterMeteredPriceID:    getEnv("STRIPE_STARTER_METERED_PRICE_ID", ""),
 	ProBasePriceID:           getEnv("STRIPE_PRO_BASE_PRICE_ID", ""),
@@ -8,6 +8,7 @@ import (
 	"github.com/blagoySimandov/ampledata/go/internal/models"
 	"github.com/blagoySimandov/ampledata/go/internal/state"
 	"github.com/blagoySimandov/ampledata/go/internal/temporal/workflows"
+	"github.com/google/uuid"
 	"go.temporal.io/sdk/client"
 )
 
@@ -30,7 +31,7 @@ func NewTemporalEnricher(temporalClient client.Client, stateManager *state.State
 }
 
 // Enrich starts a Temporal workflow to process the job
-func (e *TemporalEnricher) Enrich(ctx context.Context, jobID, userID, stripeCustomerID string, rowKeys []string, columnsMetadata []*models.ColumnMetadata, keyColumnDescription *string) error {
+func (e *TemporalEnricher) Enrich(ctx context.Context, jobID, userID, stripeCustomerID string, rowKeys []string, columnsMetadata []*models.ColumnMetadata, keyColumnDescription *string, locale string, contextColumns []string) error {
 	workflowOptions := client.StartWorkflowOptions{
 		ID:        fmt.Sprintf("job-%s", jobID),
 		TaskQueue: e.taskQueue,
@@ -41,8 +42,10 @@ func (e *TemporalEnricher) Enrich(ctx context.Context, jobID, userID, stripeCust
 		UserID:               userID,
 		StripeCustomerID:     stripeCustomerID,
 		RowKeys:              rowKeys,
+		ContextColumns:       contextColumns,
 		ColumnsMetadata:      columnsMetadata,
 		KeyColumnDescription: keyColumnDescription,
+		Locale:               locale,
 		MaxRetries:           e.maxRetries,
 	}
 
@@ -54,6 +57,88 @@ func (e *TemporalEnricher) Enrich(ctx context.Context, jobID, userID, stripeCust
 	return nil
 }
 
+// Rerun starts a Temporal workflow that enriches some cells of a finished
+// job again. It runs under the job's workflow ID, so cancelling the job
+// cancels the re-run, and it fails if the job's workflow is still running.
+func (e *TemporalEnricher) Rerun(ctx context.Context, rerun *models.RowRerun) error {
+	job := rerun.Job
+	workflowOptions := client.StartWorkflowOptions{
+		ID:                                       fmt.Sprintf("job-%s", job.JobID),
+		TaskQueue:                                e.taskQueue,
+		WorkflowExecutionErrorWhenAlreadyStarted: true,
+	}
+
+	keyColumnDescription := ""
+	if job.KeyColumnDescription != nil {
+		keyColumnDescription = *job.KeyColumnDescription
+	}
+	locale := ""
+	if job.Locale != nil {
+		locale = *job.Locale
+	}
+
+	input := workflows.RerunWorkflowInput{
+		JobID:                job.JobID,
+		UserID:               rerun.UserID,
+		StripeCustomerID:     rerun.StripeCustomerID,
+		RowColumns:           rerun.Columns,
+		ContextColumns:       job.ContextColumns,
+		ColumnsMetadata:      job.ColumnsMetadata,
+		KeyColumnDescription: keyColumnDescription,
+		Locale:               locale,
+		MaxRetries:           e.maxRetries,
+		Instructions:         rerun.Instructions,
+		Merge:                rerun.Merge,
+		PreviousStatus:       job.Status,
+	}
+
+	if _, err := e.temporalClient.ExecuteWorkflow(ctx, workflowOptions, workflows.RerunWorkflow, input); err != nil {
+		return fmt.Errorf("failed to start rerun workflow: %w", err)
+	}
+	return nil
+}
+
+// Lookup enriches a single entity and waits for the result. When ctx is done
+// first, the lookup's workflow is cancelled. The workflow does not report
+// usage; the caller does once it has the result.
+func (e *TemporalEnricher) Lookup(ctx context.Context, lookup *models.EntityLookup) (*models.EntityLookupResult, error) {
+	workflowOptions := client.StartWorkflowOptions{
+		ID:        fmt.Sprintf("lookup-%s", uuid.NewString()),
+		TaskQueue: e.taskQueue,
+	}
+	input := workflows.LookupWorkflowInput{
+		UserID:               lookup.UserID,
+		Key:                  lookup.Key,
+		KeyColumnDescription: lookup.KeyColumnDescription,
+		ColumnsMetadata:      lookup.ColumnsMetadata,
+		Locale:               lookup.Locale,
+		MaxRetries:           e.maxRetries,
+	}
+
+	run, err := e.temporalClient.ExecuteWorkflow(ctx, workflowOptions, workflows.LookupWorkflow, input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start lookup workflow: %w", err)
+	}
+	var output workflows.EnrichmentWorkflowOutput
+	if err := run.Get(ctx, &output); err != nil {
+		if ctx.Err() != nil {
+			if cancelErr := e.temporalClient.CancelWorkflow(context.Background(), run.GetID(), run.GetRunID()); cancelErr != nil {
+				err = errors.Join(err, fmt.Errorf("failed to cancel lookup workflow: %w", cancelErr))
+			}
+			return nil, errors.Join(ctx.Err(), err)
+		}
+		return nil, fmt.Errorf("lookup workflow failed: %w", err)
+	}
+	return &models.EntityLookupResult{
+		Key:           output.RowKey,
+		ExtractedData: output.ExtractedData,
+		Confidence:    output.Confidence,
+		Sources:       output.Sources,
+		Success:       output.Success,
+		Error:         output.Error,
+	}, nil
+}
+
 // GetProgress returns the current progress of a job
 func (e *TemporalEnricher) GetProgress(ctx context.Context, jobID string) (*models.JobProgress, error) {
 	return e.stateManager.Progress(ctx, jobID)
@@ -71,17 +156,61 @@ func (e *TemporalEnricher) Cancel(ctx context.Context, jobID string) error {
 	return errors.Join(errs...)
 }
 
+// StartExport starts a Temporal workflow that builds an export file.
+func (e *TemporalEnricher) StartExport(ctx context.Context, exportID uuid.UUID) error {
+	workflowOptions := client.StartWorkflowOptions{
+		ID:        fmt.Sprintf("export-%s", exportID),
+		TaskQueue: e.taskQueue,
+	}
+	input := workflows.ExportWorkflowInput{ExportID: exportID.String()}
+	if _, err := e.temporalClient.ExecuteWorkflow(ctx, workflowOptions, workflows.ExportWorkflow, input); err != nil {
+		return fmt.Errorf("failed to start export workflow: %w", err)
+	}
+	return nil
+}
+
+// GetResults pages through the results of a job's completed rows. With a key
+// mapping, offset and limit count the fanned-out results, so the rows before
+// the page are read to know where it starts.
 func (e *TemporalEnricher) GetResults(ctx context.Context, jobID string, offset, limit int) ([]*models.EnrichmentResult, error) {
-	completedRows, err := e.stateManager.Store().GetRowsAtStage(ctx, jobID, models.StageCompleted, offset, limit)
+	job, err := e.stateManager.Store().GetJob(ctx, jobID)
+	if err != nil {
+		return nil, err
+	}
+	if len(job.KeyMapping) == 0 {
+		return e.completedResults(ctx, jobID, offset, limit)
+	}
+
+	// Every row yields at least one result, so the page lies within the
+	// first offset+limit rows.
+	rowLimit := 0
+	if limit > 0 {
+		rowLimit = offset + limit
+	}
+	results, err := e.completedResults(ctx, jobID, 0, rowLimit)
 	if err != nil {
 		return nil, err
 	}
+	results = models.FanOutResults(results, job.KeyMapping)
+	if offset >= len(results) {
+		return []*models.EnrichmentResult{}, nil
+	}
+	results = results[offset:]
+	if limit > 0 && limit < len(results) {
+		results = results[:limit]
+	}
+	return results, nil
+}
 
+func (e *TemporalEnricher) completedResults(ctx context.Context, jobID string, offset, limit int) ([]*models.EnrichmentResult, error) {
+	completedRows, err := e.stateManager.Store().GetRowsAtStage(ctx, jobID, models.StageCompleted, offset, limit)
+	if err != nil {
+		return nil, err
+	}
 	results := make([]*models.EnrichmentResult, len(completedRows))
 	for i, row := range completedRows {
 		results[i] = models.ToEnrichmentResult(row)
 	}
-
 	return results, nil
 }
 
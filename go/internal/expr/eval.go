@@ -0,0 +1,337 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Eval evaluates the expression against row values keyed by column name.
+// Missing columns evaluate to null, and arithmetic on null yields null, so an
+// expression over a column that was not enriched produces no value rather
+// than an error.
+func (e *Expr) Eval(row map[string]interface{}) (interface{}, error) {
+	return e.root.eval(row)
+}
+
+type node interface {
+	eval(row map[string]interface{}) (interface{}, error)
+	walk(fn func(node))
+}
+
+type literalNode struct {
+	value interface{}
+}
+
+func (n *literalNode) eval(map[string]interface{}) (interface{}, error) { return n.value, nil }
+func (n *literalNode) walk(fn func(node))                               { fn(n) }
+
+type variableNode struct {
+	name string
+}
+
+func (n *variableNode) eval(row map[string]interface{}) (interface{}, error) {
+	return normalize(row[n.name]), nil
+}
+func (n *variableNode) walk(fn func(node)) { fn(n) }
+
+type unaryNode struct {
+	op      string
+	operand node
+}
+
+func (n *unaryNode) eval(row map[string]interface{}) (interface{}, error) {
+	v, err := n.operand.eval(row)
+	if err != nil {
+		return nil, err
+	}
+	if n.op == "!" {
+		return !truthy(v), nil
+	}
+	if v == nil {
+		return nil, nil
+	}
+	f, ok := toNumber(v)
+	if !ok {
+		return nil, fmt.Errorf("cannot negate %s", describe(v))
+	}
+	return -f, nil
+}
+
+func (n *unaryNode) walk(fn func(node)) {
+	fn(n)
+	n.operand.walk(fn)
+}
+
+type binaryNode struct {
+	op          string
+	left, right node
+}
+
+func (n *binaryNode) eval(row map[string]interface{}) (interface{}, error) {
+	left, err := n.left.eval(row)
+	if err != nil {
+		return nil, err
+	}
+
+	// Logical operators short-circuit.
+	switch n.op {
+	case "&&":
+		if !truthy(left) {
+			return false, nil
+		}
+		right, err := n.right.eval(row)
+		return truthy(right), err
+	case "||":
+		if truthy(left) {
+			return true, nil
+		}
+		right, err := n.right.eval(row)
+		return truthy(right), err
+	}
+
+	right, err := n.right.eval(row)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "==":
+		return equal(left, right), nil
+	case "!=":
+		return !equal(left, right), nil
+	case "<", "<=", ">", ">=":
+		return compare(n.op, left, right)
+	}
+
+	if left == nil || right == nil {
+		return nil, nil
+	}
+	if n.op == "+" {
+		_, ls := left.(string)
+		_, rs := right.(string)
+		if ls || rs {
+			return toString(left) + toString(right), nil
+		}
+	}
+
+	l, lok := toNumber(left)
+	r, rok := toNumber(right)
+	if !lok || !rok {
+		return nil, fmt.Errorf("cannot apply %s to %s and %s", n.op, describe(left), describe(right))
+	}
+	switch n.op {
+	case "+":
+		return l + r, nil
+	case "-":
+		return l - r, nil
+	case "*":
+		return l * r, nil
+	case "/":
+		if r == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		return l / r, nil
+	case "%":
+		if r == 0 {
+			return nil, fmt.Errorf("division by zero")
+		}
+		return math.Mod(l, r), nil
+	}
+	return nil, fmt.Errorf("unknown operator %s", n.op)
+}
+
+func (n *binaryNode) walk(fn func(node)) {
+	fn(n)
+	n.left.walk(fn)
+	n.right.walk(fn)
+}
+
+type conditionalNode struct {
+	cond, then, otherwise node
+}
+
+func (n *conditionalNode) eval(row map[string]interface{}) (interface{}, error) {
+	cond, err := n.cond.eval(row)
+	if err != nil {
+		return nil, err
+	}
+	if truthy(cond) {
+		return n.then.eval(row)
+	}
+	return n.otherwise.eval(row)
+}
+
+func (n *conditionalNode) walk(fn func(node)) {
+	fn(n)
+	n.cond.walk(fn)
+	n.then.walk(fn)
+	n.otherwise.walk(fn)
+}
+
+type callNode struct {
+	name string
+	fn   function
+	args []node
+}
+
+func (n *callNode) eval(row map[string]interface{}) (interface{}, error) {
+	if n.fn.lazy != nil {
+		return n.fn.lazy(row, n.args)
+	}
+	values := make([]interface{}, len(n.args))
+	for i, arg := range n.args {
+		v, err := arg.eval(row)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	v, err := n.fn.call(values)
+	if err != nil {
+		return nil, fmt.Errorf("%s(): %w", n.name, err)
+	}
+	return v, nil
+}
+
+func (n *callNode) walk(fn func(node)) {
+	fn(n)
+	for _, arg := range n.args {
+		arg.walk(fn)
+	}
+}
+
+// normalize maps row values onto the expression types: float64, string, bool
+// and nil.
+func normalize(v interface{}) interface{} {
+	switch t := v.(type) {
+	case nil, float64, string, bool:
+		return t
+	case int:
+		return float64(t)
+	case int64:
+		return float64(t)
+	case float32:
+		return float64(t)
+	default:
+		return fmt.Sprintf("%v", t)
+	}
+}
+
+func truthy(v interface{}) bool {
+	switch t := v.(type) {
+	case nil:
+		return false
+	case bool:
+		return t
+	case float64:
+		return t != 0
+	case string:
+		return t != ""
+	default:
+		return true
+	}
+}
+
+func toNumber(v interface{}) (float64, bool) {
+	switch t := v.(type) {
+	case float64:
+		return t, true
+	case bool:
+		if t {
+			return 1, true
+		}
+		return 0, true
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(t), 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func toString(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	default:
+		return fmt.Sprintf("%v", t)
+	}
+}
+
+func describe(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	default:
+		return fmt.Sprintf("%T", v)
+	}
+}
+
+func equal(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if af, ok := a.(float64); ok {
+		if bf, ok := toNumber(b); ok {
+			return af == bf
+		}
+	}
+	if bf, ok := b.(float64); ok {
+		if af, ok := toNumber(a); ok {
+			return af == bf
+		}
+	}
+	return toString(a) == toString(b)
+}
+
+func compare(op string, a, b interface{}) (interface{}, error) {
+	if a == nil || b == nil {
+		return false, nil
+	}
+	var cmp int
+	af, aok := toNumber(a)
+	bf, bok := toNumber(b)
+	_, as := a.(string)
+	_, bs := b.(string)
+	switch {
+	case aok && bok && !(as && bs):
+		cmp = compareFloats(af, bf)
+	case as && bs:
+		cmp = strings.Compare(a.(string), b.(string))
+	default:
+		return nil, fmt.Errorf("cannot compare %s and %s", describe(a), describe(b))
+	}
+	switch op {
+	case "<":
+		return cmp < 0, nil
+	case "<=":
+		return cmp <= 0, nil
+	case ">":
+		return cmp > 0, nil
+	default:
+		return cmp >= 0, nil
+	}
+}
+
+func compareFloats(a, b float64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,132 @@
+package expr
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestEval(t *testing.T) {
+	now = func() time.Time { return time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC) }
+	defer func() { now = time.Now }()
+
+	row := map[string]interface{}{
+		"revenue":        5000000.0,
+		"employee_count": 50,
+		"founded_year":   2019.0,
+		"founded":        "2015-03-01",
+		"name":           "  Acme Corp ",
+		"industry":       "Software",
+		"Annual Revenue": 100.0,
+		"empty":          "",
+	}
+
+	tests := []struct {
+		name string
+		src  string
+		want interface{}
+	}{
+		{"division", "revenue / employee_count", 100000.0},
+		{"precedence", "1 + 2 * 3", 7.0},
+		{"parentheses", "(1 + 2) * 3", 9.0},
+		{"unary minus", "-founded_year + 2020", 1.0},
+		{"modulo", "10 % 4", 2.0},
+		{"company age", "year(today()) - founded_year", 5.0},
+		{"year of date", "year(founded)", 2015.0},
+		{"bracketed name", "[Annual Revenue] * 2", 200.0},
+		{"string concat", "industry + '/' + 'B2B'", "Software/B2B"},
+		{"string functions", "upper(trim(name))", "ACME CORP"},
+		{"substr is 1-based", "substr('abcdef', 2, 3)", "bcd"},
+		{"left right", "left('abcdef', 2) + right('abcdef', 2)", "abef"},
+		{"replace", "replace(industry, 'Soft', 'Hard')", "Hardware"},
+		{"len", "len(industry)", 8.0},
+		{"contains", "contains(lower(industry), 'soft')", true},
+		{"if function", "if(employee_count > 10, 'large', 'small')", "large"},
+		{"ternary", "employee_count < 10 ? 'small' : 'large'", "large"},
+		{"and or", "employee_count > 10 and (industry == 'Software' or false)", true},
+		{"not", "not isnull(revenue)", true},
+		{"comparison with string number", "'10' == 10", true},
+		{"missing column is null", "missing * 2", nil},
+		{"coalesce skips null and empty", "coalesce(missing, empty, 'fallback')", "fallback"},
+		{"if does not evaluate other branch", "if(true, 1, 1 / 0)", 1.0},
+		{"round digits", "round(2 / 3, 2)", 0.67},
+		{"min max", "max(1, missing, 3) - min(5, 2)", 1.0},
+		{"number conversion", "number('42') + 1", 43.0},
+		{"string conversion", "string(1.5)", "1.5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := Parse(tt.src)
+			if err != nil {
+				t.Fatalf("Parse(%q) error: %v", tt.src, err)
+			}
+			got, err := e.Eval(row)
+			if err != nil {
+				t.Fatalf("Eval(%q) error: %v", tt.src, err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Eval(%q) = %#v, want %#v", tt.src, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{"empty", ""},
+		{"unknown function", "exec('rm -rf /')"},
+		{"wrong arity", "if(true, 1)"},
+		{"unbalanced parens", "(1 + 2"},
+		{"trailing operator", "1 +"},
+		{"unterminated string", "'abc"},
+		{"unterminated bracket", "[Annual Revenue"},
+		{"unexpected character", "a $ b"},
+		{"missing ternary branch", "true ? 1"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.src); err == nil {
+				t.Errorf("Parse(%q) succeeded, want error", tt.src)
+			}
+		})
+	}
+}
+
+func TestEval_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+	}{
+		{"division by zero", "1 / 0"},
+		{"arithmetic on text", "'abc' * 2"},
+		{"bad date", "year('not a date')"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e, err := Parse(tt.src)
+			if err != nil {
+				t.Fatalf("Parse(%q) error: %v", tt.src, err)
+			}
+			if got, err := e.Eval(nil); err == nil {
+				t.Errorf("Eval(%q) = %v, want error", tt.src, got)
+			}
+		})
+	}
+}
+
+func TestIdentifiers(t *testing.T) {
+	e, err := Parse("if(revenue > 0, revenue / [Employee Count], null) + len(name)")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"revenue", "Employee Count", "name"}
+	if got := e.Identifiers(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Identifiers() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,292 @@
+package expr
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+type function struct {
+	minArgs int
+	// maxArgs is -1 for variadic functions.
+	maxArgs int
+	call    func(args []interface{}) (interface{}, error)
+	// lazy functions receive unevaluated arguments, for conditionals.
+	lazy func(row map[string]interface{}, args []node) (interface{}, error)
+}
+
+func (f function) arity() string {
+	switch {
+	case f.maxArgs < 0:
+		return fmt.Sprintf("at least %d arguments", f.minArgs)
+	case f.minArgs == f.maxArgs:
+		return fmt.Sprintf("%d arguments", f.minArgs)
+	default:
+		return fmt.Sprintf("%d to %d arguments", f.minArgs, f.maxArgs)
+	}
+}
+
+// now is swapped in tests.
+var now = time.Now
+
+var functions map[string]function
+
+func init() {
+	functions = map[string]function{
+		// Conditionals
+		"if":       {minArgs: 3, maxArgs: 3, lazy: ifFunc},
+		"coalesce": {minArgs: 1, maxArgs: -1, lazy: coalesceFunc},
+		"isnull":   {minArgs: 1, maxArgs: 1, call: func(a []interface{}) (interface{}, error) { return a[0] == nil, nil }},
+
+		// Arithmetic
+		"abs":   numeric1(math.Abs),
+		"floor": numeric1(math.Floor),
+		"ceil":  numeric1(math.Ceil),
+		"sqrt":  numeric1(math.Sqrt),
+		"round": {minArgs: 1, maxArgs: 2, call: roundFunc},
+		"pow":   {minArgs: 2, maxArgs: 2, call: powFunc},
+		"min":   {minArgs: 1, maxArgs: -1, call: extremum(func(a, b float64) bool { return a < b })},
+		"max":   {minArgs: 1, maxArgs: -1, call: extremum(func(a, b float64) bool { return a > b })},
+
+		// Strings
+		"lower":      string1(strings.ToLower),
+		"upper":      string1(strings.ToUpper),
+		"trim":       string1(strings.TrimSpace),
+		"len":        {minArgs: 1, maxArgs: 1, call: lenFunc},
+		"concat":     {minArgs: 1, maxArgs: -1, call: concatFunc},
+		"substr":     {minArgs: 2, maxArgs: 3, call: substrFunc},
+		"left":       {minArgs: 2, maxArgs: 2, call: leftFunc},
+		"right":      {minArgs: 2, maxArgs: 2, call: rightFunc},
+		"replace":    {minArgs: 3, maxArgs: 3, call: replaceFunc},
+		"contains":   stringPredicate(strings.Contains),
+		"startswith": stringPredicate(strings.HasPrefix),
+		"endswith":   stringPredicate(strings.HasSuffix),
+
+		// Conversions
+		"number": {minArgs: 1, maxArgs: 1, call: numberFunc},
+		"string": {minArgs: 1, maxArgs: 1, call: func(a []interface{}) (interface{}, error) {
+			if a[0] == nil {
+				return nil, nil
+			}
+			return toString(a[0]), nil
+		}},
+
+		// Dates
+		"today": {minArgs: 0, maxArgs: 0, call: func([]interface{}) (interface{}, error) { return now().Format("2006-01-02"), nil }},
+		"year":  datePart(func(t time.Time) int { return t.Year() }),
+		"month": datePart(func(t time.Time) int { return int(t.Month()) }),
+		"day":   datePart(func(t time.Time) int { return t.Day() }),
+	}
+}
+
+func ifFunc(row map[string]interface{}, args []node) (interface{}, error) {
+	cond, err := args[0].eval(row)
+	if err != nil {
+		return nil, err
+	}
+	if truthy(cond) {
+		return args[1].eval(row)
+	}
+	return args[2].eval(row)
+}
+
+func coalesceFunc(row map[string]interface{}, args []node) (interface{}, error) {
+	for _, arg := range args {
+		v, err := arg.eval(row)
+		if err != nil {
+			return nil, err
+		}
+		if v != nil && v != "" {
+			return v, nil
+		}
+	}
+	return nil, nil
+}
+
+func numeric1(f func(float64) float64) function {
+	return function{minArgs: 1, maxArgs: 1, call: func(a []interface{}) (interface{}, error) {
+		if a[0] == nil {
+			return nil, nil
+		}
+		n, ok := toNumber(a[0])
+		if !ok {
+			return nil, fmt.Errorf("expected number, got %s", describe(a[0]))
+		}
+		return f(n), nil
+	}}
+}
+
+func string1(f func(string) string) function {
+	return function{minArgs: 1, maxArgs: 1, call: func(a []interface{}) (interface{}, error) {
+		if a[0] == nil {
+			return nil, nil
+		}
+		return f(toString(a[0])), nil
+	}}
+}
+
+func stringPredicate(f func(s, sub string) bool) function {
+	return function{minArgs: 2, maxArgs: 2, call: func(a []interface{}) (interface{}, error) {
+		if a[0] == nil || a[1] == nil {
+			return false, nil
+		}
+		return f(toString(a[0]), toString(a[1])), nil
+	}}
+}
+
+func numberArgs(args []interface{}) ([]float64, bool, error) {
+	nums := make([]float64, len(args))
+	for i, a := range args {
+		if a == nil {
+			return nil, false, nil
+		}
+		n, ok := toNumber(a)
+		if !ok {
+			return nil, false, fmt.Errorf("argument %d: expected number, got %s", i+1, describe(a))
+		}
+		nums[i] = n
+	}
+	return nums, true, nil
+}
+
+func roundFunc(a []interface{}) (interface{}, error) {
+	nums, ok, err := numberArgs(a)
+	if !ok {
+		return nil, err
+	}
+	digits := 0.0
+	if len(nums) == 2 {
+		digits = nums[1]
+	}
+	scale := math.Pow(10, digits)
+	return math.Round(nums[0]*scale) / scale, nil
+}
+
+func powFunc(a []interface{}) (interface{}, error) {
+	nums, ok, err := numberArgs(a)
+	if !ok {
+		return nil, err
+	}
+	return math.Pow(nums[0], nums[1]), nil
+}
+
+func extremum(better func(a, b float64) bool) func([]interface{}) (interface{}, error) {
+	return func(a []interface{}) (interface{}, error) {
+		var best interface{}
+		for i, v := range a {
+			if v == nil {
+				continue
+			}
+			n, ok := toNumber(v)
+			if !ok {
+				return nil, fmt.Errorf("argument %d: expected number, got %s", i+1, describe(v))
+			}
+			if best == nil || better(n, best.(float64)) {
+				best = n
+			}
+		}
+		return best, nil
+	}
+}
+
+func lenFunc(a []interface{}) (interface{}, error) {
+	if a[0] == nil {
+		return nil, nil
+	}
+	return float64(len([]rune(toString(a[0])))), nil
+}
+
+func concatFunc(a []interface{}) (interface{}, error) {
+	var sb strings.Builder
+	for _, v := range a {
+		sb.WriteString(toString(v))
+	}
+	return sb.String(), nil
+}
+
+// substrFunc uses 1-based positions like spreadsheet MID().
+func substrFunc(a []interface{}) (interface{}, error) {
+	if a[0] == nil {
+		return nil, nil
+	}
+	nums, ok, err := numberArgs(a[1:])
+	if !ok {
+		return nil, err
+	}
+	runes := []rune(toString(a[0]))
+	start := clamp(int(nums[0])-1, 0, len(runes))
+	end := len(runes)
+	if len(nums) == 2 {
+		end = clamp(start+int(nums[1]), start, len(runes))
+	}
+	return string(runes[start:end]), nil
+}
+
+func leftFunc(a []interface{}) (interface{}, error) {
+	if a[0] == nil {
+		return nil, nil
+	}
+	nums, ok, err := numberArgs(a[1:])
+	if !ok {
+		return nil, err
+	}
+	runes := []rune(toString(a[0]))
+	return string(runes[:clamp(int(nums[0]), 0, len(runes))]), nil
+}
+
+func rightFunc(a []interface{}) (interface{}, error) {
+	if a[0] == nil {
+		return nil, nil
+	}
+	nums, ok, err := numberArgs(a[1:])
+	if !ok {
+		return nil, err
+	}
+	runes := []rune(toString(a[0]))
+	return string(runes[len(runes)-clamp(int(nums[0]), 0, len(runes)):]), nil
+}
+
+func replaceFunc(a []interface{}) (interface{}, error) {
+	if a[0] == nil {
+		return nil, nil
+	}
+	return strings.ReplaceAll(toString(a[0]), toString(a[1]), toString(a[2])), nil
+}
+
+func numberFunc(a []interface{}) (interface{}, error) {
+	if a[0] == nil {
+		return nil, nil
+	}
+	n, ok := toNumber(a[0])
+	if !ok {
+		return nil, fmt.Errorf("cannot convert %q to number", toString(a[0]))
+	}
+	return n, nil
+}
+
+var dateLayouts = []string{"2006-01-02", "2006-01", "2006", time.RFC3339}
+
+// datePart extracts a component from an ISO date string. A bare number is
+// treated as a year so year() works on founded_year style columns.
+func datePart(part func(time.Time) int) function {
+	return function{minArgs: 1, maxArgs: 1, call: func(a []interface{}) (interface{}, error) {
+		switch v := a[0].(type) {
+		case nil:
+			return nil, nil
+		case float64:
+			return float64(part(time.Date(int(v), time.January, 1, 0, 0, 0, 0, time.UTC))), nil
+		}
+		s := strings.TrimSpace(toString(a[0]))
+		for _, layout := range dateLayouts {
+			if t, err := time.Parse(layout, s); err == nil {
+				return float64(part(t)), nil
+			}
+		}
+		return nil, fmt.Errorf("cannot parse date %q", s)
+	}}
+}
+
+func clamp(v, lo, hi int) int {
+	return max(lo, min(v, hi))
+}
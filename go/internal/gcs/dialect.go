@@ -0,0 +1,222 @@
+package gcs
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/unicode"
+)
+
+// Text encodings CSV files can be read in.
+const (
+	EncodingUTF8        = "utf-8"
+	EncodingUTF16LE     = "utf-16le"
+	EncodingUTF16BE     = "utf-16be"
+	EncodingWindows1252 = "windows-1252"
+	EncodingISO88591    = "iso-8859-1"
+)
+
+// Encodings lists every supported CSV encoding.
+var Encodings = []string{EncodingUTF8, EncodingUTF16LE, EncodingUTF16BE, EncodingWindows1252, EncodingISO88591}
+
+// Delimiters lists the field separators dialect detection chooses from.
+var Delimiters = []rune{',', ';', '\t', '|'}
+
+// dialectSampleSize is how much of a file dialect detection looks at.
+const dialectSampleSize = 64 << 10
+
+// dialectSampleRecords caps how many records are parsed per candidate
+// delimiter.
+const dialectSampleRecords = 200
+
+// Dialect describes how a CSV file is laid out. HeaderRow is the 1-based
+// record holding the column names; records above it, such as report titles,
+// are skipped.
+type Dialect struct {
+	Delimiter rune
+	Encoding  string
+	BOM       bool
+	HeaderRow int
+}
+
+// DetectDialect inspects the start of a CSV file. Complete reports whether
+// the sample is the whole file; otherwise its last, possibly cut, line is
+// ignored.
+func DetectDialect(sample []byte, complete bool) Dialect {
+	d := Dialect{Delimiter: ',', Encoding: EncodingUTF8, HeaderRow: 1}
+
+	switch {
+	case bytes.HasPrefix(sample, utf8BOM):
+		d.BOM = true
+		sample = sample[len(utf8BOM):]
+	case bytes.HasPrefix(sample, []byte{0xFF, 0xFE}):
+		d.BOM, d.Encoding = true, EncodingUTF16LE
+	case bytes.HasPrefix(sample, []byte{0xFE, 0xFF}):
+		d.BOM, d.Encoding = true, EncodingUTF16BE
+	}
+
+	if d.Encoding == EncodingUTF8 {
+		if !complete {
+			sample = trimPartialRune(sample)
+		}
+		if !utf8.Valid(sample) {
+			d.Encoding = EncodingWindows1252
+		}
+	}
+	text, err := decodeSample(sample, d.Encoding)
+	if err != nil {
+		return d
+	}
+	if !complete {
+		if i := bytes.LastIndexByte(text, '\n'); i >= 0 {
+			text = text[:i+1]
+		}
+	}
+
+	bestConsistency, bestFields := 0.0, 1
+	for _, delim := range Delimiters {
+		counts := fieldCounts(text, delim)
+		fields, consistency := modalCount(counts)
+		if fields < 2 {
+			continue
+		}
+		if consistency > bestConsistency || (consistency == bestConsistency && fields > bestFields) {
+			bestConsistency, bestFields = consistency, fields
+			d.Delimiter = delim
+			d.HeaderRow = headerRecord(counts, fields)
+		}
+	}
+	return d
+}
+
+// DetectDialect reads the start of an uploaded CSV file and detects its
+// dialect.
+func (r *CSVReader) DetectDialect(ctx context.Context, objectName string) (Dialect, error) {
+	rc, err := r.openObject(ctx, objectName)
+	if err != nil {
+		return Dialect{}, err
+	}
+	defer rc.Close()
+	sample, complete, err := readSample(bufio.NewReaderSize(rc, dialectSampleSize))
+	if err != nil {
+		return Dialect{}, fmt.Errorf("failed to read CSV sample: %w", err)
+	}
+	return DetectDialect(sample, complete), nil
+}
+
+// readSample peeks at the start of a file without consuming it.
+func readSample(br *bufio.Reader) ([]byte, bool, error) {
+	sample, err := br.Peek(dialectSampleSize)
+	if err == io.EOF || errors.Is(err, bufio.ErrBufferFull) {
+		return sample, err == io.EOF, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return sample, false, nil
+}
+
+// decodeReader converts a CSV stream to UTF-8 and drops its byte order mark.
+func decodeReader(r io.Reader, encoding string) (io.Reader, error) {
+	switch encoding {
+	case "", EncodingUTF8:
+		br := bufio.NewReader(r)
+		if prefix, err := br.Peek(len(utf8BOM)); err == nil && bytes.Equal(prefix, utf8BOM) {
+			br.Discard(len(utf8BOM))
+		}
+		return br, nil
+	case EncodingUTF16LE:
+		return unicode.UTF16(unicode.LittleEndian, unicode.UseBOM).NewDecoder().Reader(r), nil
+	case EncodingUTF16BE:
+		return unicode.UTF16(unicode.BigEndian, unicode.UseBOM).NewDecoder().Reader(r), nil
+	case EncodingWindows1252:
+		return charmap.Windows1252.NewDecoder().Reader(r), nil
+	case EncodingISO88591:
+		return charmap.ISO8859_1.NewDecoder().Reader(r), nil
+	}
+	return nil, fmt.Errorf("unsupported encoding %q", encoding)
+}
+
+func decodeSample(sample []byte, encoding string) ([]byte, error) {
+	r, err := decodeReader(bytes.NewReader(sample), encoding)
+	if err != nil {
+		return nil, err
+	}
+	return io.ReadAll(r)
+}
+
+// trimPartialRune drops a multi-byte character cut off at the end of a
+// sample, so that it does not make valid UTF-8 look invalid.
+func trimPartialRune(b []byte) []byte {
+	for i := 1; i < utf8.UTFMax && i <= len(b); i++ {
+		if utf8.RuneStart(b[len(b)-i]) {
+			if !utf8.FullRune(b[len(b)-i:]) {
+				return b[:len(b)-i]
+			}
+			break
+		}
+	}
+	return b
+}
+
+// fieldCounts parses a sample with the given delimiter and returns the field
+// count of each record.
+func fieldCounts(text []byte, delim rune) []int {
+	reader := csv.NewReader(bytes.NewReader(text))
+	reader.Comma = delim
+	reader.LazyQuotes = true
+	reader.FieldsPerRecord = -1
+
+	var counts []int
+	for len(counts) < dialectSampleRecords {
+		record, err := reader.Read()
+		if err != nil {
+			break
+		}
+		counts = append(counts, len(record))
+	}
+	return counts
+}
+
+// modalCount returns the most common field count, preferring wider records
+// on ties, and the share of records that have it.
+func modalCount(counts []int) (int, float64) {
+	if len(counts) == 0 {
+		return 0, 0
+	}
+	freq := make(map[int]int)
+	for _, c := range counts {
+		freq[c]++
+	}
+	mode := 0
+	for c, n := range freq {
+		if n > freq[mode] || (n == freq[mode] && c > mode) {
+			mode = c
+		}
+	}
+	return mode, float64(freq[mode]) / float64(len(counts))
+}
+
+// headerRecord returns the 1-based position of the first record with the
+// table's field count.
+func headerRecord(counts []int, fields int) int {
+	for i, c := range counts {
+		if c == fields {
+			return i + 1
+		}
+	}
+	return 1
+}
+
+// IsSupportedEncoding reports whether CSV files can be read in encoding.
+func IsSupportedEncoding(encoding string) bool {
+	return slices.Contains(Encodings, encoding)
+}
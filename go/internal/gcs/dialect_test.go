@@ -0,0 +1,138 @@
+package gcs
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDetectDialect(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		complete bool
+		want     Dialect
+	}{
+		{
+			name:     "comma",
+			input:    "name,city\nAcme,Berlin\nGlobex,Paris\n",
+			complete: true,
+			want:     Dialect{Delimiter: ',', Encoding: EncodingUTF8, HeaderRow: 1},
+		},
+		{
+			name:     "semicolon with decimal commas",
+			input:    "name;revenue;city\nAcme;1,5;Berlin\nGlobex;2,75;Paris\n",
+			complete: true,
+			want:     Dialect{Delimiter: ';', Encoding: EncodingUTF8, HeaderRow: 1},
+		},
+		{
+			name:     "tab",
+			input:    "name\tcity\nAcme, Inc.\tBerlin\n",
+			complete: true,
+			want:     Dialect{Delimiter: '\t', Encoding: EncodingUTF8, HeaderRow: 1},
+		},
+		{
+			name:     "utf-8 byte order mark",
+			input:    "\xEF\xBB\xBFname,city\nAcme,Berlin\n",
+			complete: true,
+			want:     Dialect{Delimiter: ',', Encoding: EncodingUTF8, BOM: true, HeaderRow: 1},
+		},
+		{
+			name:     "windows-1252",
+			input:    "name;city\nM\xfcller GmbH;K\xf6ln\n",
+			complete: true,
+			want:     Dialect{Delimiter: ';', Encoding: EncodingWindows1252, HeaderRow: 1},
+		},
+		{
+			name:     "utf-16le",
+			input:    "\xFF\xFEn\x00a\x00m\x00e\x00;\x00i\x00d\x00\n\x00A\x00;\x001\x00\n\x00",
+			complete: true,
+			want:     Dialect{Delimiter: ';', Encoding: EncodingUTF16LE, BOM: true, HeaderRow: 1},
+		},
+		{
+			name:     "title rows above the header",
+			input:    "Quarterly export\nGenerated 2024-01-01\nname,city,country\nAcme,Berlin,DE\nGlobex,Paris,FR\n",
+			complete: true,
+			want:     Dialect{Delimiter: ',', Encoding: EncodingUTF8, HeaderRow: 3},
+		},
+		{
+			name:     "truncated sample ignores the cut line and rune",
+			input:    "name;city\nM\xc3\xbcller;K\xc3\xb6ln\nAcme;Ber\xc3",
+			complete: false,
+			want:     Dialect{Delimiter: ';', Encoding: EncodingUTF8, HeaderRow: 1},
+		},
+		{
+			name:     "single column",
+			input:    "name\nAcme\n",
+			complete: true,
+			want:     Dialect{Delimiter: ',', Encoding: EncodingUTF8, HeaderRow: 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DetectDialect([]byte(tt.input), tt.complete); got != tt.want {
+				t.Errorf("DetectDialect() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCSV_Dialect(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		file        SourceFile
+		wantHeaders []string
+		wantRows    [][]string
+	}{
+		{
+			name:        "detected semicolons and windows-1252",
+			input:       "\"name\";\"city\"\r\nM\xfcller;K\xf6ln\r\n",
+			wantHeaders: []string{"name", "city"},
+			wantRows:    [][]string{{"Müller", "Köln"}},
+		},
+		{
+			name:        "detected title row and byte order mark",
+			input:       "\xEF\xBB\xBFExport\nname,city\nAcme,Berlin\n",
+			wantHeaders: []string{"name", "city"},
+			wantRows:    [][]string{{"Acme", "Berlin"}},
+		},
+		{
+			name:        "stored dialect is applied",
+			input:       "Export\nname|city\nAcme|Berlin\n",
+			file:        SourceFile{Delimiter: '|', Encoding: EncodingUTF8, HeaderRow: 2},
+			wantHeaders: []string{"name", "city"},
+			wantRows:    [][]string{{"Acme", "Berlin"}},
+		},
+		{
+			name:        "override wins over content",
+			input:       "a;b,c\n1;2,3\n",
+			file:        SourceFile{Delimiter: ',', Encoding: EncodingUTF8, HeaderRow: 1},
+			wantHeaders: []string{"a;b", "c"},
+			wantRows:    [][]string{{"1;2", "3"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := readSource(newCSVIterator, tt.input, tt.file)
+			if err != nil {
+				t.Fatalf("newCSVIterator() error: %v", err)
+			}
+			if !reflect.DeepEqual(result.Headers, tt.wantHeaders) {
+				t.Errorf("headers = %q, want %q", result.Headers, tt.wantHeaders)
+			}
+			if !reflect.DeepEqual(result.Rows, tt.wantRows) {
+				t.Errorf("rows = %q, want %q", result.Rows, tt.wantRows)
+			}
+		})
+	}
+}
+
+func TestParseCSV_UnsupportedEncoding(t *testing.T) {
+	_, err := readSource(newCSVIterator, "a,b\n", SourceFile{Delimiter: ',', Encoding: "ebcdic"})
+	if err == nil || !strings.Contains(err.Error(), "unsupported encoding") {
+		t.Errorf("error = %v, want unsupported encoding", err)
+	}
+}
@@ -0,0 +1,236 @@
+package gcs
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// newJSONIterator streams either a JSON array of objects or a stream of
+// objects (NDJSON). Nested objects are flattened into dotted headers such as
+// "address.city" and arrays are kept as JSON text. Headers are ordered by
+// first appearance across all records, which takes a first pass over the
+// file that keeps only the header names.
+func newJSONIterator(open objectOpener, _ SourceFile) (RowIterator, error) {
+	headers := &headerSet{index: make(map[string]int)}
+	scan, err := openJSONRecords(open, headers)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		_, err := scan.next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			scan.Close()
+			return nil, err
+		}
+	}
+	scan.Close()
+	if len(headers.names) == 0 {
+		return nil, fmt.Errorf("failed to read JSON: no fields found")
+	}
+
+	records, err := openJSONRecords(open, &headerSet{index: make(map[string]int)})
+	if err != nil {
+		return nil, err
+	}
+	return &jsonIterator{records: records, headers: headers.names}, nil
+}
+
+type jsonIterator struct {
+	records *jsonRecords
+	headers []string
+	offset  int
+}
+
+func (it *jsonIterator) Headers() []string { return it.headers }
+
+func (it *jsonIterator) Next() (*SourceRow, error) {
+	record, err := it.records.next()
+	if err != nil {
+		return nil, err
+	}
+	cells := make([]string, len(it.headers))
+	for i, name := range it.headers {
+		cells[i] = record[name]
+	}
+	row := &SourceRow{Offset: it.offset, Cells: cells}
+	it.offset++
+	return row, nil
+}
+
+func (it *jsonIterator) Close() error { return it.records.Close() }
+
+// jsonRecords decodes one flattened record at a time from an array or an
+// object stream.
+type jsonRecords struct {
+	rc      io.ReadCloser
+	dec     *json.Decoder
+	array   bool
+	done    bool
+	count   int
+	headers *headerSet
+}
+
+func openJSONRecords(open objectOpener, headers *headerSet) (*jsonRecords, error) {
+	rc, err := open()
+	if err != nil {
+		return nil, err
+	}
+	br := bufio.NewReader(rc)
+	if prefix, err := br.Peek(len(utf8BOM)); err == nil && bytes.Equal(prefix, utf8BOM) {
+		br.Discard(len(utf8BOM))
+	}
+	first, err := peekNonSpace(br)
+	if err == io.EOF {
+		rc.Close()
+		return nil, fmt.Errorf("failed to read JSON: file is empty")
+	}
+	if err != nil {
+		rc.Close()
+		return nil, fmt.Errorf("failed to read JSON: %w", err)
+	}
+
+	dec := json.NewDecoder(br)
+	dec.UseNumber()
+	records := &jsonRecords{rc: rc, dec: dec, array: first == '[', headers: headers}
+	if records.array {
+		if _, err := dec.Token(); err != nil {
+			rc.Close()
+			return nil, fmt.Errorf("failed to read JSON: %w", err)
+		}
+	}
+	return records, nil
+}
+
+func (j *jsonRecords) next() (map[string]string, error) {
+	if j.done {
+		return nil, io.EOF
+	}
+	if j.array && !j.dec.More() {
+		j.done = true
+		if _, err := j.dec.Token(); err != nil {
+			return nil, fmt.Errorf("failed to read JSON: %w", err)
+		}
+		return nil, io.EOF
+	}
+
+	var raw json.RawMessage
+	if err := j.dec.Decode(&raw); err != nil {
+		if err == io.EOF && !j.array {
+			j.done = true
+			return nil, io.EOF
+		}
+		return nil, fmt.Errorf("failed to read JSON record: %w", err)
+	}
+	record := make(map[string]string)
+	if err := flattenObject("", raw, record, j.headers); err != nil {
+		return nil, fmt.Errorf("failed to read JSON record: record %d: %w", j.count+1, err)
+	}
+	j.count++
+	return record, nil
+}
+
+func (j *jsonRecords) Close() error { return j.rc.Close() }
+
+type headerSet struct {
+	names []string
+	index map[string]int
+}
+
+func (h *headerSet) add(name string) {
+	if _, ok := h.index[name]; !ok {
+		h.index[name] = len(h.names)
+		h.names = append(h.names, name)
+	}
+}
+
+// flattenObject walks a JSON object in key order, so headers follow the file
+// rather than Go's map ordering.
+func flattenObject(prefix string, raw json.RawMessage, record map[string]string, headers *headerSet) error {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("not an object")
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, _ := tok.(string)
+		name := key
+		if prefix != "" {
+			name = prefix + "." + key
+		}
+
+		var value json.RawMessage
+		if err := dec.Decode(&value); err != nil {
+			return err
+		}
+		if isNonEmptyObject(value) {
+			if err := flattenObject(name, value, record, headers); err != nil {
+				return err
+			}
+			continue
+		}
+		headers.add(name)
+		record[name] = jsonCellValue(value)
+	}
+	return nil
+}
+
+func isNonEmptyObject(raw json.RawMessage) bool {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		return false
+	}
+	return len(bytes.TrimSpace(trimmed[1:len(trimmed)-1])) > 0
+}
+
+// jsonCellValue renders a scalar as its plain text and anything else (arrays,
+// empty objects) as compact JSON. Numbers keep their original formatting.
+func jsonCellValue(raw json.RawMessage) string {
+	trimmed := bytes.TrimSpace(raw)
+	switch {
+	case bytes.Equal(trimmed, []byte("null")):
+		return ""
+	case len(trimmed) > 0 && trimmed[0] == '"':
+		var s string
+		if err := json.Unmarshal(trimmed, &s); err == nil {
+			return s
+		}
+	case len(trimmed) > 0 && (trimmed[0] == '[' || trimmed[0] == '{'):
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, trimmed); err == nil {
+			return buf.String()
+		}
+	}
+	return string(trimmed)
+}
+
+func peekNonSpace(br *bufio.Reader) (byte, error) {
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		switch b {
+		case ' ', '\t', '\r', '\n':
+			continue
+		}
+		return b, br.UnreadByte()
+	}
+}
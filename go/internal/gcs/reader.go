@@ -2,55 +2,36 @@ package gcs
 
 import (
 	"context"
-	"encoding/csv"
 	"fmt"
 	"io"
-	"os"
 	"time"
 
-	"cloud.google.com/go/storage"
-	"google.golang.org/api/option"
+	"github.com/blagoySimandov/ampledata/go/internal/blob"
 )
 
-type CSVReader struct {
-	client     *storage.Client
-	bucketName string
-}
+// signedURLExpiry is how long an upload URL stays valid.
+const signedURLExpiry = 90 * time.Second
 
-func NewCSVReader(bucketName string) (*CSVReader, error) {
-	ctx := context.Background()
-	client, err := newGCSClient(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create GCS client: %w", err)
-	}
-	return &CSVReader{
-		client:     client,
-		bucketName: bucketName,
-	}, nil
+// CSVReader reads uploaded source files from blob storage.
+type CSVReader struct {
+	store blob.Store
 }
 
-func newGCSClient(ctx context.Context) (*storage.Client, error) {
-	if json := os.Getenv("GCP_SERVICE_ACCOUNT_JSON"); json != "" {
-		return storage.NewClient(ctx, option.WithCredentialsJSON([]byte(json)))
-	}
-	return storage.NewClient(ctx)
+func NewCSVReader(store blob.Store) *CSVReader {
+	return &CSVReader{store: store}
 }
 
-func (r *CSVReader) Close() error {
-	return r.client.Close()
+func (r *CSVReader) GenerateSignedURL(ctx context.Context, objectName, contentType string) (string, error) {
+	return r.store.SignedUploadURL(ctx, objectName, contentType, signedURLExpiry)
 }
 
-func (r *CSVReader) GenerateSignedURL(ctx context.Context, objectName, contentType string) (string, error) {
-	bucket := r.client.Bucket(r.bucketName)
-	url, err := bucket.SignedURL(objectName, &storage.SignedURLOptions{
-		Expires:     time.Now().Add(90 * time.Second),
-		Method:      "PUT",
-		ContentType: contentType,
-	})
+// CheckObject reports an error unless the object can be read.
+func (r *CSVReader) CheckObject(ctx context.Context, objectName string) error {
+	rc, err := r.openObject(ctx, objectName)
 	if err != nil {
-		return "", fmt.Errorf("failed to generate signed URL: %w", err)
+		return err
 	}
-	return url, nil
+	return rc.Close()
 }
 
 type CSVResult struct {
@@ -58,42 +39,6 @@ type CSVResult struct {
 	Rows    [][]string
 }
 
-func (r *CSVReader) ReadCSV(ctx context.Context, objectName string) (*CSVResult, error) {
-	bucket := r.client.Bucket(r.bucketName)
-	obj := bucket.Object(objectName)
-
-	reader, err := obj.NewReader(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create object reader: %w", err)
-	}
-	defer reader.Close()
-
-	csvReader := csv.NewReader(reader)
-	csvReader.LazyQuotes = true
-
-	headers, err := csvReader.Read()
-	if err != nil {
-		return nil, fmt.Errorf("failed to read CSV headers: %w", err)
-	}
-
-	var rows [][]string
-	for {
-		row, err := csvReader.Read()
-		if err == io.EOF {
-			break
-		}
-		if err != nil {
-			return nil, fmt.Errorf("failed to read CSV row: %w", err)
-		}
-		rows = append(rows, row)
-	}
-
-	return &CSVResult{
-		Headers: headers,
-		Rows:    rows,
-	}, nil
-}
-
 func (r *CSVReader) ExtractColumn(result *CSVResult, columnName string) ([]string, error) {
 	columnIndex := -1
 	for i, header := range result.Headers {
@@ -123,35 +68,7 @@ func (r *CSVReader) ExtractCompositeKey(result *CSVResult, columnNames []string)
 	if len(columnNames) == 0 {
 		return nil, fmt.Errorf("at least one column name is required")
 	}
-
-	columnIndices := make([]int, len(columnNames))
-	for i, columnName := range columnNames {
-		columnIndex := -1
-		for j, header := range result.Headers {
-			if header == columnName {
-				columnIndex = j
-				break
-			}
-		}
-		if columnIndex == -1 {
-			return nil, fmt.Errorf("column '%s' not found in CSV headers: %v", columnName, result.Headers)
-		}
-		columnIndices[i] = columnIndex
-	}
-
-	values := make([]string, 0, len(result.Rows))
-	for _, row := range result.Rows {
-		keyParts := make([]string, len(columnIndices))
-		for i, columnIndex := range columnIndices {
-			if columnIndex < len(row) {
-				keyParts[i] = row[columnIndex]
-			}
-		}
-		compositeKey := joinKeyParts(keyParts)
-		values = append(values, compositeKey)
-	}
-
-	return values, nil
+	return ScanCompositeKeys(newResultIterator(result), columnNames, nil)
 }
 
 func joinKeyParts(parts []string) string {
@@ -165,51 +82,74 @@ func joinKeyParts(parts []string) string {
 	return result
 }
 
-func (r *CSVReader) ReadCompositeKeyFromFile(ctx context.Context, objectName string, columnNames []string) ([]string, error) {
-	result, err := r.ReadCSV(ctx, objectName)
+// CompositeKey joins the cells at indices into a row key, treating missing
+// cells as empty.
+func CompositeKey(row []string, indices []int) string {
+	keyParts := make([]string, len(indices))
+	for i, idx := range indices {
+		if idx < len(row) {
+			keyParts[i] = row[idx]
+		}
+	}
+	return joinKeyParts(keyParts)
+}
+
+func (r *CSVReader) ReadCompositeKeyFromFile(ctx context.Context, file SourceFile, columnNames []string) ([]string, error) {
+	if len(columnNames) == 0 {
+		return nil, fmt.Errorf("at least one column name is required")
+	}
+	it, err := r.OpenSource(ctx, file)
 	if err != nil {
 		return nil, err
 	}
-	return r.ExtractCompositeKey(result, columnNames)
+	defer it.Close()
+	return ScanCompositeKeys(it, columnNames, nil)
 }
 
-func (r *CSVReader) ReadCompositeKeyFromFileFiltered(ctx context.Context, objectName string, keyColumns []string, filterColumns []string) ([]string, error) {
-	result, err := r.ReadCSV(ctx, objectName)
+func (r *CSVReader) ReadCompositeKeyFromFileFiltered(ctx context.Context, file SourceFile, keyColumns []string, filterColumns []string) ([]string, error) {
+	it, err := r.OpenSource(ctx, file)
 	if err != nil {
 		return nil, err
 	}
-	return ExtractCompositeKeyFiltered(result, keyColumns, filterColumns)
+	defer it.Close()
+	return ScanCompositeKeys(it, keyColumns, filterColumns)
 }
 
 func ExtractCompositeKeyFiltered(result *CSVResult, keyColumns []string, filterColumns []string) ([]string, error) {
-	keyIndices, err := findColumnIndices(result.Headers, keyColumns)
+	return ScanCompositeKeys(newResultIterator(result), keyColumns, filterColumns)
+}
+
+// ScanCompositeKeys streams the composite key of every row. With filter
+// columns, only rows missing a value in at least one of them are kept.
+func ScanCompositeKeys(it RowIterator, keyColumns []string, filterColumns []string) ([]string, error) {
+	keyIndices, err := FindColumnIndices(it.Headers(), keyColumns)
 	if err != nil {
 		return nil, err
 	}
 
-	filterIndices, err := findColumnIndices(result.Headers, filterColumns)
+	filterIndices, err := FindColumnIndices(it.Headers(), filterColumns)
 	if err != nil {
 		return nil, err
 	}
 
-	values := make([]string, 0, len(result.Rows))
-	for _, row := range result.Rows {
-		if !hasAnyEmptyColumn(row, filterIndices) {
-			continue
+	var values []string
+	for {
+		row, err := it.Next()
+		if err == io.EOF {
+			return values, nil
 		}
-		keyParts := make([]string, len(keyIndices))
-		for i, idx := range keyIndices {
-			if idx < len(row) {
-				keyParts[i] = row[idx]
-			}
+		if err != nil {
+			return nil, err
+		}
+		if filterColumns != nil && !hasAnyEmptyColumn(row.Cells, filterIndices) {
+			continue
 		}
-		values = append(values, joinKeyParts(keyParts))
+		values = append(values, CompositeKey(row.Cells, keyIndices))
 	}
-
-	return values, nil
 }
 
-func findColumnIndices(headers []string, columnNames []string) ([]int, error) {
+// FindColumnIndices returns the position of each named column in headers.
+func FindColumnIndices(headers []string, columnNames []string) ([]int, error) {
 	indices := make([]int, len(columnNames))
 	for i, name := range columnNames {
 		idx := -1
@@ -235,3 +175,69 @@ func hasAnyEmptyColumn(row []string, indices []int) bool {
 	}
 	return false
 }
+
+// ReadRowValues returns the values of valueColumns for each composite key,
+// keeping the first row when a key appears more than once.
+func (r *CSVReader) ReadRowValues(ctx context.Context, file SourceFile, keyColumns []string, valueColumns []string) (map[string]map[string]string, error) {
+	it, err := r.OpenSource(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	return ScanRowValues(it, keyColumns, valueColumns)
+}
+
+func ExtractRowValues(result *CSVResult, keyColumns []string, valueColumns []string) (map[string]map[string]string, error) {
+	return ScanRowValues(newResultIterator(result), keyColumns, valueColumns)
+}
+
+// ScanRowValues streams the values of valueColumns for each composite key.
+func ScanRowValues(it RowIterator, keyColumns []string, valueColumns []string) (map[string]map[string]string, error) {
+	return scanRowValues(it, keyColumns, valueColumns, nil)
+}
+
+// ScanRowValuesFor is ScanRowValues restricted to the given composite keys;
+// the values of other rows are never kept.
+func ScanRowValuesFor(it RowIterator, keyColumns []string, valueColumns []string, keys []string) (map[string]map[string]string, error) {
+	wanted := make(map[string]bool, len(keys))
+	for _, key := range keys {
+		wanted[key] = true
+	}
+	return scanRowValues(it, keyColumns, valueColumns, wanted)
+}
+
+// scanRowValues keeps the rows whose key is in wanted, or every row when
+// wanted is nil.
+func scanRowValues(it RowIterator, keyColumns []string, valueColumns []string, wanted map[string]bool) (map[string]map[string]string, error) {
+	keyIndices, err := FindColumnIndices(it.Headers(), keyColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	valueIndices, err := FindColumnIndices(it.Headers(), valueColumns)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]map[string]string)
+	for {
+		row, err := it.Next()
+		if err == io.EOF {
+			return values, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		key := CompositeKey(row.Cells, keyIndices)
+		if _, ok := values[key]; ok || wanted != nil && !wanted[key] {
+			continue
+		}
+		rowValues := make(map[string]string, len(valueIndices))
+		for i, idx := range valueIndices {
+			if idx < len(row.Cells) {
+				rowValues[valueColumns[i]] = row.Cells[idx]
+			}
+		}
+		values[key] = rowValues
+	}
+}
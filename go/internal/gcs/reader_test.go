@@ -109,3 +109,40 @@ func TestExtractCompositeKeyFiltered_InvalidColumn(t *testing.T) {
 		t.Error("expected error for invalid key column")
 	}
 }
+
+func TestExtractRowValues(t *testing.T) {
+	result := &CSVResult{
+		Headers: []string{"first", "last", "description", "city"},
+		Rows: [][]string{
+			{"Jane", "Doe", "Builds CRM software", "Berlin"},
+			{"John", "Roe", "Bakery chain"},
+			{"Jane", "Doe", "Duplicate row", "Paris"},
+		},
+	}
+
+	values, err := ExtractRowValues(result, []string{"first", "last"}, []string{"description", "city"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	jane := values["Jane"+CompositeKeyDelimiter+"Doe"]
+	if jane["description"] != "Builds CRM software" || jane["city"] != "Berlin" {
+		t.Errorf("first occurrence should win, got %v", jane)
+	}
+	john := values["John"+CompositeKeyDelimiter+"Roe"]
+	if _, ok := john["city"]; ok || john["description"] != "Bakery chain" {
+		t.Errorf("short row should omit missing cells, got %v", john)
+	}
+
+	if _, err := ExtractRowValues(result, []string{"first"}, []string{"nonexistent"}); err == nil {
+		t.Error("expected error for invalid value column")
+	}
+
+	selected, err := ScanRowValuesFor(newResultIterator(result), []string{"first", "last"}, []string{"city"}, []string{"Jane" + CompositeKeyDelimiter + "Doe"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(selected) != 1 || selected["Jane"+CompositeKeyDelimiter+"Doe"]["city"] != "Berlin" {
+		t.Errorf("ScanRowValuesFor() = %v, want only Jane Doe", selected)
+	}
+}
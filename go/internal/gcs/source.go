@@ -0,0 +1,262 @@
+package gcs
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+)
+
+// Content types accepted for uploaded sources.
+const (
+	ContentTypeCSV    = "text/csv"
+	ContentTypeJSON   = "application/json"
+	ContentTypeNDJSON = "application/x-ndjson"
+	ContentTypeXLSX   = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+)
+
+// SourceFile identifies an uploaded file and how it should be parsed into
+// headers and rows. HeaderRow is 1-based and defaults to the first row.
+// Sheet only applies to workbooks, Delimiter and Encoding only to CSV files;
+// a CSV file without a delimiter has its dialect detected on every read.
+type SourceFile struct {
+	ObjectName  string
+	ContentType string
+	Sheet       string
+	HeaderRow   int
+	Delimiter   rune
+	Encoding    string
+}
+
+// SourceRow is one data row of a source file. Offset is the row's 0-based
+// position among data rows, excluding the header.
+type SourceRow struct {
+	Offset int
+	Cells  []string
+}
+
+// RowIterator streams the data rows of a source file so that large files
+// never have to be held in memory.
+type RowIterator interface {
+	Headers() []string
+	// Next returns the next row, or io.EOF once the file is exhausted.
+	Next() (*SourceRow, error)
+	Close() error
+}
+
+// SourcePage is a window of rows read from a source file.
+type SourcePage struct {
+	Headers []string
+	Rows    [][]string
+	Offset  int
+	HasMore bool
+}
+
+// objectOpener opens the source object for reading. Parsers that need more
+// than one pass, such as JSON header discovery, call it again.
+type objectOpener func() (io.ReadCloser, error)
+
+type sourceParser func(open objectOpener, file SourceFile) (RowIterator, error)
+
+var sourceParsers = map[string]sourceParser{
+	ContentTypeCSV:    newCSVIterator,
+	ContentTypeJSON:   newJSONIterator,
+	ContentTypeNDJSON: newJSONIterator,
+	ContentTypeXLSX:   newXLSXIterator,
+}
+
+func parserFor(contentType string) (sourceParser, error) {
+	if contentType == "" {
+		return newCSVIterator, nil
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid content type %q: %w", contentType, err)
+	}
+	parse, ok := sourceParsers[strings.ToLower(mediaType)]
+	if !ok {
+		return nil, fmt.Errorf("unsupported content type %q", contentType)
+	}
+	return parse, nil
+}
+
+// IsCSV reports whether a content type is read as CSV.
+func IsCSV(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && strings.EqualFold(mediaType, ContentTypeCSV)
+}
+
+// OpenSource starts streaming an uploaded file according to its content
+// type. Files without a content type are read as CSV. The caller must close
+// the iterator.
+func (r *CSVReader) OpenSource(ctx context.Context, file SourceFile) (RowIterator, error) {
+	parse, err := parserFor(file.ContentType)
+	if err != nil {
+		return nil, err
+	}
+	return parse(func() (io.ReadCloser, error) {
+		return r.openObject(ctx, file.ObjectName)
+	}, file)
+}
+
+// ReadHeaders returns the column headers of an uploaded file.
+func (r *CSVReader) ReadHeaders(ctx context.Context, file SourceFile) ([]string, error) {
+	it, err := r.OpenSource(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	return it.Headers(), nil
+}
+
+// ReadPage reads up to limit rows starting at the given row offset. Rows
+// before the offset are streamed past without being kept.
+func (r *CSVReader) ReadPage(ctx context.Context, file SourceFile, offset, limit int) (*SourcePage, error) {
+	it, err := r.OpenSource(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	return PageRows(it, offset, limit)
+}
+
+// PageRows reads up to limit rows from an iterator starting at the given row
+// offset.
+func PageRows(it RowIterator, offset, limit int) (*SourcePage, error) {
+	page := &SourcePage{Headers: it.Headers(), Rows: [][]string{}, Offset: offset}
+	for {
+		row, err := it.Next()
+		if err == io.EOF {
+			return page, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if row.Offset < offset {
+			continue
+		}
+		if len(page.Rows) == limit {
+			page.HasMore = true
+			return page, nil
+		}
+		page.Rows = append(page.Rows, row.Cells)
+	}
+}
+
+// ListSheets returns the sheet names of an uploaded workbook.
+func (r *CSVReader) ListSheets(ctx context.Context, file SourceFile) ([]string, error) {
+	reader, err := r.openObject(ctx, file.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	return ListXLSXSheets(reader)
+}
+
+func (r *CSVReader) openObject(ctx context.Context, objectName string) (io.ReadCloser, error) {
+	return r.store.NewReader(ctx, objectName)
+}
+
+type csvIterator struct {
+	rc      io.ReadCloser
+	reader  *csv.Reader
+	headers []string
+	offset  int
+}
+
+func newCSVIterator(open objectOpener, file SourceFile) (RowIterator, error) {
+	rc, err := open()
+	if err != nil {
+		return nil, err
+	}
+	it, err := readCSVHeaders(rc, file)
+	if err != nil {
+		rc.Close()
+		return nil, err
+	}
+	return it, nil
+}
+
+func readCSVHeaders(rc io.ReadCloser, file SourceFile) (*csvIterator, error) {
+	var src io.Reader = rc
+	if file.Delimiter == 0 {
+		br := bufio.NewReaderSize(rc, dialectSampleSize)
+		sample, complete, err := readSample(br)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CSV sample: %w", err)
+		}
+		detected := DetectDialect(sample, complete)
+		file.Delimiter, file.Encoding = detected.Delimiter, detected.Encoding
+		if file.HeaderRow == 0 {
+			file.HeaderRow = detected.HeaderRow
+		}
+		src = br
+	}
+	decoded, err := decodeReader(src, file.Encoding)
+	if err != nil {
+		return nil, err
+	}
+	reader := csv.NewReader(decoded)
+	reader.Comma = file.Delimiter
+	reader.LazyQuotes = true
+	// Rows above the header may be narrower than the table.
+	reader.FieldsPerRecord = -1
+
+	for i := 1; i < file.HeaderRow; i++ {
+		if _, err := reader.Read(); err != nil {
+			return nil, fmt.Errorf("failed to skip to CSV header row %d: %w", file.HeaderRow, err)
+		}
+	}
+	headers, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV headers: %w", err)
+	}
+	return &csvIterator{rc: rc, reader: reader, headers: headers}, nil
+}
+
+func (it *csvIterator) Headers() []string { return it.headers }
+
+func (it *csvIterator) Next() (*SourceRow, error) {
+	cells, err := it.reader.Read()
+	if err == io.EOF {
+		return nil, io.EOF
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV row: %w", err)
+	}
+	row := &SourceRow{Offset: it.offset, Cells: cells}
+	it.offset++
+	return row, nil
+}
+
+func (it *csvIterator) Close() error { return it.rc.Close() }
+
+// resultIterator walks rows that are already in memory.
+type resultIterator struct {
+	result *CSVResult
+	offset int
+}
+
+func newResultIterator(result *CSVResult) RowIterator {
+	return &resultIterator{result: result}
+}
+
+func (it *resultIterator) Headers() []string { return it.result.Headers }
+
+func (it *resultIterator) Next() (*SourceRow, error) {
+	if it.offset >= len(it.result.Rows) {
+		return nil, io.EOF
+	}
+	row := &SourceRow{Offset: it.offset, Cells: it.result.Rows[it.offset]}
+	it.offset++
+	return row, nil
+}
+
+func (it *resultIterator) Close() error { return nil }
@@ -0,0 +1,179 @@
+package gcs
+
+import (
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// readSource runs a parser over in-memory content and collects every row.
+func readSource(parse sourceParser, content string, file SourceFile) (*CSVResult, error) {
+	it, err := parse(func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(content)), nil
+	}, file)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+
+	result := &CSVResult{Headers: it.Headers()}
+	for {
+		row, err := it.Next()
+		if err == io.EOF {
+			return result, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		result.Rows = append(result.Rows, row.Cells)
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		wantHeaders []string
+		wantRows    [][]string
+	}{
+		{
+			name:        "array of objects",
+			input:       `[{"name": "Acme", "employees": 120}, {"name": "Globex", "public": true}]`,
+			wantHeaders: []string{"name", "employees", "public"},
+			wantRows:    [][]string{{"Acme", "120", ""}, {"Globex", "", "true"}},
+		},
+		{
+			name:        "ndjson",
+			input:       "{\"name\": \"Acme\"}\n{\"name\": \"Globex\"}\n",
+			wantHeaders: []string{"name"},
+			wantRows:    [][]string{{"Acme"}, {"Globex"}},
+		},
+		{
+			name:        "nested objects are flattened",
+			input:       `[{"name": "Acme", "address": {"city": "Berlin", "geo": {"lat": 52.52}}}]`,
+			wantHeaders: []string{"name", "address.city", "address.geo.lat"},
+			wantRows:    [][]string{{"Acme", "Berlin", "52.52"}},
+		},
+		{
+			name:        "arrays and empty objects stay JSON",
+			input:       `[{"tags": ["b2b", "saas"], "meta": {}, "note": null}]`,
+			wantHeaders: []string{"tags", "meta", "note"},
+			wantRows:    [][]string{{`["b2b","saas"]`, "{}", ""}},
+		},
+		{
+			name:        "number formatting is preserved",
+			input:       `{"revenue": 1.50e6, "id": 7.0}`,
+			wantHeaders: []string{"revenue", "id"},
+			wantRows:    [][]string{{"1.50e6", "7.0"}},
+		},
+		{
+			name:        "byte order mark",
+			input:       "\xEF\xBB\xBF[{\"name\": \"Acme\"}]",
+			wantHeaders: []string{"name"},
+			wantRows:    [][]string{{"Acme"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := readSource(newJSONIterator, tt.input, SourceFile{})
+			if err != nil {
+				t.Fatalf("readSource() error: %v", err)
+			}
+			if !reflect.DeepEqual(result.Headers, tt.wantHeaders) {
+				t.Errorf("headers = %v, want %v", result.Headers, tt.wantHeaders)
+			}
+			if !reflect.DeepEqual(result.Rows, tt.wantRows) {
+				t.Errorf("rows = %v, want %v", result.Rows, tt.wantRows)
+			}
+		})
+	}
+}
+
+func TestParseJSON_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"empty", "  \n"},
+		{"array of scalars", `[1, 2, 3]`},
+		{"truncated", `[{"name": "Acme"}`},
+		{"no fields", `[{}, {}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := readSource(newJSONIterator, tt.input, SourceFile{}); err == nil {
+				t.Errorf("readSource(%q) succeeded, want error", tt.input)
+			}
+		})
+	}
+}
+
+func TestParserFor(t *testing.T) {
+	tests := []struct {
+		contentType string
+		wantErr     bool
+	}{
+		{"", false},
+		{"text/csv", false},
+		{"text/csv; charset=utf-8", false},
+		{"application/json", false},
+		{"application/x-ndjson", false},
+		{"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet", false},
+		{"application/pdf", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.contentType, func(t *testing.T) {
+			_, err := parserFor(tt.contentType)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parserFor(%q) error = %v, wantErr %v", tt.contentType, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReadPage(t *testing.T) {
+	content := "name,city\nAcme,Berlin\nGlobex,Paris\nInitech,Austin\n"
+
+	tests := []struct {
+		name        string
+		offset      int
+		limit       int
+		wantRows    [][]string
+		wantHasMore bool
+	}{
+		{"first page", 0, 2, [][]string{{"Acme", "Berlin"}, {"Globex", "Paris"}}, true},
+		{"last page", 2, 2, [][]string{{"Initech", "Austin"}}, false},
+		{"exact fit", 1, 2, [][]string{{"Globex", "Paris"}, {"Initech", "Austin"}}, false},
+		{"past the end", 5, 2, [][]string{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it, err := newCSVIterator(func() (io.ReadCloser, error) {
+				return io.NopCloser(strings.NewReader(content)), nil
+			}, SourceFile{})
+			if err != nil {
+				t.Fatalf("newCSVIterator() error: %v", err)
+			}
+			defer it.Close()
+
+			page, err := PageRows(it, tt.offset, tt.limit)
+			if err != nil {
+				t.Fatalf("PageRows() error: %v", err)
+			}
+			if !reflect.DeepEqual(page.Headers, []string{"name", "city"}) {
+				t.Errorf("headers = %v", page.Headers)
+			}
+			if !reflect.DeepEqual(page.Rows, tt.wantRows) {
+				t.Errorf("rows = %v, want %v", page.Rows, tt.wantRows)
+			}
+			if page.HasMore != tt.wantHasMore {
+				t.Errorf("hasMore = %v, want %v", page.HasMore, tt.wantHasMore)
+			}
+		})
+	}
+}
@@ -0,0 +1,608 @@
+package gcs
+
+import (
+	"archive/zip"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxXLSXSize bounds how much of a workbook is spooled to disk. The zip
+// format needs random access, so workbooks are copied to a temporary file
+// rather than read straight from the object.
+const maxXLSXSize = 100 << 20
+
+// newXLSXIterator streams one sheet of a workbook. The sheet defaults to the
+// first one and the header row to row 1; rows above the header are skipped.
+// Typed cells keep their meaning: numbers are written without float noise,
+// dates as ISO 8601 and booleans as true/false. The workbook is spooled to
+// a temporary file and the sheet XML is decoded from it one row at a time,
+// so only the shared strings and styles are held in memory.
+func newXLSXIterator(open objectOpener, file SourceFile) (RowIterator, error) {
+	rc, err := open()
+	if err != nil {
+		return nil, err
+	}
+	wb, err := openWorkbook(rc)
+	rc.Close()
+	if err != nil {
+		return nil, err
+	}
+	sheet, err := wb.sheet(file.Sheet)
+	if err != nil {
+		wb.Close()
+		return nil, err
+	}
+	rows, err := wb.openRows(sheet)
+	if err != nil {
+		wb.Close()
+		return nil, err
+	}
+
+	headerRow := file.HeaderRow
+	if headerRow <= 0 {
+		headerRow = 1
+	}
+	var headers []string
+	for {
+		row, err := rows.next()
+		if err == io.EOF || err == nil && row.number > headerRow {
+			break
+		}
+		if err != nil {
+			rows.Close()
+			wb.Close()
+			return nil, fmt.Errorf("failed to read sheet %q: %w", sheet.Name, err)
+		}
+		if row.number == headerRow {
+			headers = row.cells
+			break
+		}
+	}
+	if isEmptyRow(headers) {
+		rows.Close()
+		wb.Close()
+		return nil, fmt.Errorf("header row %d of sheet %q is empty", headerRow, sheet.Name)
+	}
+	for i, h := range headers {
+		if strings.TrimSpace(h) == "" {
+			headers[i] = "Column " + ColumnName(i)
+		}
+	}
+	return &xlsxIterator{wb: wb, rows: rows, sheet: sheet.Name, headers: headers}, nil
+}
+
+type xlsxIterator struct {
+	wb      *workbook
+	rows    *xlsxRowReader
+	sheet   string
+	headers []string
+	offset  int
+}
+
+func (it *xlsxIterator) Headers() []string { return it.headers }
+
+// Next skips blank rows and trims cells beyond the header width.
+func (it *xlsxIterator) Next() (*SourceRow, error) {
+	for {
+		row, err := it.rows.next()
+		if err == io.EOF {
+			return nil, io.EOF
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read sheet %q: %w", it.sheet, err)
+		}
+		if isEmptyRow(row.cells) {
+			continue
+		}
+		cells := row.cells
+		if len(cells) > len(it.headers) {
+			cells = cells[:len(it.headers)]
+		}
+		result := &SourceRow{Offset: it.offset, Cells: cells}
+		it.offset++
+		return result, nil
+	}
+}
+
+func (it *xlsxIterator) Close() error {
+	err := it.rows.Close()
+	if wbErr := it.wb.Close(); err == nil {
+		err = wbErr
+	}
+	return err
+}
+
+// ListXLSXSheets returns the sheet names of a workbook in tab order.
+func ListXLSXSheets(r io.Reader) ([]string, error) {
+	wb, err := openWorkbook(r)
+	if err != nil {
+		return nil, err
+	}
+	defer wb.Close()
+	names := make([]string, len(wb.sheets))
+	for i, s := range wb.sheets {
+		names[i] = s.Name
+	}
+	return names, nil
+}
+
+type workbook struct {
+	file          *os.File
+	files         map[string]*zip.File
+	sheets        []xlsxSheet
+	sharedStrings []string
+	dateStyles    map[int]dateKind
+	date1904      bool
+}
+
+type xlsxSheet struct {
+	Name string
+	path string
+}
+
+type xlsxRow struct {
+	number int
+	cells  []string
+}
+
+type dateKind int
+
+const (
+	notDate dateKind = iota
+	dateOnly
+	dateTime
+	timeOnly
+)
+
+// openWorkbook spools a workbook to a temporary file, which Close removes.
+func openWorkbook(r io.Reader) (*workbook, error) {
+	f, err := os.CreateTemp("", "workbook-*.xlsx")
+	if err != nil {
+		return nil, fmt.Errorf("failed to spool workbook: %w", err)
+	}
+	wb := &workbook{file: f}
+	if err := wb.load(r); err != nil {
+		wb.Close()
+		return nil, err
+	}
+	return wb, nil
+}
+
+func (wb *workbook) load(r io.Reader) error {
+	size, err := io.Copy(wb.file, io.LimitReader(r, maxXLSXSize+1))
+	if err != nil {
+		return fmt.Errorf("failed to read workbook: %w", err)
+	}
+	if size > maxXLSXSize {
+		return fmt.Errorf("workbook exceeds %d MB", maxXLSXSize>>20)
+	}
+	zr, err := zip.NewReader(wb.file, size)
+	if err != nil {
+		return fmt.Errorf("failed to open workbook: %w", err)
+	}
+
+	wb.files = make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		wb.files[f.Name] = f
+	}
+	if err := wb.loadSheets(); err != nil {
+		return err
+	}
+	if err := wb.loadSharedStrings(); err != nil {
+		return err
+	}
+	return wb.loadStyles()
+}
+
+// Close removes the workbook's temporary file.
+func (wb *workbook) Close() error {
+	err := wb.file.Close()
+	if rmErr := os.Remove(wb.file.Name()); err == nil {
+		err = rmErr
+	}
+	return err
+}
+
+func (wb *workbook) decode(name string, v interface{}) (bool, error) {
+	f, ok := wb.files[name]
+	if !ok {
+		return false, nil
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return true, err
+	}
+	defer rc.Close()
+	if err := xml.NewDecoder(rc).Decode(v); err != nil {
+		return true, fmt.Errorf("failed to parse %s: %w", name, err)
+	}
+	return true, nil
+}
+
+func (wb *workbook) loadSheets() error {
+	var book struct {
+		Pr struct {
+			Date1904 string `xml:"date1904,attr"`
+		} `xml:"workbookPr"`
+		Sheets []struct {
+			Name string `xml:"name,attr"`
+			RID  string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	found, err := wb.decode("xl/workbook.xml", &book)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("not an XLSX workbook")
+	}
+	wb.date1904 = book.Pr.Date1904 == "1" || book.Pr.Date1904 == "true"
+
+	var rels struct {
+		Relationships []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	if _, err := wb.decode("xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return err
+	}
+	targets := make(map[string]string, len(rels.Relationships))
+	for _, rel := range rels.Relationships {
+		if strings.HasPrefix(rel.Target, "/") {
+			targets[rel.ID] = strings.TrimPrefix(rel.Target, "/")
+		} else {
+			targets[rel.ID] = path.Join("xl", rel.Target)
+		}
+	}
+
+	for _, s := range book.Sheets {
+		if target, ok := targets[s.RID]; ok {
+			wb.sheets = append(wb.sheets, xlsxSheet{Name: s.Name, path: target})
+		}
+	}
+	if len(wb.sheets) == 0 {
+		return fmt.Errorf("workbook has no sheets")
+	}
+	return nil
+}
+
+func (wb *workbook) loadSharedStrings() error {
+	var sst struct {
+		Items []struct {
+			T  *string `xml:"t"`
+			Rs []struct {
+				T string `xml:"t"`
+			} `xml:"r"`
+		} `xml:"si"`
+	}
+	if _, err := wb.decode("xl/sharedStrings.xml", &sst); err != nil {
+		return err
+	}
+	wb.sharedStrings = make([]string, len(sst.Items))
+	for i, si := range sst.Items {
+		if si.T != nil {
+			wb.sharedStrings[i] = *si.T
+			continue
+		}
+		var sb strings.Builder
+		for _, r := range si.Rs {
+			sb.WriteString(r.T)
+		}
+		wb.sharedStrings[i] = sb.String()
+	}
+	return nil
+}
+
+func (wb *workbook) loadStyles() error {
+	var styles struct {
+		NumFmts []struct {
+			ID   int    `xml:"numFmtId,attr"`
+			Code string `xml:"formatCode,attr"`
+		} `xml:"numFmts>numFmt"`
+		CellXfs []struct {
+			NumFmtID int `xml:"numFmtId,attr"`
+		} `xml:"cellXfs>xf"`
+	}
+	if _, err := wb.decode("xl/styles.xml", &styles); err != nil {
+		return err
+	}
+	custom := make(map[int]string, len(styles.NumFmts))
+	for _, f := range styles.NumFmts {
+		custom[f.ID] = f.Code
+	}
+	wb.dateStyles = make(map[int]dateKind)
+	for i, xf := range styles.CellXfs {
+		kind := builtinDateFormat(xf.NumFmtID)
+		if code, ok := custom[xf.NumFmtID]; ok {
+			kind = classifyDateFormat(code)
+		}
+		if kind != notDate {
+			wb.dateStyles[i] = kind
+		}
+	}
+	return nil
+}
+
+func (wb *workbook) sheet(name string) (xlsxSheet, error) {
+	if name == "" {
+		return wb.sheets[0], nil
+	}
+	for _, s := range wb.sheets {
+		if s.Name == name {
+			return s, nil
+		}
+	}
+	return xlsxSheet{}, fmt.Errorf("sheet %q not found in workbook", name)
+}
+
+type xlsxCell struct {
+	Ref    string  `xml:"r,attr"`
+	Type   string  `xml:"t,attr"`
+	Style  int     `xml:"s,attr"`
+	Value  *string `xml:"v"`
+	Inline struct {
+		T  *string `xml:"t"`
+		Rs []struct {
+			T string `xml:"t"`
+		} `xml:"r"`
+	} `xml:"is"`
+}
+
+// xlsxRowReader decodes <row> elements of a worksheet one at a time.
+type xlsxRowReader struct {
+	wb   *workbook
+	rc   io.ReadCloser
+	dec  *xml.Decoder
+	last int
+}
+
+func (wb *workbook) openRows(sheet xlsxSheet) (*xlsxRowReader, error) {
+	f, ok := wb.files[sheet.path]
+	if !ok {
+		return nil, fmt.Errorf("missing worksheet %s", sheet.path)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	return &xlsxRowReader{wb: wb, rc: rc, dec: xml.NewDecoder(rc)}, nil
+}
+
+func (rr *xlsxRowReader) next() (*xlsxRow, error) {
+	for {
+		tok, err := rr.dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		start, ok := tok.(xml.StartElement)
+		if !ok || start.Name.Local != "row" {
+			continue
+		}
+		var r struct {
+			Number int        `xml:"r,attr"`
+			Cells  []xlsxCell `xml:"c"`
+		}
+		if err := rr.dec.DecodeElement(&r, &start); err != nil {
+			return nil, err
+		}
+		if r.Number == 0 {
+			r.Number = rr.last + 1
+		}
+		rr.last = r.Number
+		return &xlsxRow{number: r.Number, cells: rr.wb.rowCells(r.Cells)}, nil
+	}
+}
+
+func (rr *xlsxRowReader) Close() error { return rr.rc.Close() }
+
+func (wb *workbook) rowCells(row []xlsxCell) []string {
+	var cells []string
+	for i, c := range row {
+		col := i
+		if c.Ref != "" {
+			if idx, ok := columnIndex(c.Ref); ok {
+				col = idx
+			}
+		}
+		for len(cells) <= col {
+			cells = append(cells, "")
+		}
+		inline := ""
+		if c.Inline.T != nil {
+			inline = *c.Inline.T
+		} else {
+			for _, r := range c.Inline.Rs {
+				inline += r.T
+			}
+		}
+		cells[col] = wb.cellValue(c.Type, c.Style, c.Value, inline)
+	}
+	return cells
+}
+
+func (wb *workbook) cellValue(cellType string, style int, value *string, inline string) string {
+	v := ""
+	if value != nil {
+		v = *value
+	}
+	switch cellType {
+	case "s":
+		idx, err := strconv.Atoi(v)
+		if err != nil || idx < 0 || idx >= len(wb.sharedStrings) {
+			return ""
+		}
+		return wb.sharedStrings[idx]
+	case "inlineStr":
+		return inline
+	case "b":
+		if v == "1" {
+			return "true"
+		}
+		return "false"
+	case "e":
+		return ""
+	case "str", "d":
+		return v
+	}
+
+	if v == "" {
+		return ""
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return v
+	}
+	if kind, ok := wb.dateStyles[style]; ok {
+		return formatExcelDate(f, kind, wb.date1904)
+	}
+	return formatExcelNumber(f)
+}
+
+// formatExcelNumber drops the binary float noise Excel leaves in stored
+// values (0.1 saved as 0.10000000000000001) by rounding to the 15
+// significant digits Excel itself displays.
+func formatExcelNumber(f float64) string {
+	rounded, err := strconv.ParseFloat(strconv.FormatFloat(f, 'g', 15, 64), 64)
+	if err != nil {
+		rounded = f
+	}
+	return strconv.FormatFloat(rounded, 'f', -1, 64)
+}
+
+func formatExcelDate(serial float64, kind dateKind, date1904 bool) string {
+	base := time.Date(1899, time.December, 30, 0, 0, 0, 0, time.UTC)
+	if date1904 {
+		base = time.Date(1904, time.January, 1, 0, 0, 0, 0, time.UTC)
+	} else if serial < 60 {
+		// Excel counts a nonexistent 1900-02-29, so serials before it are
+		// one day later than the 1899-12-30 epoch implies.
+		serial++
+	}
+	days := math.Floor(serial)
+	seconds := math.Round((serial - days) * 86400)
+	t := base.AddDate(0, 0, int(days)).Add(time.Duration(seconds) * time.Second)
+
+	switch kind {
+	case timeOnly:
+		return t.Format("15:04:05")
+	case dateTime:
+		return t.Format("2006-01-02T15:04:05")
+	default:
+		return t.Format("2006-01-02")
+	}
+}
+
+// builtinDateFormat classifies Excel's built-in number formats.
+func builtinDateFormat(id int) dateKind {
+	switch {
+	case id >= 14 && id <= 17, id >= 27 && id <= 31, id >= 50 && id <= 54, id >= 57 && id <= 58:
+		return dateOnly
+	case id == 22:
+		return dateTime
+	case id >= 18 && id <= 21, id >= 45 && id <= 47, id >= 32 && id <= 36, id >= 55 && id <= 56:
+		return timeOnly
+	}
+	return notDate
+}
+
+// classifyDateFormat inspects a custom format code for date and time tokens,
+// ignoring quoted literals, escaped characters and bracketed sections such
+// as colors and locales.
+func classifyDateFormat(code string) dateKind {
+	if i := strings.Index(code, ";"); i >= 0 {
+		code = code[:i]
+	}
+	var hasDate, hasTime bool
+	inQuote, inBracket := false, false
+	for i := 0; i < len(code); i++ {
+		c := code[i]
+		switch {
+		case inQuote:
+			inQuote = c != '"'
+		case inBracket:
+			if c == ']' {
+				inBracket = false
+			} else if c == 'h' || c == 'H' || c == 's' || c == 'S' {
+				hasTime = true // elapsed time such as [h]:mm
+			}
+		case c == '"':
+			inQuote = true
+		case c == '[':
+			inBracket = true
+		case c == '\\' || c == '_' || c == '*':
+			i++
+		default:
+			switch c {
+			case 'd', 'D', 'y', 'Y':
+				hasDate = true
+			case 'h', 'H', 's', 'S':
+				hasTime = true
+			case 'm', 'M':
+				// m is months unless it sits next to hours or seconds.
+				if !strings.ContainsAny(code, "hHsS") {
+					hasDate = true
+				}
+			}
+		}
+	}
+	switch {
+	case hasDate && hasTime:
+		return dateTime
+	case hasDate:
+		return dateOnly
+	case hasTime:
+		return timeOnly
+	}
+	return notDate
+}
+
+// columnIndex converts the column part of a cell reference ("AB12") to a
+// zero-based index.
+func columnIndex(ref string) (int, bool) {
+	idx := 0
+	n := 0
+	for _, r := range ref {
+		if r >= 'A' && r <= 'Z' {
+			idx = idx*26 + int(r-'A'+1)
+			n++
+			continue
+		}
+		if r >= 'a' && r <= 'z' {
+			idx = idx*26 + int(r-'a'+1)
+			n++
+			continue
+		}
+		break
+	}
+	if n == 0 {
+		return 0, false
+	}
+	return idx - 1, true
+}
+
+// ColumnName converts a zero-based index to Excel letters (0 → A, 27 → AB).
+func ColumnName(idx int) string {
+	name := ""
+	for idx >= 0 {
+		name = string(rune('A'+idx%26)) + name
+		idx = idx/26 - 1
+	}
+	return name
+}
+
+func isEmptyRow(cells []string) bool {
+	for _, c := range cells {
+		if strings.TrimSpace(c) != "" {
+			return false
+		}
+	}
+	return true
+}
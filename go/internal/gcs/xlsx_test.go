@@ -0,0 +1,176 @@
+package gcs
+
+import (
+	"archive/zip"
+	"bytes"
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func buildWorkbook(t *testing.T, files map[string]string) string {
+	t.Helper()
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.String()
+}
+
+func testWorkbookFiles() map[string]string {
+	return map[string]string{
+		"xl/workbook.xml": `<?xml version="1.0"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Summary" sheetId="1" r:id="rId1"/><sheet name="Companies" sheetId="2" r:id="rId2"/></sheets>
+</workbook>`,
+		"xl/_rels/workbook.xml.rels": `<?xml version="1.0"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Target="worksheets/sheet1.xml"/>
+<Relationship Id="rId2" Target="/xl/worksheets/sheet2.xml"/>
+</Relationships>`,
+		"xl/sharedStrings.xml": `<?xml version="1.0"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<si><t>Name</t></si><si><t>Founded</t></si><si><t>Revenue</t></si><si><t>Public</t></si>
+<si><r><t>Acme</t></r><r><t> Corp</t></r></si>
+</sst>`,
+		"xl/styles.xml": `<?xml version="1.0"?>
+<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">
+<numFmts><numFmt numFmtId="164" formatCode="yyyy/mm/dd hh:mm"/></numFmts>
+<cellXfs><xf numFmtId="0"/><xf numFmtId="14"/><xf numFmtId="164"/></cellXfs>
+</styleSheet>`,
+		"xl/worksheets/sheet1.xml": `<?xml version="1.0"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="inlineStr"><is><t>Only</t></is></c></row>
+</sheetData></worksheet>`,
+		"xl/worksheets/sheet2.xml": `<?xml version="1.0"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="inlineStr"><is><t>Exported 2024</t></is></c></row>
+<row r="2"><c r="A2" t="s"><v>0</v></c><c r="B2" t="s"><v>1</v></c><c r="C2" t="s"><v>2</v></c><c r="D2" t="s"><v>3</v></c><c r="F2" t="str"><v>Updated</v></c></row>
+<row r="3"><c r="A3" t="s"><v>4</v></c><c r="B3" s="1"><v>45292</v></c><c r="C3"><v>0.10000000000000001</v></c><c r="D3" t="b"><v>1</v></c><c r="F3" s="2"><v>45292.5</v></c></row>
+<row r="4"><c r="A4"/></row>
+<row r="6"><c r="A6" t="inlineStr"><is><t>Globex</t></is></c><c r="C6"><v>1.5E3</v></c><c r="D6" t="e"><v>#N/A</v></c><c r="H6"><v>9</v></c></row>
+</sheetData></worksheet>`,
+	}
+}
+
+func TestParseXLSX(t *testing.T) {
+	data := testWorkbookFiles()
+
+	result, err := readSource(newXLSXIterator, buildWorkbook(t, data), SourceFile{Sheet: "Companies", HeaderRow: 2})
+	if err != nil {
+		t.Fatalf("readSource() error: %v", err)
+	}
+	wantHeaders := []string{"Name", "Founded", "Revenue", "Public", "Column E", "Updated"}
+	if !reflect.DeepEqual(result.Headers, wantHeaders) {
+		t.Errorf("headers = %q, want %q", result.Headers, wantHeaders)
+	}
+	wantRows := [][]string{
+		{"Acme Corp", "2024-01-01", "0.1", "true", "", "2024-01-01T12:00:00"},
+		{"Globex", "", "1500", "", "", ""},
+	}
+	if !reflect.DeepEqual(result.Rows, wantRows) {
+		t.Errorf("rows = %q, want %q", result.Rows, wantRows)
+	}
+
+	result, err = readSource(newXLSXIterator, buildWorkbook(t, data), SourceFile{})
+	if err != nil {
+		t.Fatalf("readSource() default sheet error: %v", err)
+	}
+	if !reflect.DeepEqual(result.Headers, []string{"Only"}) {
+		t.Errorf("default sheet headers = %q, want [Only]", result.Headers)
+	}
+
+	if _, err := readSource(newXLSXIterator, buildWorkbook(t, data), SourceFile{Sheet: "Missing"}); err == nil {
+		t.Error("readSource() with unknown sheet succeeded, want error")
+	}
+	if _, err := readSource(newXLSXIterator, buildWorkbook(t, data), SourceFile{Sheet: "Companies", HeaderRow: 5}); err == nil {
+		t.Error("readSource() with empty header row succeeded, want error")
+	}
+}
+
+func TestXLSXIteratorRemovesSpooledWorkbook(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("TMPDIR", dir)
+	content := buildWorkbook(t, testWorkbookFiles())
+	it, err := newXLSXIterator(func() (io.ReadCloser, error) {
+		return io.NopCloser(strings.NewReader(content)), nil
+	}, SourceFile{Sheet: "Companies", HeaderRow: 2})
+	if err != nil {
+		t.Fatalf("newXLSXIterator() error: %v", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 1 {
+		t.Fatalf("spooled files = %d, want 1", len(entries))
+	}
+	if err := it.Close(); err != nil {
+		t.Fatalf("Close() error: %v", err)
+	}
+	if entries, _ := os.ReadDir(dir); len(entries) != 0 {
+		t.Errorf("spooled files after Close = %d, want 0", len(entries))
+	}
+}
+
+func TestListXLSXSheets(t *testing.T) {
+	sheets, err := ListXLSXSheets(strings.NewReader(buildWorkbook(t, testWorkbookFiles())))
+	if err != nil {
+		t.Fatalf("ListXLSXSheets() error: %v", err)
+	}
+	if want := []string{"Summary", "Companies"}; !reflect.DeepEqual(sheets, want) {
+		t.Errorf("sheets = %q, want %q", sheets, want)
+	}
+}
+
+func TestClassifyDateFormat(t *testing.T) {
+	tests := []struct {
+		code string
+		want dateKind
+	}{
+		{"yyyy-mm-dd", dateOnly},
+		{"dd/mm/yyyy hh:mm:ss", dateTime},
+		{"h:mm AM/PM", timeOnly},
+		{"[h]:mm", timeOnly},
+		{"0.00%", notDate},
+		{`#,##0 "days"`, notDate},
+		{"[Red]#,##0.00", notDate},
+		{"mmm-yy", dateOnly},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			if got := classifyDateFormat(tt.code); got != tt.want {
+				t.Errorf("classifyDateFormat(%q) = %v, want %v", tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestColumnIndex(t *testing.T) {
+	tests := []struct {
+		ref  string
+		want int
+	}{
+		{"A1", 0},
+		{"Z9", 25},
+		{"AA10", 26},
+		{"AB3", 27},
+	}
+	for _, tt := range tests {
+		got, ok := columnIndex(tt.ref)
+		if !ok || got != tt.want {
+			t.Errorf("columnIndex(%q) = %d, %v, want %d", tt.ref, got, ok, tt.want)
+		}
+		if name := ColumnName(tt.want); name+tt.ref[len(name):] != tt.ref {
+			t.Errorf("ColumnName(%d) = %q, want prefix of %q", tt.want, name, tt.ref)
+		}
+	}
+}
@@ -0,0 +1,93 @@
+package models
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// APIKeyPrefix starts every API key, which tells them apart from the JWTs
+// sent in the same Authorization header.
+const APIKeyPrefix = "ak_"
+
+type APIKeyScope string
+
+const (
+	// APIKeyScopeReadOnly allows reading sources, jobs and their results.
+	APIKeyScopeReadOnly APIKeyScope = "read-only"
+	// APIKeyScopeEnrich allows creating and changing sources and jobs, and
+	// implies APIKeyScopeReadOnly.
+	APIKeyScopeEnrich APIKeyScope = "enrich"
+	// APIKeyScopeBilling allows managing the subscription.
+	APIKeyScopeBilling APIKeyScope = "billing"
+)
+
+// APIKeyScopes lists every scope an API key can be given.
+var APIKeyScopes = []APIKeyScope{
+	APIKeyScopeReadOnly,
+	APIKeyScopeEnrich,
+	APIKeyScopeBilling,
+}
+
+// APIKey is a user's key for calling the API without a WorkOS session.
+// Only a hash of the key is stored; Prefix is the start of it, so users can
+// tell their keys apart.
+type APIKey struct {
+	ID         uuid.UUID     `json:"id"`
+	UserID     string        `json:"user_id"`
+	Name       string        `json:"name"`
+	Prefix     string        `json:"prefix"`
+	Scopes     []APIKeyScope `json:"scopes"`
+	ExpiresAt  *time.Time    `json:"expires_at,omitempty"`
+	LastUsedAt *time.Time    `json:"last_used_at,omitempty"`
+	RevokedAt  *time.Time    `json:"revoked_at,omitempty"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+// HasScope reports whether the key was given the scope, or enrich when
+// the scope is read-only.
+func (k *APIKey) HasScope(scope APIKeyScope) bool {
+	if scope == APIKeyScopeReadOnly && slices.Contains(k.Scopes, APIKeyScopeEnrich) {
+		return true
+	}
+	return slices.Contains(k.Scopes, scope)
+}
+
+// Active reports whether the key is neither revoked nor expired at now.
+func (k *APIKey) Active(now time.Time) bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || now.Before(*k.ExpiresAt)
+}
+
+type APIKeyDB struct {
+	bun.BaseModel `bun:"table:api_keys,alias:ak"`
+
+	ID         uuid.UUID     `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	UserID     string        `bun:"user_id,notnull" json:"user_id"`
+	Name       string        `bun:"name,notnull" json:"name"`
+	Prefix     string        `bun:"prefix,notnull" json:"prefix"`
+	KeyHash    string        `bun:"key_hash,notnull,unique" json:"-"`
+	Scopes     []APIKeyScope `bun:"scopes,type:jsonb,notnull" json:"scopes"`
+	ExpiresAt  *time.Time    `bun:"expires_at" json:"expires_at"`
+	LastUsedAt *time.Time    `bun:"last_used_at" json:"last_used_at"`
+	RevokedAt  *time.Time    `bun:"revoked_at" json:"revoked_at"`
+	CreatedAt  time.Time     `bun:"created_at,notnull,default:current_timestamp" json:"created_at"`
+}
+
+func (k *APIKeyDB) ToAPIKey() *APIKey {
+	return &APIKey{
+		ID:         k.ID,
+		UserID:     k.UserID,
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     k.Scopes,
+		ExpiresAt:  k.ExpiresAt,
+		LastUsedAt: k.LastUsedAt,
+		RevokedAt:  k.RevokedAt,
+		CreatedAt:  k.CreatedAt,
+	}
+}
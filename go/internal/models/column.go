@@ -10,11 +10,15 @@ const (
 	ColumnTypeNumber  ColumnType = "number"
 	ColumnTypeBoolean ColumnType = "boolean"
 	ColumnTypeDate    ColumnType = "date"
+	// ColumnTypeList holds several text values.
+	ColumnTypeList ColumnType = "list"
 )
 
 const (
 	JobTypeEnrichment JobType = "enrichment"
 	JobTypeImputation JobType = "imputation"
+	JobTypeDerived    JobType = "derived"
+	JobTypeClassify   JobType = "classify"
 )
 
 type ColumnMetadata struct {
@@ -22,6 +26,56 @@ type ColumnMetadata struct {
 	Type        ColumnType `json:"type"`
 	JobType     JobType    `json:"job_type"`
 	Description *string    `json:"description,omitempty"`
+	// Expression computes derived columns from the row's other values.
+	Expression *string `json:"expression,omitempty"`
+	// InputColumns are the source columns a classify column is computed from.
+	InputColumns []string `json:"input_columns,omitempty"`
+}
+
+// SplitDerivedColumns separates derived columns, which are computed locally
+// once a row completes, from the columns that go through search and extraction.
+func SplitDerivedColumns(cols []*ColumnMetadata) (enriched, derived []*ColumnMetadata) {
+	for _, col := range cols {
+		if col.JobType == JobTypeDerived {
+			derived = append(derived, col)
+		} else {
+			enriched = append(enriched, col)
+		}
+	}
+	return enriched, derived
+}
+
+// SplitClassifyColumns separates classify columns, which are answered from the
+// row's own input columns, from the columns that need a web search.
+func SplitClassifyColumns(cols []*ColumnMetadata) (searched, classify []*ColumnMetadata) {
+	for _, col := range cols {
+		if col.JobType == JobTypeClassify {
+			classify = append(classify, col)
+		} else {
+			searched = append(searched, col)
+		}
+	}
+	return searched, classify
+}
+
+// ClassifyCredits converts a number of classified cells to credits, of which
+// each buys cellsPerCredit. A classify cell is a single LLM call over data
+// already in the row, so it is billed at a fraction of a searched cell,
+// rounded up.
+func ClassifyCredits(cells, cellsPerCredit int) int {
+	if cellsPerCredit <= 1 {
+		return cells
+	}
+	return (cells + cellsPerCredit - 1) / cellsPerCredit
+}
+
+// RequiredCredits is the number of credits a job over rowCount rows is
+// expected to cost, with classify cells billed as in ClassifyCredits.
+// Derived columns are free.
+func RequiredCredits(rowCount int, cols []*ColumnMetadata, classifyCellsPerCredit int) int64 {
+	enriched, _ := SplitDerivedColumns(cols)
+	searched, classify := SplitClassifyColumns(enriched)
+	return int64(rowCount*len(searched) + ClassifyCredits(rowCount*len(classify), classifyCellsPerCredit))
 }
 
 type TemplateColumnMetadata struct {
@@ -0,0 +1,53 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+// CorrectionReason is the confidence reason of a cell set by a reviewer.
+const CorrectionReason = "Corrected by a reviewer"
+
+// CellCorrection records a reviewer's edit of one extracted cell: who made
+// it, when, and the value before and after.
+type CellCorrection struct {
+	ID        uuid.UUID   `json:"id"`
+	JobID     string      `json:"job_id"`
+	RowKey    string      `json:"row_key"`
+	Column    string      `json:"column"`
+	OldValue  interface{} `json:"old_value"`
+	NewValue  interface{} `json:"new_value"`
+	UserID    string      `json:"user_id"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+type CellCorrectionDB struct {
+	bun.BaseModel `bun:"table:cell_corrections,alias:cc"`
+
+	ID        uuid.UUID   `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	JobID     string      `bun:"job_id,notnull" json:"job_id"`
+	RowKey    string      `bun:"row_key,notnull" json:"row_key"`
+	Column    string      `bun:"column_name,notnull" json:"column"`
+	OldValue  interface{} `bun:"old_value,type:jsonb" json:"old_value"`
+	NewValue  interface{} `bun:"new_value,type:jsonb" json:"new_value"`
+	UserID    string      `bun:"user_id,notnull" json:"user_id"`
+	CreatedAt time.Time   `bun:"created_at,notnull,default:current_timestamp" json:"created_at"`
+	// Precision is the precision of a corrected date, kept in the cell's
+	// confidence rather than stored with the correction.
+	Precision DatePrecision `bun:"-" json:"-"`
+}
+
+func (c *CellCorrectionDB) ToCellCorrection() *CellCorrection {
+	return &CellCorrection{
+		ID:        c.ID,
+		JobID:     c.JobID,
+		RowKey:    c.RowKey,
+		Column:    c.Column,
+		OldValue:  c.OldValue,
+		NewValue:  c.NewValue,
+		UserID:    c.UserID,
+		CreatedAt: c.CreatedAt,
+	}
+}
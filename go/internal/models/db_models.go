@@ -36,14 +36,19 @@ type JobDB struct {
 	SourceID             uuid.UUID         `bun:"source_id,type:uuid" json:"source_id"`
 	Source               *SourceDB         `bun:"rel:belongs-to,join:source_id=id"`
 	KeyColumns           []string          `bun:"key_columns,type:jsonb" json:"key_columns"`
+	ContextColumns       []string          `bun:"context_columns,type:jsonb" json:"context_columns"`
 	ColumnsMetadata      []*ColumnMetadata `bun:"columns_metadata,type:jsonb" json:"columns_metadata"`
 	KeyColumnDescription *string           `bun:"entity_type" json:"key_column_description"`
+	Locale               *string           `bun:"locale" json:"locale"`
 	TotalRows            int               `bun:"total_rows,notnull" json:"total_rows"`
 	StartedAt            *time.Time        `bun:"started_at" json:"started_at"`
 	Status               JobStatus         `bun:"status,notnull,default:'PENDING'" json:"status"`
 	CostDollars          int               `bun:"cost_dollars,notnull,default:0" json:"cost_dollars"`
 	CostCredits          int               `bun:"cost_credits,notnull,default:0" json:"cost_credits"`
 	TemplateID           *uuid.UUID        `bun:"template_id,type:uuid" json:"template_id"`
+	KeyMapping           map[string]string `bun:"key_mapping,type:jsonb" json:"key_mapping,omitempty"`
+	SourceRevision       int               `bun:"source_revision,notnull,default:1" json:"source_revision"`
+	BaseJobID            *string           `bun:"base_job_id" json:"base_job_id"`
 
 	CreatedAt time.Time `bun:"created_at,notnull,default:current_timestamp" json:"created_at"`
 	UpdatedAt time.Time `bun:"updated_at,notnull,default:current_timestamp" json:"updated_at"`
@@ -55,12 +60,17 @@ func (j *JobDB) ToJob() (*Job, error) {
 		UserID:               j.UserID,
 		SourceID:             j.SourceID,
 		KeyColumns:           j.KeyColumns,
+		ContextColumns:       j.ContextColumns,
 		ColumnsMetadata:      j.ColumnsMetadata,
 		KeyColumnDescription: j.KeyColumnDescription,
+		Locale:               j.Locale,
 		TotalRows:            j.TotalRows,
 		StartedAt:            j.StartedAt,
 		Status:               j.Status,
 		TemplateID:           j.TemplateID,
+		KeyMapping:           j.KeyMapping,
+		SourceRevision:       j.SourceRevision,
+		BaseJobID:            j.BaseJobID,
 		CreatedAt:            j.CreatedAt,
 		UpdatedAt:            j.UpdatedAt,
 	}
@@ -80,6 +90,7 @@ func JobFromDomain(job *Job) *JobDB {
 		UserID:               job.UserID,
 		SourceID:             job.SourceID,
 		KeyColumns:           job.KeyColumns,
+		ContextColumns:       job.ContextColumns,
 		ColumnsMetadata:      job.ColumnsMetadata,
 		KeyColumnDescription: job.KeyColumnDescription,
 		TotalRows:            job.TotalRows,
@@ -102,6 +113,7 @@ type RowStateDB struct {
 	Confidence        map[string]*FieldConfidenceInfo `bun:"confidence,type:jsonb" json:"confidence,omitempty"`
 	Sources           []string                        `bun:"sources,type:jsonb" json:"sources,omitempty"`
 	ExtractionHistory []*ExtractionHistoryEntry       `bun:"extraction_history,type:jsonb" json:"extraction_history,omitempty"`
+	InputValues       map[string]string               `bun:"input_values,type:jsonb" json:"-"`
 	Error             *string                         `bun:"error" json:"error,omitempty"`
 	CreatedAt         time.Time                       `bun:"created_at,notnull,default:current_timestamp" json:"created_at"`
 	UpdatedAt         time.Time                       `bun:"updated_at,notnull,default:current_timestamp" json:"updated_at"`
@@ -0,0 +1,50 @@
+package models
+
+// DiffChange is how a cell moved between two jobs.
+type DiffChange string
+
+const (
+	DiffAdded     DiffChange = "added"
+	DiffRemoved   DiffChange = "removed"
+	DiffChanged   DiffChange = "changed"
+	DiffUnchanged DiffChange = "unchanged"
+)
+
+// CellDiff compares one cell of a row in a base job and a later job.
+// ConfidenceDelta is set when both jobs scored the cell.
+type CellDiff struct {
+	Column          string      `json:"column"`
+	Change          DiffChange  `json:"change"`
+	BaseValue       interface{} `json:"base_value"`
+	Value           interface{} `json:"value"`
+	BaseConfidence  *float64    `json:"base_confidence,omitempty"`
+	Confidence      *float64    `json:"confidence,omitempty"`
+	ConfidenceDelta *float64    `json:"confidence_delta,omitempty"`
+}
+
+// RowDiff holds the compared cells of the rows sharing a key.
+type RowDiff struct {
+	Key   string      `json:"key"`
+	Cells []*CellDiff `json:"cells"`
+}
+
+// ColumnDiffSummary counts the changes of one column over all rows.
+// MeanConfidenceDelta averages the deltas of cells scored in both jobs.
+type ColumnDiffSummary struct {
+	Column              string   `json:"column"`
+	Added               int      `json:"added"`
+	Removed             int      `json:"removed"`
+	Changed             int      `json:"changed"`
+	Unchanged           int      `json:"unchanged"`
+	MeanConfidenceDelta *float64 `json:"mean_confidence_delta,omitempty"`
+}
+
+// JobDiff compares the results of two jobs on the same source, with rows
+// aligned by key.
+type JobDiff struct {
+	BaseJobID  string               `json:"base_job_id"`
+	JobID      string               `json:"job_id"`
+	Columns    []*ColumnDiffSummary `json:"columns"`
+	Rows       []*RowDiff           `json:"rows"`
+	Pagination *PaginationInfo      `json:"pagination"`
+}
@@ -1,6 +1,8 @@
 package models
 
 import (
+	"maps"
+	"slices"
 	"time"
 
 	"github.com/google/uuid"
@@ -35,12 +37,17 @@ type Job struct {
 	SourceID             uuid.UUID         `json:"source_id"`
 	Source               *Source           `json:"source,omitempty"`
 	KeyColumns           []string          `json:"key_columns"`
+	ContextColumns       []string          `json:"context_columns"`
 	ColumnsMetadata      []*ColumnMetadata `json:"columns_metadata"`
 	KeyColumnDescription *string           `json:"key_column_description"`
+	Locale               *string           `json:"locale"`
 	TotalRows            int               `json:"total_rows"`
 	StartedAt            *time.Time        `json:"started_at"`
 	Status               JobStatus         `json:"status"`
 	TemplateID           *uuid.UUID        `json:"template_id"`
+	KeyMapping           map[string]string `json:"key_mapping,omitempty"`
+	SourceRevision       int               `json:"source_revision"`
+	BaseJobID            *string           `json:"base_job_id"`
 	CreatedAt            time.Time         `json:"created_at"`
 	UpdatedAt            time.Time         `json:"updated_at"`
 }
@@ -64,9 +71,34 @@ type CrawlResults struct {
 	Sources []string `json:"sources"`
 }
 
+type DatePrecision string
+
+const (
+	DatePrecisionDay     DatePrecision = "day"
+	DatePrecisionWeek    DatePrecision = "week"
+	DatePrecisionMonth   DatePrecision = "month"
+	DatePrecisionQuarter DatePrecision = "quarter"
+	DatePrecisionYear    DatePrecision = "year"
+)
+
 type FieldConfidenceInfo struct {
-	Score  float64 `json:"score"`
-	Reason string  `json:"reason"`
+	Score     float64       `json:"score"`
+	Reason    string        `json:"reason"`
+	Precision DatePrecision `json:"precision,omitempty"`
+	// HumanVerified marks a value set by a reviewer. Enrichment never
+	// overwrites it.
+	HumanVerified bool `json:"human_verified,omitempty"`
+	// Citation is the page passage the value was taken from.
+	Citation *Citation `json:"citation,omitempty"`
+}
+
+// Citation points to the passage supporting an extracted value. Verified is
+// set once the quote has been found in the fetched content; the model's
+// own claim is never trusted.
+type Citation struct {
+	URL      string `json:"url"`
+	Quote    string `json:"quote"`
+	Verified bool   `json:"verified"`
 }
 
 type EnrichmentAttempt struct {
@@ -97,33 +129,64 @@ type RowState struct {
 	UpdatedAt         time.Time                       `json:"updated_at"`
 }
 
+// MergeMode says how the values of a re-run are combined with the values a
+// row already has.
+type MergeMode string
+
+const (
+	// MergeBest keeps whichever value has the higher confidence score.
+	MergeBest MergeMode = "best"
+	// MergeReplace replaces the stored values of the re-run columns.
+	MergeReplace MergeMode = "replace"
+)
+
 type StateUpdate struct {
 	ExtractedData     map[string]interface{}          `json:"extracted_data,omitempty"`
 	Confidence        map[string]*FieldConfidenceInfo `json:"confidence,omitempty"`
 	Sources           []string                        `json:"sources,omitempty"`
 	ExtractionHistory []*ExtractionHistoryEntry       `json:"extraction_history,omitempty"`
 	Error             *string                         `json:"error,omitempty"`
+	// Merge is set for re-runs. Their sources are added to the row's
+	// instead of replacing them, and with MergeBest a value only replaces a
+	// stored one with a higher confidence score.
+	Merge MergeMode `json:"merge,omitempty"`
+}
+
+// IsVerified reports whether a reviewer has set the value of column.
+func (s *RowState) IsVerified(column string) bool {
+	c := s.Confidence[column]
+	return c != nil && c.HumanVerified
 }
 
+// ApplyUpdate merges an update into the row. Human-verified cells keep
+// their value and confidence; with MergeBest the other cells keep their
+// value unless the update's confidence is higher.
 func (s *RowState) ApplyUpdate(u *StateUpdate) {
-	if u.ExtractedData != nil {
-		if s.ExtractedData == nil {
-			s.ExtractedData = make(map[string]interface{})
+	data, conf := withoutVerified(s, u.ExtractedData), withoutVerified(s, u.Confidence)
+	if u.Merge == MergeBest {
+		if data != nil {
+			s.ExtractedData, s.Confidence = MergeBestConfidence(s.ExtractedData, s.Confidence, data, conf)
 		}
-		for k, v := range u.ExtractedData {
-			s.ExtractedData[k] = v
+	} else {
+		if data != nil {
+			if s.ExtractedData == nil {
+				s.ExtractedData = make(map[string]interface{})
+			}
+			maps.Copy(s.ExtractedData, data)
 		}
-	}
-	if u.Confidence != nil {
-		if s.Confidence == nil {
-			s.Confidence = make(map[string]*FieldConfidenceInfo)
-		}
-		for k, v := range u.Confidence {
-			s.Confidence[k] = v
+		if conf != nil {
+			if s.Confidence == nil {
+				s.Confidence = make(map[string]*FieldConfidenceInfo)
+			}
+			maps.Copy(s.Confidence, conf)
 		}
 	}
 	if u.Sources != nil {
-		s.Sources = u.Sources
+		if u.Merge != "" {
+			s.Sources = appendMissing(s.Sources, u.Sources)
+		} else {
+			s.Sources = u.Sources
+		}
 	}
 	if u.ExtractionHistory != nil {
 		s.ExtractionHistory = append(s.ExtractionHistory, u.ExtractionHistory...)
@@ -134,6 +197,57 @@ func (s *RowState) ApplyUpdate(u *StateUpdate) {
 	}
 }
 
+// MergeBestConfidence merges candidate values into the base, keeping
+// whichever value has the higher confidence score for each field. Fields
+// that only exist in one side are always included.
+func MergeBestConfidence(
+	base map[string]interface{},
+	baseConf map[string]*FieldConfidenceInfo,
+	candidates map[string]interface{},
+	candidateConf map[string]*FieldConfidenceInfo,
+) (map[string]interface{}, map[string]*FieldConfidenceInfo) {
+	if base == nil {
+		base = make(map[string]interface{})
+	}
+	if baseConf == nil {
+		baseConf = make(map[string]*FieldConfidenceInfo)
+	}
+	for k, v := range candidates {
+		current := baseConf[k]
+		candidate := candidateConf[k]
+		if current == nil || (candidate != nil && candidate.Score > current.Score) {
+			base[k] = v
+			if candidate != nil {
+				baseConf[k] = candidate
+			}
+		}
+	}
+	return base, baseConf
+}
+
+// withoutVerified drops the human-verified columns from an update's values.
+func withoutVerified[V any](s *RowState, values map[string]V) map[string]V {
+	if values == nil {
+		return nil
+	}
+	kept := make(map[string]V, len(values))
+	for column, v := range values {
+		if !s.IsVerified(column) {
+			kept[column] = v
+		}
+	}
+	return kept
+}
+
+func appendMissing(list, items []string) []string {
+	for _, item := range items {
+		if !slices.Contains(list, item) {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
 type JobProgress struct {
 	JobID       string           `json:"job_id"`
 	TotalRows   int              `json:"total_rows"`
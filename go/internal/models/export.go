@@ -0,0 +1,82 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type ExportFormat string
+
+const (
+	ExportFormatCSV     ExportFormat = "csv"
+	ExportFormatXLSX    ExportFormat = "xlsx"
+	ExportFormatNDJSON  ExportFormat = "ndjson"
+	ExportFormatParquet ExportFormat = "parquet"
+)
+
+type ExportStatus string
+
+const (
+	ExportStatusPending   ExportStatus = "PENDING"
+	ExportStatusRunning   ExportStatus = "RUNNING"
+	ExportStatusCompleted ExportStatus = "COMPLETED"
+	ExportStatusFailed    ExportStatus = "FAILED"
+)
+
+// ExportOptions picks the columns written next to the enriched values.
+type ExportOptions struct {
+	IncludeConfidence bool `json:"include_confidence,omitempty"`
+	IncludeSources    bool `json:"include_sources,omitempty"`
+	IncludeCitations  bool `json:"include_citations,omitempty"`
+	IncludeError      bool `json:"include_error,omitempty"`
+}
+
+// Export is a file of a job's results merged back into its source rows.
+// ObjectName is set once the file has been written to blob storage.
+type Export struct {
+	ID          uuid.UUID     `json:"id"`
+	JobID       string        `json:"job_id"`
+	UserID      string        `json:"user_id"`
+	Format      ExportFormat  `json:"format"`
+	Options     ExportOptions `json:"options"`
+	Status      ExportStatus  `json:"status"`
+	ObjectName  *string       `json:"object_name,omitempty"`
+	RowCount    int           `json:"row_count"`
+	Error       *string       `json:"error,omitempty"`
+	CreatedAt   time.Time     `json:"created_at"`
+	CompletedAt *time.Time    `json:"completed_at,omitempty"`
+}
+
+type ExportDB struct {
+	bun.BaseModel `bun:"table:exports,alias:ex"`
+
+	ID          uuid.UUID     `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	JobID       string        `bun:"job_id,notnull" json:"job_id"`
+	UserID      string        `bun:"user_id,notnull" json:"user_id"`
+	Format      ExportFormat  `bun:"format,notnull" json:"format"`
+	Options     ExportOptions `bun:"options,type:jsonb" json:"options"`
+	Status      ExportStatus  `bun:"status,notnull,default:'PENDING'" json:"status"`
+	ObjectName  *string       `bun:"object_name" json:"object_name"`
+	RowCount    int           `bun:"row_count,notnull,default:0" json:"row_count"`
+	Error       *string       `bun:"error" json:"error"`
+	CreatedAt   time.Time     `bun:"created_at,notnull,default:current_timestamp" json:"created_at"`
+	CompletedAt *time.Time    `bun:"completed_at" json:"completed_at"`
+}
+
+func (e *ExportDB) ToExport() *Export {
+	return &Export{
+		ID:          e.ID,
+		JobID:       e.JobID,
+		UserID:      e.UserID,
+		Format:      e.Format,
+		Options:     e.Options,
+		Status:      e.Status,
+		ObjectName:  e.ObjectName,
+		RowCount:    e.RowCount,
+		Error:       e.Error,
+		CreatedAt:   e.CreatedAt,
+		CompletedAt: e.CompletedAt,
+	}
+}
@@ -0,0 +1,36 @@
+package models
+
+import "sort"
+
+// KeyAliases inverts a job's key mapping: for each canonical key, the source
+// row keys that were merged into it, sorted.
+func KeyAliases(mapping map[string]string) map[string][]string {
+	aliases := make(map[string][]string)
+	for original, canonical := range mapping {
+		aliases[canonical] = append(aliases[canonical], original)
+	}
+	for _, keys := range aliases {
+		sort.Strings(keys)
+	}
+	return aliases
+}
+
+// FanOutResults copies each result to every source row key merged into it,
+// so exports carry a result for every original row. Each copy directly
+// follows the result it was made from.
+func FanOutResults(results []*EnrichmentResult, mapping map[string]string) []*EnrichmentResult {
+	if len(mapping) == 0 {
+		return results
+	}
+	aliases := KeyAliases(mapping)
+	out := make([]*EnrichmentResult, 0, len(results))
+	for _, r := range results {
+		out = append(out, r)
+		for _, key := range aliases[r.Key] {
+			alias := *r
+			alias.Key = key
+			out = append(out, &alias)
+		}
+	}
+	return out
+}
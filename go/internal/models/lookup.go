@@ -0,0 +1,22 @@
+package models
+
+// EntityLookup asks for a single entity to be enriched right away, outside
+// of any job.
+type EntityLookup struct {
+	UserID               string
+	Key                  string
+	KeyColumnDescription string
+	ColumnsMetadata      []*ColumnMetadata
+	Locale               string
+}
+
+// EntityLookupResult is what a lookup found. Error says why a lookup that
+// did not succeed failed.
+type EntityLookupResult struct {
+	Key           string                          `json:"key"`
+	ExtractedData map[string]interface{}          `json:"extracted_data"`
+	Confidence    map[string]*FieldConfidenceInfo `json:"confidence"`
+	Sources       []string                        `json:"sources"`
+	Success       bool                            `json:"success"`
+	Error         string                          `json:"error,omitempty"`
+}
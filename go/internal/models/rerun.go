@@ -0,0 +1,12 @@
+package models
+
+// RowRerun asks for some cells of a finished job to be enriched again.
+type RowRerun struct {
+	Job              *Job
+	UserID           string
+	StripeCustomerID string
+	// Columns maps each re-run row key to the names of its re-run columns.
+	Columns      map[string][]string
+	Instructions string
+	Merge        MergeMode
+}
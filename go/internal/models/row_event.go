@@ -0,0 +1,69 @@
+package models
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// RowEvent records a row of a job moving to a stage, with the row's values
+// at that point. The IDs of a job's events increase in commit order, so
+// clients can resume a stream after the last event they saw. They are the
+// Seq of the stored event.
+type RowEvent struct {
+	ID            int64                           `json:"id"`
+	JobID         string                          `json:"job_id"`
+	RowKey        string                          `json:"row_key"`
+	Stage         RowStage                        `json:"stage"`
+	ExtractedData map[string]interface{}          `json:"extracted_data,omitempty"`
+	Confidence    map[string]*FieldConfidenceInfo `json:"confidence,omitempty"`
+	Error         *string                         `json:"error,omitempty"`
+	CreatedAt     time.Time                       `json:"created_at"`
+}
+
+// RowEventDB is a stored row event. Seq orders a job's events by commit; it
+// is assigned after the event is committed, when the job's events are next
+// read, so events that are not yet readable have none.
+type RowEventDB struct {
+	bun.BaseModel `bun:"table:row_events,alias:re"`
+
+	ID            int64                           `bun:"id,pk,autoincrement" json:"id"`
+	Seq           *int64                          `bun:"seq" json:"seq,omitempty"`
+	JobID         string                          `bun:"job_id,notnull" json:"job_id"`
+	RowKey        string                          `bun:"row_key,notnull" json:"row_key"`
+	Stage         RowStage                        `bun:"stage,notnull" json:"stage"`
+	ExtractedData map[string]interface{}          `bun:"extracted_data,type:jsonb" json:"extracted_data,omitempty"`
+	Confidence    map[string]*FieldConfidenceInfo `bun:"confidence,type:jsonb" json:"confidence,omitempty"`
+	Error         *string                         `bun:"error" json:"error,omitempty"`
+	CreatedAt     time.Time                       `bun:"created_at,notnull,default:current_timestamp" json:"created_at"`
+}
+
+// NewRowEventDB captures the current state of a row as an event.
+func NewRowEventDB(jobID string, state *RowState) *RowEventDB {
+	return &RowEventDB{
+		JobID:         jobID,
+		RowKey:        state.Key,
+		Stage:         state.Stage,
+		ExtractedData: state.ExtractedData,
+		Confidence:    state.Confidence,
+		Error:         state.Error,
+		CreatedAt:     state.UpdatedAt,
+	}
+}
+
+func (e *RowEventDB) ToRowEvent() *RowEvent {
+	var id int64
+	if e.Seq != nil {
+		id = *e.Seq
+	}
+	return &RowEvent{
+		ID:            id,
+		JobID:         e.JobID,
+		RowKey:        e.RowKey,
+		Stage:         e.Stage,
+		ExtractedData: e.ExtractedData,
+		Confidence:    e.Confidence,
+		Error:         e.Error,
+		CreatedAt:     e.CreatedAt,
+	}
+}
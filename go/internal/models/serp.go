@@ -61,3 +61,41 @@ type GoogleSearchResults struct {
 	RelatedSearches  []RelatedSearch     `json:"relatedSearches,omitempty"`
 	Credits          *int                `json:"credits,omitempty"`
 }
+
+// SerpSnippet is the part of an organic search result that extraction reads,
+// so the full search response does not have to travel with it.
+type SerpSnippet struct {
+	Link    string `json:"link,omitempty"`
+	Title   string `json:"title,omitempty"`
+	Snippet string `json:"snippet,omitempty"`
+	Date    string `json:"date,omitempty"`
+}
+
+// Snippets returns the organic results of every search, in order.
+func (s *SerpData) Snippets() []SerpSnippet {
+	if s == nil {
+		return nil
+	}
+	var snippets []SerpSnippet
+	for _, results := range s.Results {
+		if results == nil {
+			continue
+		}
+		for _, organic := range results.Organic {
+			snippets = append(snippets, SerpSnippet{
+				Link:    deref(organic.Link),
+				Title:   deref(organic.Title),
+				Snippet: deref(organic.Snippet),
+				Date:    deref(organic.Date),
+			})
+		}
+	}
+	return snippets
+}
+
+func deref(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
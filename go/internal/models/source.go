@@ -12,7 +12,9 @@ import (
 type SourceType string
 
 const (
-	SourceTypeCSVUpload SourceType = "csv_upload"
+	SourceTypeCSVUpload  SourceType = "csv_upload"
+	SourceTypeXLSXUpload SourceType = "xlsx_upload"
+	SourceTypeSQLQuery   SourceType = "sql_query"
 )
 
 type SourceMetadata interface {
@@ -23,10 +25,178 @@ type CSVSourceMetadata struct {
 	FileURI     string `json:"file_uri"`
 	ContentType string `json:"content_type"`
 	Name        string `json:"name,omitempty"`
+	// Dialect is detected when a revision is confirmed or set by hand;
+	// without it every read detects the dialect of the file again.
+	Dialect *CSVDialect `json:"dialect,omitempty"`
+	FileRevisions
 }
 
 func (m *CSVSourceMetadata) SourceType() SourceType { return SourceTypeCSVUpload }
 
+// CSVDialect describes how a CSV file is parsed. Delimiter is a single
+// character and HeaderRow the 1-based record holding the column names.
+// Manual marks a dialect set by the user, which is kept for new revisions
+// instead of being detected again.
+type CSVDialect struct {
+	Delimiter string `json:"delimiter"`
+	Encoding  string `json:"encoding"`
+	BOM       bool   `json:"bom,omitempty"`
+	HeaderRow int    `json:"header_row"`
+	Manual    bool   `json:"manual,omitempty"`
+}
+
+// FileRevision is an earlier uploaded version of a file source and when a
+// newer upload replaced it.
+type FileRevision struct {
+	Revision    int       `json:"revision"`
+	FileURI     string    `json:"file_uri"`
+	ContentType string    `json:"content_type"`
+	ReplacedAt  time.Time `json:"replaced_at"`
+	// Dialect is the CSV dialect the revision was read with.
+	Dialect *CSVDialect `json:"dialect,omitempty"`
+}
+
+// PendingRevision is a requested upload that has not been confirmed yet.
+type PendingRevision struct {
+	Revision    int       `json:"revision"`
+	FileURI     string    `json:"file_uri"`
+	ContentType string    `json:"content_type"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// FileRevisions versions the object a file source reads. The source's
+// FileURI and ContentType always describe the current revision; History
+// keeps the earlier ones so later revisions can be diffed against them.
+// Revision zero is the original upload, counted as revision 1. Pending is
+// an upload that only becomes current once its object exists.
+type FileRevisions struct {
+	Revision int              `json:"revision,omitempty"`
+	History  []FileRevision   `json:"history,omitempty"`
+	Pending  *PendingRevision `json:"pending,omitempty"`
+}
+
+func (r *FileRevisions) CurrentRevision() int {
+	return max(r.Revision, 1)
+}
+
+// PreviousRevision looks up an earlier revision of the file.
+func (r *FileRevisions) PreviousRevision(revision int) (FileRevision, bool) {
+	for _, h := range r.History {
+		if h.Revision == revision {
+			return h, true
+		}
+	}
+	return FileRevision{}, false
+}
+
+// StageRevision records fileURI as the pending next revision. A revision
+// staged earlier and never confirmed is replaced, and its number is not
+// reused so a late confirmation of it cannot promote the newer file.
+func (r *FileRevisions) StageRevision(fileURI, contentType string, at time.Time) int {
+	next := r.CurrentRevision() + 1
+	if r.Pending != nil {
+		next = max(next, r.Pending.Revision+1)
+	}
+	r.Pending = &PendingRevision{Revision: next, FileURI: fileURI, ContentType: contentType, CreatedAt: at}
+	return next
+}
+
+// PendingRevision looks up the staged revision with the given number.
+func (r *FileRevisions) PendingRevision(revision int) (PendingRevision, bool) {
+	if r.Pending == nil || r.Pending.Revision != revision {
+		return PendingRevision{}, false
+	}
+	return *r.Pending, true
+}
+
+// RevisionedMetadata is implemented by sources that accept new revisions of
+// their file.
+type RevisionedMetadata interface {
+	SourceMetadata
+	CurrentRevision() int
+	PreviousRevision(revision int) (FileRevision, bool)
+	StageRevision(fileURI, contentType string, at time.Time) int
+	PendingRevision(revision int) (PendingRevision, bool)
+	// PromoteRevision makes the pending revision current and moves the
+	// previous one into the history. It reports false when the revision is
+	// not the pending one.
+	PromoteRevision(revision int, at time.Time) bool
+}
+
+// RevisionDialect returns the dialect a revision of the file is read with.
+func (m *CSVSourceMetadata) RevisionDialect(revision int) *CSVDialect {
+	if revision == m.CurrentRevision() {
+		return m.Dialect
+	}
+	previous, _ := m.PreviousRevision(revision)
+	return previous.Dialect
+}
+
+// PromoteRevision keeps the dialect of the replaced file with its revision.
+// A detected dialect is detected again for the new file.
+func (m *CSVSourceMetadata) PromoteRevision(revision int, at time.Time) bool {
+	if !m.FileRevisions.promote(revision, &m.FileURI, &m.ContentType, at) {
+		return false
+	}
+	m.History[len(m.History)-1].Dialect = m.Dialect
+	if m.Dialect != nil && !m.Dialect.Manual {
+		m.Dialect = nil
+	}
+	return true
+}
+
+func (m *XLSXSourceMetadata) PromoteRevision(revision int, at time.Time) bool {
+	return m.FileRevisions.promote(revision, &m.FileURI, &m.ContentType, at)
+}
+
+func (r *FileRevisions) promote(revision int, currentURI, currentType *string, at time.Time) bool {
+	pending, ok := r.PendingRevision(revision)
+	if !ok {
+		return false
+	}
+	r.History = append(r.History, FileRevision{
+		Revision:    r.CurrentRevision(),
+		FileURI:     *currentURI,
+		ContentType: *currentType,
+		ReplacedAt:  at,
+	})
+	r.Revision = pending.Revision
+	r.Pending = nil
+	*currentURI, *currentType = pending.FileURI, pending.ContentType
+	return true
+}
+
+// XLSXSourceMetadata describes an uploaded workbook. An empty SheetName reads
+// the first sheet; HeaderRow is 1-based and zero means the first row.
+type XLSXSourceMetadata struct {
+	FileURI     string `json:"file_uri"`
+	ContentType string `json:"content_type"`
+	Name        string `json:"name,omitempty"`
+	SheetName   string `json:"sheet_name,omitempty"`
+	HeaderRow   int    `json:"header_row,omitempty"`
+	FileRevisions
+}
+
+func (m *XLSXSourceMetadata) SourceType() SourceType { return SourceTypeXLSXUpload }
+
+// SQLSourceMetadata describes a source read from a database query. Connection
+// names a server-side connection, so credentials never reach the client or
+// the database row.
+type SQLSourceMetadata struct {
+	Connection string        `json:"connection"`
+	Query      string        `json:"query"`
+	Name       string        `json:"name,omitempty"`
+	WriteBack  *SQLWriteBack `json:"write_back,omitempty"`
+}
+
+func (m *SQLSourceMetadata) SourceType() SourceType { return SourceTypeSQLQuery }
+
+// SQLWriteBack is the table that enriched results of a query source are
+// upserted into, keyed on the job's key columns.
+type SQLWriteBack struct {
+	Table string `json:"table"`
+}
+
 type Source struct {
 	ID        uuid.UUID      `json:"id"`
 	UserID    string         `json:"user_id"`
@@ -55,6 +225,18 @@ func (s *SourceDB) ParseMetadata() (SourceMetadata, error) {
 			return nil, err
 		}
 		return &m, nil
+	case SourceTypeXLSXUpload:
+		var m XLSXSourceMetadata
+		if err := json.Unmarshal(s.Metadata, &m); err != nil {
+			return nil, err
+		}
+		return &m, nil
+	case SourceTypeSQLQuery:
+		var m SQLSourceMetadata
+		if err := json.Unmarshal(s.Metadata, &m); err != nil {
+			return nil, err
+		}
+		return &m, nil
 	default:
 		return nil, fmt.Errorf("unknown source type: %s", s.Type)
 	}
@@ -0,0 +1,145 @@
+package models
+
+import (
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/uptrace/bun"
+)
+
+type WebhookEventType string
+
+const (
+	WebhookJobStarted   WebhookEventType = "job.started"
+	WebhookRowFailed    WebhookEventType = "row.failed"
+	WebhookJobCompleted WebhookEventType = "job.completed"
+	WebhookJobCancelled WebhookEventType = "job.cancelled"
+)
+
+// WebhookEventTypes lists every event a webhook endpoint can subscribe to.
+var WebhookEventTypes = []WebhookEventType{
+	WebhookJobStarted,
+	WebhookRowFailed,
+	WebhookJobCompleted,
+	WebhookJobCancelled,
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryRetrying  WebhookDeliveryStatus = "RETRYING"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "DELIVERED"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "FAILED"
+)
+
+// JobOutcome counts how the rows of a job ended. It is sent with job events.
+type JobOutcome struct {
+	TotalRows      int        `json:"total_rows"`
+	SuccessfulRows int        `json:"successful_rows"`
+	FailedRows     int        `json:"failed_rows"`
+	CompletedAt    *time.Time `json:"completed_at,omitempty"`
+}
+
+// WebhookEvent is the JSON body posted to webhook endpoints. Job events
+// carry an Outcome, row events the RowKey and Error of the row.
+type WebhookEvent struct {
+	ID        string           `json:"id"`
+	Type      WebhookEventType `json:"type"`
+	JobID     string           `json:"job_id"`
+	CreatedAt time.Time        `json:"created_at"`
+	Outcome   *JobOutcome      `json:"outcome,omitempty"`
+	RowKey    string           `json:"row_key,omitempty"`
+	Error     string           `json:"error,omitempty"`
+}
+
+// WebhookEndpoint is a URL a user has asked to receive events at. Secret
+// signs every delivery and is only shown when the endpoint is created.
+type WebhookEndpoint struct {
+	ID        uuid.UUID          `json:"id"`
+	UserID    string             `json:"user_id"`
+	URL       string             `json:"url"`
+	Events    []WebhookEventType `json:"events"`
+	Secret    string             `json:"-"`
+	CreatedAt time.Time          `json:"created_at"`
+}
+
+// Subscribes reports whether the endpoint receives events of the type.
+func (e *WebhookEndpoint) Subscribes(event WebhookEventType) bool {
+	return slices.Contains(e.Events, event)
+}
+
+type WebhookEndpointDB struct {
+	bun.BaseModel `bun:"table:webhook_endpoints,alias:we"`
+
+	ID        uuid.UUID          `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	UserID    string             `bun:"user_id,notnull" json:"user_id"`
+	URL       string             `bun:"url,notnull" json:"url"`
+	Events    []WebhookEventType `bun:"events,type:jsonb,notnull" json:"events"`
+	Secret    string             `bun:"secret,notnull" json:"-"`
+	CreatedAt time.Time          `bun:"created_at,notnull,default:current_timestamp" json:"created_at"`
+}
+
+func (e *WebhookEndpointDB) ToWebhookEndpoint() *WebhookEndpoint {
+	return &WebhookEndpoint{
+		ID:        e.ID,
+		UserID:    e.UserID,
+		URL:       e.URL,
+		Events:    e.Events,
+		Secret:    e.Secret,
+		CreatedAt: e.CreatedAt,
+	}
+}
+
+// WebhookDelivery is the log entry of sending one event to one endpoint.
+// ResponseStatus and Error describe the latest attempt.
+type WebhookDelivery struct {
+	ID             uuid.UUID             `json:"id"`
+	EndpointID     uuid.UUID             `json:"endpoint_id"`
+	EventID        string                `json:"event_id"`
+	EventType      WebhookEventType      `json:"event_type"`
+	JobID          string                `json:"job_id"`
+	Payload        json.RawMessage       `json:"payload"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	Attempts       int                   `json:"attempts"`
+	ResponseStatus *int                  `json:"response_status,omitempty"`
+	Error          *string               `json:"error,omitempty"`
+	CreatedAt      time.Time             `json:"created_at"`
+	DeliveredAt    *time.Time            `json:"delivered_at,omitempty"`
+}
+
+type WebhookDeliveryDB struct {
+	bun.BaseModel `bun:"table:webhook_deliveries,alias:wd"`
+
+	ID             uuid.UUID             `bun:"id,pk,type:uuid,default:gen_random_uuid()" json:"id"`
+	EndpointID     uuid.UUID             `bun:"endpoint_id,type:uuid,notnull" json:"endpoint_id"`
+	EventID        string                `bun:"event_id,notnull" json:"event_id"`
+	EventType      WebhookEventType      `bun:"event_type,notnull" json:"event_type"`
+	JobID          string                `bun:"job_id,notnull" json:"job_id"`
+	Payload        json.RawMessage       `bun:"payload,type:jsonb,notnull" json:"payload"`
+	Status         WebhookDeliveryStatus `bun:"status,notnull,default:'PENDING'" json:"status"`
+	Attempts       int                   `bun:"attempts,notnull,default:0" json:"attempts"`
+	ResponseStatus *int                  `bun:"response_status" json:"response_status"`
+	Error          *string               `bun:"error" json:"error"`
+	CreatedAt      time.Time             `bun:"created_at,notnull,default:current_timestamp" json:"created_at"`
+	DeliveredAt    *time.Time            `bun:"delivered_at" json:"delivered_at"`
+}
+
+func (d *WebhookDeliveryDB) ToWebhookDelivery() *WebhookDelivery {
+	return &WebhookDelivery{
+		ID:             d.ID,
+		EndpointID:     d.EndpointID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		JobID:          d.JobID,
+		Payload:        d.Payload,
+		Status:         d.Status,
+		Attempts:       d.Attempts,
+		ResponseStatus: d.ResponseStatus,
+		Error:          d.Error,
+		CreatedAt:      d.CreatedAt,
+		DeliveredAt:    d.DeliveredAt,
+	}
+}
@@ -0,0 +1,402 @@
+// Package parquet writes Apache Parquet files. It covers what exports need:
+// flat schemas of optional and repeated primitive columns, plain encoded and
+// uncompressed, with one data page per column chunk.
+package parquet
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// Type is a physical Parquet type.
+type Type int32
+
+const (
+	Boolean   Type = 0
+	Int32     Type = 1
+	Double    Type = 5
+	ByteArray Type = 6
+)
+
+// ConvertedType annotates how a physical type is read.
+type ConvertedType int32
+
+const (
+	NoConversion ConvertedType = -1
+	UTF8         ConvertedType = 0
+	// Date is an Int32 count of days since the Unix epoch.
+	Date ConvertedType = 6
+)
+
+// Column is a top-level field of a file. Columns are optional, or repeated
+// when Repeated is set, in which case rows hold a []interface{} of elements.
+type Column struct {
+	Name      string
+	Type      Type
+	Converted ConvertedType
+	Repeated  bool
+}
+
+const (
+	magic = "PAR1"
+	// rowGroupSize is how many buffered value bytes start a new row group.
+	// Row groups are held in memory until written, so this bounds the memory
+	// an export takes.
+	rowGroupSize = 8 << 20
+	createdBy    = "ampledata"
+)
+
+// Thrift enum values from parquet.thrift.
+const (
+	encodingPlain      = 0
+	encodingRLE        = 3
+	codecUncompressed  = 0
+	pageTypeData       = 0
+	repetitionOptional = 1
+	repetitionRepeated = 2
+	fileFormatVersion  = 1
+)
+
+// levelHeaderLength is the size of the length prefix of encoded levels.
+const levelHeaderLength = 4
+
+// Writer writes rows to a Parquet file. Rows are buffered into row groups;
+// Close writes the last one and the footer.
+type Writer struct {
+	w         *countingWriter
+	columns   []*columnBuffer
+	rowGroups []rowGroup
+	rows      int64
+	numRows   int64
+	started   bool
+}
+
+type rowGroup struct {
+	chunks    []columnChunk
+	numRows   int64
+	totalSize int64
+}
+
+type columnChunk struct {
+	offset    int64
+	size      int64
+	numValues int64
+}
+
+// NewWriter starts a file with the given columns. Names must be unique.
+func NewWriter(w io.Writer, columns []Column) (*Writer, error) {
+	seen := make(map[string]bool, len(columns))
+	buffers := make([]*columnBuffer, len(columns))
+	for i, col := range columns {
+		if col.Name == "" || seen[col.Name] {
+			return nil, fmt.Errorf("parquet: column name %q is empty or repeated", col.Name)
+		}
+		seen[col.Name] = true
+		buffers[i] = &columnBuffer{Column: col}
+	}
+	return &Writer{w: &countingWriter{w: w}, columns: buffers}, nil
+}
+
+// Write adds a row, one value per column: a bool, int32, float64, or string
+// or []byte for ByteArray, and nil for a null. After an error the file is
+// unusable.
+func (w *Writer) Write(row []interface{}) error {
+	if len(row) != len(w.columns) {
+		return fmt.Errorf("parquet: row has %d values, want %d", len(row), len(w.columns))
+	}
+	for i, col := range w.columns {
+		if err := col.add(row[i]); err != nil {
+			return err
+		}
+	}
+	w.rows++
+	buffered := 0
+	for _, col := range w.columns {
+		buffered += len(col.values) + len(col.bools) + len(col.defLevels) + len(col.repLevels)
+	}
+	if buffered >= rowGroupSize {
+		return w.flushRowGroup()
+	}
+	return nil
+}
+
+func (w *Writer) start() error {
+	if w.started {
+		return nil
+	}
+	w.started = true
+	_, err := io.WriteString(w.w, magic)
+	return err
+}
+
+func (w *Writer) flushRowGroup() error {
+	if err := w.start(); err != nil {
+		return err
+	}
+	if w.rows == 0 {
+		return nil
+	}
+	group := rowGroup{numRows: w.rows}
+	for _, col := range w.columns {
+		offset := w.w.n
+		if err := col.writePage(w.w); err != nil {
+			return err
+		}
+		chunk := columnChunk{offset: offset, size: w.w.n - offset, numValues: int64(len(col.defLevels))}
+		group.chunks = append(group.chunks, chunk)
+		group.totalSize += chunk.size
+		col.reset()
+	}
+	w.rowGroups = append(w.rowGroups, group)
+	w.numRows += w.rows
+	w.rows = 0
+	return nil
+}
+
+// Close writes buffered rows and the file footer. It does not close the
+// underlying writer.
+func (w *Writer) Close() error {
+	if err := w.flushRowGroup(); err != nil {
+		return err
+	}
+	footer := w.footer()
+	footer = binary.LittleEndian.AppendUint32(footer, uint32(len(footer)))
+	footer = append(footer, magic...)
+	_, err := w.w.Write(footer)
+	return err
+}
+
+func (w *Writer) footer() []byte {
+	t := &thriftWriter{}
+	t.structBegin()
+	t.i32(1, fileFormatVersion)
+
+	t.listHeader(2, thriftStruct, len(w.columns)+1)
+	t.structBegin()
+	t.string(4, "schema")
+	t.i32(5, int32(len(w.columns)))
+	t.structEnd()
+	for _, col := range w.columns {
+		t.structBegin()
+		t.i32(1, int32(col.Type))
+		t.i32(3, col.repetition())
+		t.string(4, col.Name)
+		if col.Converted != NoConversion {
+			t.i32(6, int32(col.Converted))
+		}
+		t.structEnd()
+	}
+
+	t.i64(3, w.numRows)
+	t.listHeader(4, thriftStruct, len(w.rowGroups))
+	for _, group := range w.rowGroups {
+		t.structBegin()
+		t.listHeader(1, thriftStruct, len(group.chunks))
+		for i, chunk := range group.chunks {
+			col := w.columns[i]
+			t.structBegin()
+			t.i64(2, chunk.offset)
+			t.structField(3)
+			t.i32(1, int32(col.Type))
+			t.i32List(2, []int32{encodingPlain, encodingRLE})
+			t.stringList(3, []string{col.Name})
+			t.i32(4, codecUncompressed)
+			t.i64(5, chunk.numValues)
+			t.i64(6, chunk.size)
+			t.i64(7, chunk.size)
+			t.i64(9, chunk.offset)
+			t.structEnd()
+			t.structEnd()
+		}
+		t.i64(2, group.totalSize)
+		t.i64(3, group.numRows)
+		t.structEnd()
+	}
+	t.string(6, createdBy)
+	t.structEnd()
+	return t.buf
+}
+
+// columnBuffer holds the levels and plain encoded values of a column for
+// the current row group.
+type columnBuffer struct {
+	Column
+	defLevels []byte
+	repLevels []byte
+	values    []byte
+	bools     []bool
+}
+
+func (c *columnBuffer) repetition() int32 {
+	if c.Repeated {
+		return repetitionRepeated
+	}
+	return repetitionOptional
+}
+
+func (c *columnBuffer) add(v interface{}) error {
+	if !c.Repeated {
+		return c.addValue(v, 0)
+	}
+	if v == nil {
+		return c.addValue(nil, 0)
+	}
+	elems, ok := v.([]interface{})
+	if !ok {
+		return fmt.Errorf("parquet: column %q is repeated, got %T", c.Name, v)
+	}
+	if len(elems) == 0 {
+		return c.addValue(nil, 0)
+	}
+	for i, elem := range elems {
+		if elem == nil {
+			return fmt.Errorf("parquet: column %q has a null list element", c.Name)
+		}
+		rep := byte(1)
+		if i == 0 {
+			rep = 0
+		}
+		if err := c.addValue(elem, rep); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *columnBuffer) addValue(v interface{}, rep byte) error {
+	if c.Repeated {
+		c.repLevels = append(c.repLevels, rep)
+	}
+	if v == nil {
+		c.defLevels = append(c.defLevels, 0)
+		return nil
+	}
+	switch c.Type {
+	case Boolean:
+		b, ok := v.(bool)
+		if !ok {
+			return c.typeError(v)
+		}
+		c.bools = append(c.bools, b)
+	case Int32:
+		n, ok := v.(int32)
+		if !ok {
+			return c.typeError(v)
+		}
+		c.values = binary.LittleEndian.AppendUint32(c.values, uint32(n))
+	case Double:
+		f, ok := v.(float64)
+		if !ok {
+			return c.typeError(v)
+		}
+		c.values = binary.LittleEndian.AppendUint64(c.values, math.Float64bits(f))
+	case ByteArray:
+		var b []byte
+		switch s := v.(type) {
+		case string:
+			b = []byte(s)
+		case []byte:
+			b = s
+		default:
+			return c.typeError(v)
+		}
+		c.values = binary.LittleEndian.AppendUint32(c.values, uint32(len(b)))
+		c.values = append(c.values, b...)
+	default:
+		return fmt.Errorf("parquet: column %q has unsupported type %d", c.Name, c.Type)
+	}
+	c.defLevels = append(c.defLevels, 1)
+	return nil
+}
+
+func (c *columnBuffer) typeError(v interface{}) error {
+	return fmt.Errorf("parquet: column %q cannot hold %T", c.Name, v)
+}
+
+// writePage writes the buffered column as a single data page: repetition
+// levels, definition levels, then the non-null values.
+func (c *columnBuffer) writePage(w io.Writer) error {
+	var body []byte
+	if c.Repeated {
+		body = appendLevels(body, c.repLevels)
+	}
+	body = appendLevels(body, c.defLevels)
+	if c.Type == Boolean {
+		body = appendBitPacked(body, c.bools)
+	} else {
+		body = append(body, c.values...)
+	}
+	if len(body) > math.MaxInt32 {
+		return errors.New("parquet: column chunk exceeds the page size limit")
+	}
+
+	t := &thriftWriter{}
+	t.structBegin()
+	t.i32(1, pageTypeData)
+	t.i32(2, int32(len(body)))
+	t.i32(3, int32(len(body)))
+	t.structField(5)
+	t.i32(1, int32(len(c.defLevels)))
+	t.i32(2, encodingPlain)
+	t.i32(3, encodingRLE)
+	t.i32(4, encodingRLE)
+	t.structEnd()
+	t.structEnd()
+
+	if _, err := w.Write(t.buf); err != nil {
+		return err
+	}
+	_, err := w.Write(body)
+	return err
+}
+
+func (c *columnBuffer) reset() {
+	c.defLevels = c.defLevels[:0]
+	c.repLevels = c.repLevels[:0]
+	c.values = c.values[:0]
+	c.bools = c.bools[:0]
+}
+
+// appendLevels encodes levels of at most 1 as RLE runs, prefixed by their
+// byte length as data page v1 requires. The bit width of 1 stores each run
+// value in one byte.
+func appendLevels(buf []byte, levels []byte) []byte {
+	start := len(buf)
+	buf = append(buf, make([]byte, levelHeaderLength)...)
+	for i := 0; i < len(levels); {
+		j := i + 1
+		for j < len(levels) && levels[j] == levels[i] {
+			j++
+		}
+		buf = binary.AppendUvarint(buf, uint64(j-i)<<1)
+		buf = append(buf, levels[i])
+		i = j
+	}
+	binary.LittleEndian.PutUint32(buf[start:], uint32(len(buf)-start-levelHeaderLength))
+	return buf
+}
+
+// appendBitPacked encodes booleans one bit each, least significant first.
+func appendBitPacked(buf []byte, bools []bool) []byte {
+	packed := make([]byte, (len(bools)+7)/8)
+	for i, b := range bools {
+		if b {
+			packed[i/8] |= 1 << (i % 8)
+		}
+	}
+	return append(buf, packed...)
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
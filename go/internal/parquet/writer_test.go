@@ -0,0 +1,337 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"flag"
+	"math"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the testdata fixtures")
+
+// thriftReader decodes Thrift compact structs into maps of field id to
+// value, enough to check what the writer produced.
+type thriftReader struct {
+	buf []byte
+	pos int
+}
+
+type thriftStructValue map[int16]interface{}
+
+func (r *thriftReader) byte() byte {
+	b := r.buf[r.pos]
+	r.pos++
+	return b
+}
+
+func (r *thriftReader) uvarint() uint64 {
+	v, n := binary.Uvarint(r.buf[r.pos:])
+	r.pos += n
+	return v
+}
+
+func (r *thriftReader) zigzag() int64 {
+	v := r.uvarint()
+	return int64(v>>1) ^ -int64(v&1)
+}
+
+func (r *thriftReader) readStruct() thriftStructValue {
+	fields := thriftStructValue{}
+	var last int16
+	for {
+		b := r.byte()
+		if b == 0 {
+			return fields
+		}
+		id := last + int16(b>>4)
+		if b>>4 == 0 {
+			id = int16(r.zigzag())
+		}
+		last = id
+		fields[id] = r.readValue(b & 0x0f)
+	}
+}
+
+func (r *thriftReader) readValue(typ byte) interface{} {
+	switch typ {
+	case thriftI32, thriftI64:
+		return r.zigzag()
+	case thriftBinary:
+		n := int(r.uvarint())
+		s := string(r.buf[r.pos : r.pos+n])
+		r.pos += n
+		return s
+	case thriftList:
+		h := r.byte()
+		size := int(h >> 4)
+		if size == 15 {
+			size = int(r.uvarint())
+		}
+		list := make([]interface{}, size)
+		for i := range list {
+			list[i] = r.readValue(h & 0x0f)
+		}
+		return list
+	case thriftStruct:
+		return r.readStruct()
+	default:
+		panic("unexpected thrift type")
+	}
+}
+
+func readLevels(buf []byte, pos int, count int) ([]byte, int) {
+	length := int(binary.LittleEndian.Uint32(buf[pos:]))
+	r := &thriftReader{buf: buf[pos+levelHeaderLength : pos+levelHeaderLength+length]}
+	var levels []byte
+	for len(levels) < count {
+		run := int(r.uvarint() >> 1)
+		level := r.byte()
+		for range run {
+			levels = append(levels, level)
+		}
+	}
+	return levels, pos + levelHeaderLength + length
+}
+
+// readFile decodes the rows of a file written by Writer.
+func readFile(t *testing.T, data []byte) ([]thriftStructValue, [][]interface{}) {
+	t.Helper()
+	if string(data[:4]) != magic || string(data[len(data)-4:]) != magic {
+		t.Fatal("missing magic")
+	}
+	footerLen := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	meta := (&thriftReader{buf: data[len(data)-8-footerLen : len(data)-8]}).readStruct()
+	schema := meta[2].([]interface{})
+	columns := make([]thriftStructValue, len(schema)-1)
+	for i := range columns {
+		columns[i] = schema[i+1].(thriftStructValue)
+	}
+
+	var rows [][]interface{}
+	for _, g := range meta[4].([]interface{}) {
+		group := g.(thriftStructValue)
+		numRows := int(group[3].(int64))
+		groupRows := make([][]interface{}, numRows)
+		for i := range groupRows {
+			groupRows[i] = make([]interface{}, len(columns))
+		}
+		for c, ch := range group[1].([]interface{}) {
+			colMeta := ch.(thriftStructValue)[3].(thriftStructValue)
+			r := &thriftReader{buf: data, pos: int(colMeta[9].(int64))}
+			header := r.readStruct()
+			numValues := int(header[5].(thriftStructValue)[1].(int64))
+			body := data[r.pos : r.pos+int(header[3].(int64))]
+			repeated := columns[c][3].(int64) == repetitionRepeated
+
+			var reps []byte
+			pos := 0
+			if repeated {
+				reps, pos = readLevels(body, pos, numValues)
+			} else {
+				reps = make([]byte, numValues)
+			}
+			defs, pos := readLevels(body, pos, numValues)
+
+			row, boolIdx := -1, 0
+			for i := range numValues {
+				if reps[i] == 0 {
+					row++
+				}
+				if defs[i] == 0 {
+					continue
+				}
+				var v interface{}
+				switch Type(colMeta[1].(int64)) {
+				case Boolean:
+					v = body[pos+boolIdx/8]&(1<<(boolIdx%8)) != 0
+					boolIdx++
+				case Int32:
+					v = int32(binary.LittleEndian.Uint32(body[pos:]))
+					pos += 4
+				case Double:
+					v = math.Float64frombits(binary.LittleEndian.Uint64(body[pos:]))
+					pos += 8
+				case ByteArray:
+					n := int(binary.LittleEndian.Uint32(body[pos:]))
+					v = string(body[pos+4 : pos+4+n])
+					pos += 4 + n
+				}
+				if repeated {
+					list, _ := groupRows[row][c].([]interface{})
+					groupRows[row][c] = append(list, v)
+				} else {
+					groupRows[row][c] = v
+				}
+			}
+		}
+		rows = append(rows, groupRows...)
+	}
+	if int(meta[3].(int64)) != len(rows) {
+		t.Errorf("num_rows = %d, decoded %d rows", meta[3], len(rows))
+	}
+	return columns, rows
+}
+
+func TestWriter(t *testing.T) {
+	columns := []Column{
+		{Name: "name", Type: ByteArray, Converted: UTF8},
+		{Name: "revenue", Type: Double, Converted: NoConversion},
+		{Name: "public", Type: Boolean, Converted: NoConversion},
+		{Name: "founded", Type: Int32, Converted: Date},
+		{Name: "tags", Type: ByteArray, Converted: UTF8, Repeated: true},
+	}
+	rows := [][]interface{}{
+		{"Acme", 1.5e6, true, int32(3653), []interface{}{"b2b", "saas"}},
+		{"Globex", nil, false, nil, nil},
+		{nil, -2.25, nil, int32(-1), []interface{}{"retail"}},
+		{"Initech", 0.0, true, int32(0), []interface{}{}},
+	}
+
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, columns)
+	if err != nil {
+		t.Fatalf("NewWriter() error: %v", err)
+	}
+	for _, row := range rows {
+		if err := w.Write(row); err != nil {
+			t.Fatalf("Write() error: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error: %v", err)
+	}
+
+	schema, got := readFile(t, buf.Bytes())
+	for i, col := range columns {
+		el := schema[i]
+		if el[4] != col.Name || Type(el[1].(int64)) != col.Type {
+			t.Errorf("schema[%d] = %v, want %+v", i, el, col)
+		}
+		converted, ok := el[6].(int64)
+		if ok != (col.Converted != NoConversion) || ok && ConvertedType(converted) != col.Converted {
+			t.Errorf("schema[%d] converted type = %v, want %d", i, el[6], col.Converted)
+		}
+	}
+	// Empty lists read back as nulls.
+	rows[3][4] = nil
+	if !reflect.DeepEqual(got, rows) {
+		t.Errorf("rows = %v, want %v", got, rows)
+	}
+}
+
+// TestWriter_Fixture compares the writer's output with
+// testdata/dates.parquet, a file checked with a Parquet reader independent
+// of this package. It covers dates on both sides of the epoch. Run with
+// -update after intended format changes and check the file again.
+func TestWriter_Fixture(t *testing.T) {
+	columns := []Column{
+		{Name: "name", Type: ByteArray, Converted: UTF8},
+		{Name: "founded", Type: Int32, Converted: Date},
+		{Name: "revenue", Type: Double, Converted: NoConversion},
+		{Name: "public", Type: Boolean, Converted: NoConversion},
+		{Name: "tags", Type: ByteArray, Converted: UTF8, Repeated: true},
+	}
+	// Days since 1970-01-01: 1900-01-01, 1969-12-31, 1970-01-01, 2024-02-29.
+	rows := [][]interface{}{
+		{"Old Co", int32(-25567), 12.5, true, []interface{}{"a", "b"}},
+		{"Eve", int32(-1), nil, false, nil},
+		{"Epoch", int32(0), -3.0, nil, []interface{}{"c"}},
+		{nil, int32(19782), 0.0, true, []interface{}{}},
+	}
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, columns)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, row := range rows {
+		if err := w.Write(row); err != nil {
+			t.Fatalf("Write() error: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error: %v", err)
+	}
+
+	const fixture = "testdata/dates.parquet"
+	if *update {
+		if err := os.WriteFile(fixture, buf.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(buf.Bytes(), want) {
+		t.Errorf("output differs from %s", fixture)
+	}
+	_, got := readFile(t, want)
+	rows[3][4] = nil
+	if !reflect.DeepEqual(got, rows) {
+		t.Errorf("fixture rows = %v, want %v", got, rows)
+	}
+}
+
+func TestWriter_RowGroups(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, []Column{{Name: "text", Type: ByteArray, Converted: UTF8}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	value := strings.Repeat("x", 1<<10)
+	rows := 2*rowGroupSize/len(value) + 1
+	for range rows {
+		if err := w.Write([]interface{}{value}); err != nil {
+			t.Fatalf("Write() error: %v", err)
+		}
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error: %v", err)
+	}
+	if len(w.rowGroups) < 3 {
+		t.Errorf("wrote %d row groups, want at least 3", len(w.rowGroups))
+	}
+	if _, got := readFile(t, buf.Bytes()); len(got) != rows {
+		t.Errorf("read %d rows, want %d", len(got), rows)
+	}
+}
+
+func TestWriter_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, []Column{{Name: "name", Type: ByteArray, Converted: UTF8}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close() error: %v", err)
+	}
+	if _, rows := readFile(t, buf.Bytes()); len(rows) != 0 {
+		t.Errorf("rows = %v, want none", rows)
+	}
+}
+
+func TestWriter_Errors(t *testing.T) {
+	if _, err := NewWriter(&bytes.Buffer{}, []Column{{Name: "a"}, {Name: "a"}}); err == nil {
+		t.Error("NewWriter() accepted repeated column names")
+	}
+	w, err := NewWriter(&bytes.Buffer{}, []Column{{Name: "n", Type: Double, Converted: NoConversion}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		row  []interface{}
+	}{
+		{"wrong width", []interface{}{1.0, 2.0}},
+		{"wrong type", []interface{}{"1"}},
+	}
+	for _, tt := range tests {
+		if err := w.Write(tt.row); err == nil {
+			t.Errorf("%s: Write() accepted %v", tt.name, tt.row)
+		}
+	}
+}
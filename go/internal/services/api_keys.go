@@ -0,0 +1,163 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/state"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrAPIKeyNotFound = errors.New("API key not found")
+	// ErrAPIKeyInvalid is returned for unknown, revoked and expired keys
+	// alike, so callers learn nothing about keys they do not hold.
+	ErrAPIKeyInvalid = errors.New("invalid API key")
+)
+
+// apiKeyPrefixLength is how much of a key is kept in clear to identify it.
+const apiKeyPrefixLength = len(models.APIKeyPrefix) + 8
+
+// apiKeyTouchInterval limits how often the last use of a key is written,
+// so scripts polling the API do not update it on every request.
+const apiKeyTouchInterval = time.Minute
+
+const maxAPIKeyNameLength = 255
+
+type apiKeyUserGetter interface {
+	GetByID(ctx context.Context, userID string) (*models.User, error)
+}
+
+// APIKeyService manages the API keys of users and authenticates requests
+// made with them.
+type APIKeyService struct {
+	store state.Store
+	users apiKeyUserGetter
+}
+
+func NewAPIKeyService(store state.Store, users apiKeyUserGetter) *APIKeyService {
+	return &APIKeyService{store: store, users: users}
+}
+
+// CreateKey issues a key with the given scopes, read-only when none are
+// given. A nil expiresAt makes a key that does not expire. The key itself
+// is returned only here; just its hash is stored.
+func (s *APIKeyService) CreateKey(ctx context.Context, userID, name string, scopes []models.APIKeyScope, expiresAt *time.Time) (*models.APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, "", newValidationError("name is required")
+	}
+	if len(name) > maxAPIKeyNameLength {
+		return nil, "", newValidationError(fmt.Sprintf("name must be at most %d characters", maxAPIKeyNameLength))
+	}
+	scopes, err := resolveAPIKeyScopes(scopes)
+	if err != nil {
+		return nil, "", err
+	}
+	now := time.Now()
+	if expiresAt != nil && !expiresAt.After(now) {
+		return nil, "", newValidationError("expires_at must be in the future")
+	}
+	key, err := newAPIKey()
+	if err != nil {
+		return nil, "", err
+	}
+	keyDB := &models.APIKeyDB{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      name,
+		Prefix:    key[:apiKeyPrefixLength],
+		KeyHash:   hashAPIKey(key),
+		Scopes:    scopes,
+		ExpiresAt: expiresAt,
+		CreatedAt: now,
+	}
+	if err := s.store.CreateAPIKey(ctx, keyDB); err != nil {
+		return nil, "", err
+	}
+	return keyDB.ToAPIKey(), key, nil
+}
+
+// ListKeys returns the user's keys, revoked ones included, newest first.
+func (s *APIKeyService) ListKeys(ctx context.Context, userID string) ([]*models.APIKey, error) {
+	return s.store.GetAPIKeysByUser(ctx, userID)
+}
+
+// RevokeKey stops a key of the user from authenticating.
+func (s *APIKeyService) RevokeKey(ctx context.Context, keyID uuid.UUID, userID string) error {
+	key, err := s.store.GetAPIKey(ctx, keyID)
+	if err != nil {
+		return ErrAPIKeyNotFound
+	}
+	if key.UserID != userID {
+		return ErrJobForbidden
+	}
+	return s.store.RevokeAPIKey(ctx, keyID, time.Now())
+}
+
+// AuthenticateAPIKey returns an active key and the user it belongs to.
+func (s *APIKeyService) AuthenticateAPIKey(ctx context.Context, key string) (*models.APIKey, *models.User, error) {
+	if !strings.HasPrefix(key, models.APIKeyPrefix) {
+		return nil, nil, ErrAPIKeyInvalid
+	}
+	apiKey, err := s.store.GetAPIKeyByHash(ctx, hashAPIKey(key))
+	if err != nil {
+		return nil, nil, ErrAPIKeyInvalid
+	}
+	now := time.Now()
+	if !apiKey.Active(now) {
+		return nil, nil, ErrAPIKeyInvalid
+	}
+	u, err := s.users.GetByID(ctx, apiKey.UserID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get API key owner: %w", err)
+	}
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) > apiKeyTouchInterval {
+		if err := s.store.TouchAPIKey(ctx, apiKey.ID, now); err != nil {
+			log.Printf("Failed to record use of API key %s: %v", apiKey.ID, err)
+		}
+	}
+	return apiKey, u, nil
+}
+
+// hashAPIKey hashes a key for storage. Keys carry 256 random bits, so an
+// unsalted SHA-256 is enough and lets keys be looked up by their hash.
+func hashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// resolveAPIKeyScopes deduplicates the scopes of a key, defaulting to
+// read-only.
+func resolveAPIKeyScopes(scopes []models.APIKeyScope) ([]models.APIKeyScope, error) {
+	if len(scopes) == 0 {
+		return []models.APIKeyScope{models.APIKeyScopeReadOnly}, nil
+	}
+	var resolved []models.APIKeyScope
+	for _, scope := range scopes {
+		if !slices.Contains(models.APIKeyScopes, scope) {
+			return nil, newValidationError(fmt.Sprintf("unknown API key scope %q", scope))
+		}
+		if !slices.Contains(resolved, scope) {
+			resolved = append(resolved, scope)
+		}
+	}
+	return resolved, nil
+}
+
+func newAPIKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+	return models.APIKeyPrefix + hex.EncodeToString(b), nil
+}
@@ -0,0 +1,70 @@
+package services
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+func TestResolveAPIKeyScopes(t *testing.T) {
+	tests := []struct {
+		name    string
+		scopes  []models.APIKeyScope
+		want    []models.APIKeyScope
+		wantErr bool
+	}{
+		{"defaults to read-only", nil, []models.APIKeyScope{models.APIKeyScopeReadOnly}, false},
+		{"deduplicates", []models.APIKeyScope{"enrich", "read-only", "enrich"}, []models.APIKeyScope{models.APIKeyScopeEnrich, models.APIKeyScopeReadOnly}, false},
+		{"rejects unknown scope", []models.APIKeyScope{"admin"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveAPIKeyScopes(tt.scopes)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveAPIKeyScopes() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolveAPIKeyScopes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIKeyActive(t *testing.T) {
+	now := time.Now()
+	past, future := now.Add(-time.Hour), now.Add(time.Hour)
+	tests := []struct {
+		name string
+		key  models.APIKey
+		want bool
+	}{
+		{"no expiry", models.APIKey{}, true},
+		{"not yet expired", models.APIKey{ExpiresAt: &future}, true},
+		{"expired", models.APIKey{ExpiresAt: &past}, false},
+		{"revoked", models.APIKey{RevokedAt: &past}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.key.Active(now); got != tt.want {
+				t.Errorf("Active() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewAPIKey(t *testing.T) {
+	a, err := newAPIKey()
+	if err != nil {
+		t.Fatalf("newAPIKey() error = %v", err)
+	}
+	b, _ := newAPIKey()
+	if !strings.HasPrefix(a, models.APIKeyPrefix) || a == b {
+		t.Errorf("newAPIKey() = %q, %q, want distinct keys starting with %q", a, b, models.APIKeyPrefix)
+	}
+	if hashAPIKey(a) == hashAPIKey(b) || hashAPIKey(a) != hashAPIKey(a) {
+		t.Error("hashAPIKey() is not a stable per-key hash")
+	}
+}
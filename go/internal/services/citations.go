@@ -0,0 +1,119 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+// CrawledPage is the content of one fetched page.
+type CrawledPage struct {
+	URL     string `json:"url"`
+	Content string `json:"content"`
+}
+
+// FormatCrawledPages joins pages into one document for the extraction
+// prompt. Each page is wrapped in a <page> element naming its URL, so
+// extracted values can cite the page they came from.
+func FormatCrawledPages(pages []CrawledPage) string {
+	parts := make([]string, len(pages))
+	for i, p := range pages {
+		parts[i] = fmt.Sprintf("<page url=%q>\n%s\n</page>", p.URL, p.Content)
+	}
+	return strings.Join(parts, "\n\n")
+}
+
+var crawledPagePattern = regexp.MustCompile(`(?s)<page url="([^"]*)">\n(.*?)\n</page>`)
+
+// ParseCrawledPages splits content written by FormatCrawledPages back into
+// its pages. Content without page elements is a single page with no URL.
+func ParseCrawledPages(content string) []CrawledPage {
+	matches := crawledPagePattern.FindAllStringSubmatch(content, -1)
+	if len(matches) == 0 {
+		if strings.TrimSpace(content) == "" {
+			return nil
+		}
+		return []CrawledPage{{Content: content}}
+	}
+	pages := make([]CrawledPage, len(matches))
+	for i, m := range matches {
+		pages[i] = CrawledPage{URL: m[1], Content: m[2]}
+	}
+	return pages
+}
+
+// SerpPages turns organic search results into pages of their title and
+// snippet, so values taken straight from search results can be checked
+// like crawled ones.
+func SerpPages(snippets []models.SerpSnippet) []CrawledPage {
+	var pages []CrawledPage
+	for _, snippet := range snippets {
+		if snippet.Link == "" {
+			continue
+		}
+		pages = append(pages, CrawledPage{
+			URL:     snippet.Link,
+			Content: snippet.Title + "\n" + snippet.Snippet,
+		})
+	}
+	return pages
+}
+
+// VerifyCitations checks every field's quote against the fetched pages. A
+// quote found on the cited page, or failing that on any other page, is
+// verified and its URL set to that page. Quotes that appear nowhere are
+// kept for review but stay unverified.
+func VerifyCitations(confidence map[string]*models.FieldConfidenceInfo, pages []CrawledPage) {
+	normalized := make([]CrawledPage, len(pages))
+	for i, p := range pages {
+		normalized[i] = CrawledPage{URL: p.URL, Content: normalizeQuoteText(p.Content)}
+	}
+	for _, info := range confidence {
+		if info == nil || info.Citation == nil {
+			continue
+		}
+		citation := info.Citation
+		citation.Verified = false
+		quote := normalizeQuoteText(citation.Quote)
+		if quote == "" {
+			continue
+		}
+		found := -1
+		for i, p := range normalized {
+			if !strings.Contains(p.Content, quote) {
+				continue
+			}
+			if sameURL(p.URL, citation.URL) {
+				found = i
+				break
+			}
+			if found < 0 {
+				found = i
+			}
+		}
+		if found < 0 {
+			continue
+		}
+		citation.Verified = true
+		if pages[found].URL != "" {
+			citation.URL = pages[found].URL
+		}
+	}
+}
+
+// quoteReplacer drops markdown emphasis and unifies typographic quotes, so
+// a quote copied from rendered text still matches the page's markdown.
+var quoteReplacer = strings.NewReplacer(
+	"*", "", "_", "", "`", "",
+	"\u2018", "'", "\u2019", "'", "\u201c", `"`, "\u201d", `"`,
+)
+
+func normalizeQuoteText(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(quoteReplacer.Replace(s)), " "))
+}
+
+func sameURL(a, b string) bool {
+	return strings.TrimSuffix(a, "/") == strings.TrimSuffix(b, "/")
+}
@@ -0,0 +1,73 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+func TestParseCrawledPages(t *testing.T) {
+	pages := []CrawledPage{
+		{URL: "https://acme.example/about", Content: "# About\nAcme was founded in 1999."},
+		{URL: "https://news.example/acme", Content: "Acme hires a new CEO."},
+	}
+	tests := []struct {
+		name    string
+		content string
+		want    []CrawledPage
+	}{
+		{"formatted pages", FormatCrawledPages(pages), pages},
+		{"plain content", "Acme was founded in 1999.", []CrawledPage{{Content: "Acme was founded in 1999."}}},
+		{"empty", "  ", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseCrawledPages(tt.content); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseCrawledPages() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestVerifyCitations(t *testing.T) {
+	pages := []CrawledPage{
+		{URL: "https://acme.example/about", Content: "Acme was **founded in 1999** by\nJane Doe."},
+		{URL: "https://news.example/acme", Content: "Acme’s CEO is Jane Doe."},
+	}
+	tests := []struct {
+		name     string
+		citation *models.Citation
+		want     *models.Citation
+	}{
+		{
+			name:     "quote on cited page",
+			citation: &models.Citation{URL: "https://acme.example/about/", Quote: "founded in 1999 by Jane Doe"},
+			want:     &models.Citation{URL: "https://acme.example/about", Quote: "founded in 1999 by Jane Doe", Verified: true},
+		},
+		{
+			name:     "quote on another page is re-attributed",
+			citation: &models.Citation{URL: "https://acme.example/about", Quote: "Acme's CEO is Jane Doe"},
+			want:     &models.Citation{URL: "https://news.example/acme", Quote: "Acme's CEO is Jane Doe", Verified: true},
+		},
+		{
+			name:     "quote not in content",
+			citation: &models.Citation{URL: "https://acme.example/about", Quote: "founded in 2001", Verified: true},
+			want:     &models.Citation{URL: "https://acme.example/about", Quote: "founded in 2001"},
+		},
+		{
+			name:     "empty quote",
+			citation: &models.Citation{URL: "https://acme.example/about"},
+			want:     &models.Citation{URL: "https://acme.example/about"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			confidence := map[string]*models.FieldConfidenceInfo{"field": {Score: 0.9, Citation: tt.citation}, "other": nil}
+			VerifyCitations(confidence, pages)
+			if got := confidence["field"].Citation; !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("citation = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package services
+
+import (
+	"fmt"
+
+	"github.com/blagoySimandov/ampledata/go/internal/config"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+// ValidateClassifyColumns checks that every classify column names at least one
+// input column to classify from.
+func ValidateClassifyColumns(cols []*models.ColumnMetadata) error {
+	for _, col := range cols {
+		if col.JobType != models.JobTypeClassify {
+			continue
+		}
+		if len(col.InputColumns) == 0 {
+			return fmt.Errorf("classify column %q requires at least one input column", col.Name)
+		}
+	}
+	return nil
+}
+
+// ClassifyInputColumns returns the distinct input columns referenced by the
+// classify columns, in order of first use.
+func ClassifyInputColumns(cols []*models.ColumnMetadata) []string {
+	seen := make(map[string]bool)
+	var names []string
+	for _, col := range cols {
+		if col.JobType != models.JobTypeClassify {
+			continue
+		}
+		for _, name := range col.InputColumns {
+			if !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+	}
+	return names
+}
+
+// classifyCredits converts classified cells to credits at the configured rate.
+func classifyCredits(cells int) int {
+	return models.ClassifyCredits(cells, config.Load().ClassifyCellsPerCredit)
+}
+
+// requiredCredits is what enriching rowCount rows with the columns is
+// expected to cost at the configured classify rate.
+func requiredCredits(rowCount int, cols []*models.ColumnMetadata) int64 {
+	return models.RequiredCredits(rowCount, cols, config.Load().ClassifyCellsPerCredit)
+}
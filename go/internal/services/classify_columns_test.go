@@ -0,0 +1,67 @@
+package services
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+func classifyColumn(name string, inputs ...string) *models.ColumnMetadata {
+	return &models.ColumnMetadata{Name: name, Type: models.ColumnTypeString, JobType: models.JobTypeClassify, InputColumns: inputs}
+}
+
+func TestValidateClassifyColumns(t *testing.T) {
+	revenue := &models.ColumnMetadata{Name: "revenue", Type: models.ColumnTypeNumber, JobType: models.JobTypeEnrichment}
+
+	tests := []struct {
+		name    string
+		cols    []*models.ColumnMetadata
+		wantErr bool
+	}{
+		{"valid", []*models.ColumnMetadata{classifyColumn("industry", "description")}, false},
+		{"mixed with enrichment", []*models.ColumnMetadata{revenue, classifyColumn("sentiment", "review")}, false},
+		{"no input columns", []*models.ColumnMetadata{classifyColumn("industry")}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateClassifyColumns(tt.cols)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateClassifyColumns() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestClassifyInputColumns(t *testing.T) {
+	cols := []*models.ColumnMetadata{
+		classifyColumn("industry", "description", "website"),
+		{Name: "revenue", Type: models.ColumnTypeNumber, JobType: models.JobTypeEnrichment},
+		classifyColumn("segment", "website", "employees"),
+	}
+
+	want := []string{"description", "website", "employees"}
+	if got := ClassifyInputColumns(cols); !reflect.DeepEqual(got, want) {
+		t.Errorf("ClassifyInputColumns() = %v, want %v", got, want)
+	}
+}
+
+func TestClassificationPrompt(t *testing.T) {
+	cols := []*models.ColumnMetadata{classifyColumn("industry", "description")}
+	prompt := NewPromptService().ClassificationPrompt("Acme", "", "", cols, map[string]string{
+		"description": "Builds CRM software for dentists",
+		"ignored":     "not an input column",
+	})
+
+	if !strings.Contains(prompt, "description: Builds CRM software for dentists") {
+		t.Errorf("prompt is missing the input column value:\n%s", prompt)
+	}
+	if strings.Contains(prompt, "not an input column") {
+		t.Errorf("prompt should only include input columns:\n%s", prompt)
+	}
+	if !strings.Contains(prompt, "- industry [type: string] [from: description]") {
+		t.Errorf("prompt is missing the column listing:\n%s", prompt)
+	}
+}
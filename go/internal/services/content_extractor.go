@@ -45,8 +45,8 @@ func NewAIContentExtractor(client IAIClient, promptService IPromptService, opts
 
 const maxToolSteps = 3
 
-func (g *AIContentExtractor) Extract(ctx context.Context, content string, entityKey string, columnsMetadata []*models.ColumnMetadata, keyColumnDescription string) (*ExtractionResult, error) {
-	prompt := g.promptService.ExtractionPrompt(entityKey, keyColumnDescription, columnsMetadata, content)
+func (g *AIContentExtractor) Extract(ctx context.Context, content string, entityKey string, columnsMetadata []*models.ColumnMetadata, keyColumnDescription, instructions string, rowContext map[string]string) (*ExtractionResult, error) {
+	prompt := g.promptService.ExtractionPrompt(entityKey, keyColumnDescription, instructions, rowContext, columnsMetadata, content)
 
 	var opts []GenerateOption
 	if g.crawler != nil {
@@ -62,10 +62,24 @@ func (g *AIContentExtractor) Extract(ctx context.Context, content string, entity
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse response: %w", err)
 	}
+	return er, nil
+}
+
+// Classify fills columns from the row's own input values with a single LLM
+// call. Unlike Extract it never fetches pages, since classify columns must
+// not depend on anything outside the row.
+func (g *AIContentExtractor) Classify(ctx context.Context, entityKey string, rowValues map[string]string, columnsMetadata []*models.ColumnMetadata, keyColumnDescription, instructions string) (*ExtractionResult, error) {
+	prompt := g.promptService.ClassificationPrompt(entityKey, keyColumnDescription, instructions, columnsMetadata, rowValues)
 
-	// TODO: make this cleaner in some way.
-	coercedData := ValidateAndCoerceTypes(er.ExtractedData, columnsMetadata, er.Confidence)
-	er.ExtractedData = coercedData
+	result, err := g.client.GenerateContent(ctx, prompt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate content: %w", err)
+	}
+
+	er, err := parseResponse(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse response: %w", err)
+	}
 	return er, nil
 }
 
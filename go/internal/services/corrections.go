@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/state"
+	"github.com/google/uuid"
+)
+
+var ErrRowNotFound = errors.New("row not found")
+
+// CellCorrectionInput is a reviewer's value for one extracted cell. A nil
+// Value records that the cell has no value.
+type CellCorrectionInput struct {
+	JobID  string
+	UserID string
+	RowKey string
+	Column string
+	Value  interface{}
+}
+
+// CorrectionService stores reviewers' fixes of extracted cells.
+type CorrectionService struct {
+	store state.Store
+}
+
+func NewCorrectionService(store state.Store) *CorrectionService {
+	return &CorrectionService{store: store}
+}
+
+// CorrectCell sets a cell to a reviewer's value, coerced to the column's
+// type. The cell becomes human-verified, so later runs keep it.
+func (s *CorrectionService) CorrectCell(ctx context.Context, input CellCorrectionInput) (*models.CellCorrection, *models.RowState, error) {
+	job, err := s.ownedJob(ctx, input.JobID, input.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	value, precision, err := coerceCorrection(job, input.Column, input.Value)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	correction := &models.CellCorrectionDB{
+		ID:        uuid.New(),
+		JobID:     input.JobID,
+		RowKey:    input.RowKey,
+		Column:    input.Column,
+		NewValue:  value,
+		UserID:    input.UserID,
+		CreatedAt: time.Now(),
+		Precision: precision,
+	}
+	row, err := s.store.CorrectCell(ctx, correction)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, ErrRowNotFound
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return correction.ToCellCorrection(), row, nil
+}
+
+// ListCorrections returns the corrections of a job, newest first. A
+// non-empty rowKey limits them to one row.
+func (s *CorrectionService) ListCorrections(ctx context.Context, jobID, userID, rowKey string) ([]*models.CellCorrection, error) {
+	if _, err := s.ownedJob(ctx, jobID, userID); err != nil {
+		return nil, err
+	}
+	return s.store.GetCellCorrections(ctx, jobID, rowKey)
+}
+
+func (s *CorrectionService) ownedJob(ctx context.Context, jobID, userID string) (*models.Job, error) {
+	job, err := s.store.GetJob(ctx, jobID)
+	if err != nil {
+		return nil, ErrJobNotFound
+	}
+	if job.UserID != userID {
+		return nil, ErrJobForbidden
+	}
+	return job, nil
+}
+
+// coerceCorrection checks that column is enriched by the job and converts
+// value to its type the same way extracted values are converted. It also
+// returns the precision of a date value.
+func coerceCorrection(job *models.Job, column string, value interface{}) (interface{}, models.DatePrecision, error) {
+	var col *models.ColumnMetadata
+	for _, c := range job.ColumnsMetadata {
+		if c.Name == column {
+			col = c
+			break
+		}
+	}
+	if col == nil {
+		return nil, "", newValidationError(fmt.Sprintf("column %q is not enriched by this job", column))
+	}
+	if value == nil {
+		return nil, "", nil
+	}
+
+	confidence := map[string]*models.FieldConfidenceInfo{column: {Score: 1.0}}
+	coerced := ValidateAndCoerceTypes(
+		map[string]interface{}{column: value},
+		[]*models.ColumnMetadata{col},
+		confidence,
+		CoercionOptions{Locale: ResolveLocale(Deref(job.Locale))},
+	)[column]
+	if coerced == nil || confidence[column].Score == 0 {
+		return nil, "", newValidationError(fmt.Sprintf("value %v is not a valid %s", value, col.Type))
+	}
+	return coerced, confidence[column].Precision, nil
+}
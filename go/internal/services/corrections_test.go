@@ -0,0 +1,63 @@
+package services
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+func TestCoerceCorrection(t *testing.T) {
+	de := "de-DE"
+	job := &models.Job{
+		Locale: &de,
+		ColumnsMetadata: []*models.ColumnMetadata{
+			{Name: "ceo", Type: models.ColumnTypeString},
+			{Name: "revenue", Type: models.ColumnTypeNumber},
+			{Name: "public", Type: models.ColumnTypeBoolean},
+			{Name: "tags", Type: models.ColumnTypeList},
+			{Name: "founded_on", Type: models.ColumnTypeDate},
+		},
+	}
+	tests := []struct {
+		name          string
+		column        string
+		value         interface{}
+		want          interface{}
+		wantPrecision models.DatePrecision
+		wantErr       bool
+	}{
+		{"string", "ceo", "Jane Doe", "Jane Doe", "", false},
+		{"number", "revenue", 1200.0, 1200.0, "", false},
+		{"number in job locale", "revenue", "1.200,5", 1200.5, "", false},
+		{"boolean", "public", "yes", true, "", false},
+		{"list", "tags", []interface{}{"b2b", "saas"}, []interface{}{"b2b", "saas"}, "", false},
+		{"partial date", "founded_on", "March 2019", "2019-03-01", models.DatePrecisionMonth, false},
+		{"cleared", "revenue", nil, nil, "", false},
+		{"invalid number", "revenue", "a lot", nil, "", true},
+		{"invalid boolean", "public", "maybe", nil, "", true},
+		{"unknown column", "founded", "1999", nil, "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, precision, err := coerceCorrection(job, tt.column, tt.value)
+			if tt.wantErr {
+				var validErr ValidationError
+				if !errors.As(err, &validErr) {
+					t.Fatalf("coerceCorrection() error = %v, want a validation error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("coerceCorrection() error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("coerceCorrection() = %#v, want %#v", got, tt.want)
+			}
+			if precision != tt.wantPrecision {
+				t.Errorf("coerceCorrection() precision = %q, want %q", precision, tt.wantPrecision)
+			}
+		})
+	}
+}
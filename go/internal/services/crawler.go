@@ -21,8 +21,9 @@ type CrawlRequest struct {
 }
 
 type CrawlResponse struct {
-	Content string `json:"content"`
-	Success bool   `json:"success"`
+	Content string        `json:"content"`
+	Success bool          `json:"success"`
+	Pages   []CrawledPage `json:"pages"`
 }
 
 func NewCrawl4aiClient(baseURL string) *Crawl4aiClient {
@@ -72,5 +73,10 @@ func (c *Crawl4aiClient) Crawl(ctx context.Context, urls []string, query string)
 		return "", fmt.Errorf("crawl failed")
 	}
 
+	// Pages keep their URLs, so extracted values can cite the page they
+	// came from. Older crawler versions only return the joined content.
+	if len(result.Pages) > 0 {
+		return FormatCrawledPages(result.Pages), nil
+	}
 	return result.Content, nil
 }
@@ -0,0 +1,237 @@
+package services
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+// ParsedDate is a date together with how precisely the source text pinned it
+// down. Date is the first day of the period, e.g. 2021-07-01 for "Q3 2021".
+type ParsedDate struct {
+	Date      time.Time
+	Precision models.DatePrecision
+	// Relative is set when the text was relative ("3 days ago") and was
+	// resolved against a reference date.
+	Relative bool
+	// Ambiguous is set when day and month could be swapped and the locale
+	// decided the order.
+	Ambiguous bool
+}
+
+var fullDateLayouts = []string{
+	"2006-01-02",
+	"2006-01-02T15:04:05Z07:00",
+	"2006-01-02 15:04:05",
+	"January 2, 2006",
+	"January 2 2006",
+	"Jan 2, 2006",
+	"Jan 2 2006",
+	"2 January 2006",
+	"2 Jan 2006",
+	"Monday, January 2, 2006",
+}
+
+var monthYearLayouts = []string{
+	"January 2006",
+	"January, 2006",
+	"Jan 2006",
+	"Jan, 2006",
+	"2006-01",
+	"01/2006",
+	"1/2006",
+}
+
+var (
+	yearPattern         = regexp.MustCompile(`^(?:(?:in|circa|c\.|ca\.)\s*)?(\d{4})$`)
+	numericDatePattern  = regexp.MustCompile(`^(\d{1,2})[/.\-](\d{1,2})[/.\-](\d{4})$`)
+	quarterPattern      = regexp.MustCompile(`^q([1-4])[\s/\-]*(?:fy)?(\d{4})$`)
+	yearQuarterPattern  = regexp.MustCompile(`^(\d{4})[\s/\-]*q([1-4])$`)
+	quarterWordsPattern = regexp.MustCompile(`^(first|second|third|fourth|1st|2nd|3rd|4th) quarter(?: of)?,? (\d{4})$`)
+	isoWeekPattern      = regexp.MustCompile(`^(\d{4})-?w(\d{2})(?:-?([1-7]))?$`)
+	relativeAgoPattern  = regexp.MustCompile(`^(\d+|an?|one) (day|week|month|year)s? ago$`)
+	relativeLastPattern = regexp.MustCompile(`^(last|this|next) (week|month|quarter|year)$`)
+)
+
+var quarterWords = map[string]int{
+	"first": 1, "1st": 1, "second": 2, "2nd": 2, "third": 3, "3rd": 3, "fourth": 4, "4th": 4,
+}
+
+// ParseDate parses full, partial (year, month-year, quarter, ISO week) and
+// relative dates. Relative dates are resolved against reference; numeric
+// day/month order follows the locale when both readings are valid.
+func ParseDate(s string, locale Locale, reference time.Time) (*ParsedDate, error) {
+	text := strings.Join(strings.Fields(strings.TrimSpace(s)), " ")
+	if text == "" {
+		return nil, fmt.Errorf("empty string")
+	}
+	lower := strings.ToLower(text)
+
+	for _, layout := range fullDateLayouts {
+		if parsed, err := time.Parse(layout, text); err == nil {
+			return &ParsedDate{Date: truncateDay(parsed), Precision: models.DatePrecisionDay}, nil
+		}
+	}
+	if parsed, ok := parseNumericDate(lower, locale); ok {
+		return parsed, nil
+	}
+	for _, layout := range monthYearLayouts {
+		if parsed, err := time.Parse(layout, text); err == nil {
+			return &ParsedDate{Date: parsed, Precision: models.DatePrecisionMonth}, nil
+		}
+	}
+	if m := yearPattern.FindStringSubmatch(lower); m != nil {
+		year, _ := strconv.Atoi(m[1])
+		return &ParsedDate{Date: date(year, time.January, 1), Precision: models.DatePrecisionYear}, nil
+	}
+	if parsed, ok := parseQuarter(lower); ok {
+		return parsed, nil
+	}
+	if m := isoWeekPattern.FindStringSubmatch(lower); m != nil {
+		return parseISOWeek(m)
+	}
+	if parsed, ok := parseRelativeDate(lower, reference); ok {
+		return parsed, nil
+	}
+	return nil, fmt.Errorf("unrecognised date format '%s'", s)
+}
+
+func parseNumericDate(text string, locale Locale) (*ParsedDate, bool) {
+	m := numericDatePattern.FindStringSubmatch(text)
+	if m == nil {
+		return nil, false
+	}
+	first, _ := strconv.Atoi(m[1])
+	second, _ := strconv.Atoi(m[2])
+	year, _ := strconv.Atoi(m[3])
+
+	dayFirst := locale.DayFirst
+	ambiguous := false
+	switch {
+	case first > 12 && second <= 12:
+		dayFirst = true
+	case second > 12 && first <= 12:
+		dayFirst = false
+	case first > 12 && second > 12:
+		return nil, false
+	default:
+		ambiguous = first != second
+	}
+
+	day, month := second, first
+	if dayFirst {
+		day, month = first, second
+	}
+	parsed := date(year, time.Month(month), day)
+	if parsed.Day() != day {
+		return nil, false
+	}
+	return &ParsedDate{Date: parsed, Precision: models.DatePrecisionDay, Ambiguous: ambiguous}, true
+}
+
+func parseQuarter(text string) (*ParsedDate, bool) {
+	var quarter, year int
+	if m := quarterPattern.FindStringSubmatch(text); m != nil {
+		quarter, _ = strconv.Atoi(m[1])
+		year, _ = strconv.Atoi(m[2])
+	} else if m := yearQuarterPattern.FindStringSubmatch(text); m != nil {
+		year, _ = strconv.Atoi(m[1])
+		quarter, _ = strconv.Atoi(m[2])
+	} else if m := quarterWordsPattern.FindStringSubmatch(text); m != nil {
+		quarter = quarterWords[m[1]]
+		year, _ = strconv.Atoi(m[2])
+	} else {
+		return nil, false
+	}
+	return &ParsedDate{Date: quarterStart(year, quarter), Precision: models.DatePrecisionQuarter}, true
+}
+
+func parseISOWeek(m []string) (*ParsedDate, error) {
+	year, _ := strconv.Atoi(m[1])
+	week, _ := strconv.Atoi(m[2])
+	if week < 1 || week > 53 {
+		return nil, fmt.Errorf("invalid ISO week %d", week)
+	}
+	// ISO week 1 is the week containing January 4th; weeks start on Monday.
+	jan4 := date(year, time.January, 4)
+	monday := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+	start := monday.AddDate(0, 0, (week-1)*7)
+	if y, w := start.ISOWeek(); y != year || w != week {
+		return nil, fmt.Errorf("year %d has no ISO week %d", year, week)
+	}
+	if m[3] != "" {
+		weekday, _ := strconv.Atoi(m[3])
+		return &ParsedDate{Date: start.AddDate(0, 0, weekday-1), Precision: models.DatePrecisionDay}, nil
+	}
+	return &ParsedDate{Date: start, Precision: models.DatePrecisionWeek}, nil
+}
+
+func parseRelativeDate(text string, reference time.Time) (*ParsedDate, bool) {
+	ref := truncateDay(reference)
+	switch text {
+	case "today":
+		return &ParsedDate{Date: ref, Precision: models.DatePrecisionDay, Relative: true}, true
+	case "yesterday":
+		return &ParsedDate{Date: ref.AddDate(0, 0, -1), Precision: models.DatePrecisionDay, Relative: true}, true
+	}
+
+	if m := relativeAgoPattern.FindStringSubmatch(text); m != nil {
+		n := 1
+		if v, err := strconv.Atoi(m[1]); err == nil {
+			n = v
+		}
+		var d time.Time
+		var precision models.DatePrecision
+		switch m[2] {
+		case "day":
+			d, precision = ref.AddDate(0, 0, -n), models.DatePrecisionDay
+		case "week":
+			d, precision = ref.AddDate(0, 0, -7*n), models.DatePrecisionDay
+		case "month":
+			d, precision = monthStart(ref).AddDate(0, -n, 0), models.DatePrecisionMonth
+		case "year":
+			d, precision = date(ref.Year()-n, time.January, 1), models.DatePrecisionYear
+		}
+		return &ParsedDate{Date: d, Precision: precision, Relative: true}, true
+	}
+
+	if m := relativeLastPattern.FindStringSubmatch(text); m != nil {
+		offset := map[string]int{"last": -1, "this": 0, "next": 1}[m[1]]
+		var d time.Time
+		var precision models.DatePrecision
+		switch m[2] {
+		case "week":
+			monday := ref.AddDate(0, 0, -((int(ref.Weekday()) + 6) % 7))
+			d, precision = monday.AddDate(0, 0, 7*offset), models.DatePrecisionWeek
+		case "month":
+			d, precision = monthStart(ref).AddDate(0, offset, 0), models.DatePrecisionMonth
+		case "quarter":
+			q := (int(ref.Month())-1)/3 + 1
+			d, precision = quarterStart(ref.Year(), q).AddDate(0, 3*offset, 0), models.DatePrecisionQuarter
+		case "year":
+			d, precision = date(ref.Year()+offset, time.January, 1), models.DatePrecisionYear
+		}
+		return &ParsedDate{Date: d, Precision: precision, Relative: true}, true
+	}
+	return nil, false
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func truncateDay(t time.Time) time.Time {
+	return date(t.Year(), t.Month(), t.Day())
+}
+
+func monthStart(t time.Time) time.Time {
+	return date(t.Year(), t.Month(), 1)
+}
+
+func quarterStart(year, quarter int) time.Time {
+	return date(year, time.Month((quarter-1)*3+1), 1)
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+func TestParseDate(t *testing.T) {
+	reference := time.Date(2024, time.March, 15, 10, 30, 0, 0, time.UTC)
+	german := ResolveLocale("de-DE")
+
+	tests := []struct {
+		name      string
+		input     string
+		locale    Locale
+		want      string
+		precision models.DatePrecision
+		relative  bool
+		ambiguous bool
+	}{
+		{"iso", "2020-03-15", DefaultLocale, "2020-03-15", models.DatePrecisionDay, false, false},
+		{"rfc3339", "2020-03-15T12:00:00Z", DefaultLocale, "2020-03-15", models.DatePrecisionDay, false, false},
+		{"long", "March 5, 2020", DefaultLocale, "2020-03-05", models.DatePrecisionDay, false, false},
+		{"day month year", "5 March 2020", DefaultLocale, "2020-03-05", models.DatePrecisionDay, false, false},
+		{"ambiguous us", "03/04/2020", DefaultLocale, "2020-03-04", models.DatePrecisionDay, false, true},
+		{"ambiguous de", "03.04.2020", german, "2020-04-03", models.DatePrecisionDay, false, true},
+		{"unambiguous day first", "25/12/2020", DefaultLocale, "2020-12-25", models.DatePrecisionDay, false, false},
+		{"unambiguous month first", "12/25/2020", german, "2020-12-25", models.DatePrecisionDay, false, false},
+		{"same day and month", "05/05/2020", DefaultLocale, "2020-05-05", models.DatePrecisionDay, false, false},
+		{"year only", "2019", DefaultLocale, "2019-01-01", models.DatePrecisionYear, false, false},
+		{"year with prefix", "in 2019", DefaultLocale, "2019-01-01", models.DatePrecisionYear, false, false},
+		{"month year", "March 2020", DefaultLocale, "2020-03-01", models.DatePrecisionMonth, false, false},
+		{"short month year", "Mar 2020", DefaultLocale, "2020-03-01", models.DatePrecisionMonth, false, false},
+		{"iso month", "2020-03", DefaultLocale, "2020-03-01", models.DatePrecisionMonth, false, false},
+		{"quarter", "Q3 2021", DefaultLocale, "2021-07-01", models.DatePrecisionQuarter, false, false},
+		{"year quarter", "2021-Q4", DefaultLocale, "2021-10-01", models.DatePrecisionQuarter, false, false},
+		{"quarter words", "second quarter of 2021", DefaultLocale, "2021-04-01", models.DatePrecisionQuarter, false, false},
+		{"iso week", "2021-W05", DefaultLocale, "2021-02-01", models.DatePrecisionWeek, false, false},
+		{"iso week compact", "2020W01", DefaultLocale, "2019-12-30", models.DatePrecisionWeek, false, false},
+		{"iso week day", "2021-W05-3", DefaultLocale, "2021-02-03", models.DatePrecisionDay, false, false},
+		{"days ago", "3 days ago", DefaultLocale, "2024-03-12", models.DatePrecisionDay, true, false},
+		{"a month ago", "a month ago", DefaultLocale, "2024-02-01", models.DatePrecisionMonth, true, false},
+		{"years ago", "2 years ago", DefaultLocale, "2022-01-01", models.DatePrecisionYear, true, false},
+		{"yesterday", "yesterday", DefaultLocale, "2024-03-14", models.DatePrecisionDay, true, false},
+		{"last year", "last year", DefaultLocale, "2023-01-01", models.DatePrecisionYear, true, false},
+		{"this quarter", "this quarter", DefaultLocale, "2024-01-01", models.DatePrecisionQuarter, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseDate(tt.input, tt.locale, reference)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error: %v", tt.input, err)
+			}
+			if d := got.Date.Format("2006-01-02"); d != tt.want {
+				t.Errorf("ParseDate(%q) = %s, want %s", tt.input, d, tt.want)
+			}
+			if got.Precision != tt.precision {
+				t.Errorf("ParseDate(%q).Precision = %s, want %s", tt.input, got.Precision, tt.precision)
+			}
+			if got.Relative != tt.relative {
+				t.Errorf("ParseDate(%q).Relative = %v, want %v", tt.input, got.Relative, tt.relative)
+			}
+			if got.Ambiguous != tt.ambiguous {
+				t.Errorf("ParseDate(%q).Ambiguous = %v, want %v", tt.input, got.Ambiguous, tt.ambiguous)
+			}
+		})
+	}
+}
+
+func TestParseDate_Invalid(t *testing.T) {
+	tests := []string{"", "unknown", "13/13/2020", "31/02/2020", "Q5 2021", "2021-W54"}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			if got, err := ParseDate(input, DefaultLocale, time.Now()); err == nil {
+				t.Errorf("ParseDate(%q) = %v, want error", input, got.Date)
+			}
+		})
+	}
+}
+
+func TestResolveLocale(t *testing.T) {
+	tests := []struct {
+		tag      string
+		number   NumberLocale
+		dayFirst bool
+	}{
+		{"", NumberLocaleEnglish, false},
+		{"en-US", NumberLocaleEnglish, false},
+		{"en", NumberLocaleEnglish, false},
+		{"en-GB", NumberLocaleEnglish, true},
+		{"de-DE", NumberLocaleEuropean, true},
+		{"fr", NumberLocaleEuropean, true},
+		{"de-CH", NumberLocaleSwiss, true},
+		{"not a locale", NumberLocaleEnglish, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			got := ResolveLocale(tt.tag)
+			if got.Number != tt.number || got.DayFirst != tt.dayFirst {
+				t.Errorf("ResolveLocale(%q) = %+v, want number %+v dayFirst %v", tt.tag, got, tt.number, tt.dayFirst)
+			}
+		})
+	}
+}
+
+func TestCoerceToDate(t *testing.T) {
+	opts := CoercionOptions{Locale: ResolveLocale("en-US"), ReferenceDate: time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)}
+	tests := []struct {
+		name          string
+		value         interface{}
+		scored        bool
+		want          interface{}
+		wantPrecision models.DatePrecision
+	}{
+		{"full date", "2019-03-14", true, "2019-03-14", models.DatePrecisionDay},
+		{"partial date", "March 2019", true, "2019-03-01", models.DatePrecisionMonth},
+		{"partial date without confidence", "2019", false, "2019-01-01", models.DatePrecisionYear},
+		{"numeric year", 2019.0, true, "2019-01-01", models.DatePrecisionYear},
+		{"numeric year without confidence", 1887.0, false, "1887-01-01", models.DatePrecisionYear},
+		{"unix timestamp", 1700000000.0, true, "2023-11-14", models.DatePrecisionDay},
+		{"small number", 42.0, true, nil, ""},
+		{"fractional year", 2019.5, true, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			confidence := map[string]*models.FieldConfidenceInfo{}
+			if tt.scored {
+				confidence["founded"] = &models.FieldConfidenceInfo{Score: 0.9}
+			}
+
+			got := coerceToDate(tt.value, "founded", confidence, opts)
+
+			if got != tt.want {
+				t.Errorf("coerceToDate(%v) = %v, want %v", tt.value, got, tt.want)
+			}
+			var precision models.DatePrecision
+			if conf := confidence["founded"]; conf != nil {
+				precision = conf.Precision
+			}
+			if precision != tt.wantPrecision {
+				t.Errorf("coerceToDate(%v) precision = %q, want %q", tt.value, precision, tt.wantPrecision)
+			}
+		})
+	}
+}
@@ -30,8 +30,8 @@ func NewGeminiDecisionMaker(promptService IPromptService, client IAIClient) (*AI
 	}, nil
 }
 
-func (g *AIDecisionMaker) MakeDecision(ctx context.Context, serp *models.GoogleSearchResults, rowKey string, maxURLs int, columnsMetadata []*models.ColumnMetadata, keyColumnDescription string, previousAttempts []*models.EnrichmentAttempt) (*CrawlDecision, error) {
-	prompt := g.promptService.DecisionMakerPrompt(rowKey, keyColumnDescription, columnsMetadata, serp, maxURLs, previousAttempts)
+func (g *AIDecisionMaker) MakeDecision(ctx context.Context, serp *models.GoogleSearchResults, rowKey string, maxURLs int, columnsMetadata []*models.ColumnMetadata, keyColumnDescription, instructions string, rowContext map[string]string, previousAttempts []*models.EnrichmentAttempt) (*CrawlDecision, error) {
+	prompt := g.promptService.DecisionMakerPrompt(rowKey, keyColumnDescription, instructions, rowContext, columnsMetadata, serp, maxURLs, previousAttempts)
 	result, err := g.client.GenerateContent(ctx, prompt)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,153 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/blagoySimandov/ampledata/go/internal/expr"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+// ValidateDerivedColumns checks that every derived column has an expression
+// that parses and only references source columns, enriched columns or
+// derived columns listed before it.
+func ValidateDerivedColumns(cols []*models.ColumnMetadata, sourceColumns []string) error {
+	known := make(map[string]bool)
+	for _, name := range sourceColumns {
+		known[name] = true
+	}
+	enriched, derived := models.SplitDerivedColumns(cols)
+	for _, col := range enriched {
+		known[col.Name] = true
+	}
+	if len(derived) > 0 && len(enriched) == 0 {
+		return fmt.Errorf("derived columns need at least one enrichment or imputation column")
+	}
+
+	for _, col := range derived {
+		if col.Expression == nil || strings.TrimSpace(*col.Expression) == "" {
+			return fmt.Errorf("derived column %q requires an expression", col.Name)
+		}
+		parsed, err := expr.Parse(*col.Expression)
+		if err != nil {
+			return fmt.Errorf("derived column %q: %v", col.Name, err)
+		}
+		for _, ref := range parsed.Identifiers() {
+			if !known[ref] {
+				return fmt.Errorf("derived column %q references unknown column %q", col.Name, ref)
+			}
+		}
+		known[col.Name] = true
+	}
+	return nil
+}
+
+// DerivedSourceColumns returns the distinct columns referenced by derived
+// expressions that are not columns of the job, which are source columns
+// once the expressions are validated, in order of first use.
+func DerivedSourceColumns(cols []*models.ColumnMetadata) []string {
+	jobColumns := make(map[string]bool, len(cols))
+	for _, col := range cols {
+		jobColumns[col.Name] = true
+	}
+	seen := make(map[string]bool)
+	var names []string
+	_, derived := models.SplitDerivedColumns(cols)
+	for _, col := range derived {
+		if col.Expression == nil {
+			continue
+		}
+		parsed, err := expr.Parse(*col.Expression)
+		if err != nil {
+			continue
+		}
+		for _, ref := range parsed.Identifiers() {
+			if !jobColumns[ref] && !seen[ref] {
+				seen[ref] = true
+				names = append(names, ref)
+			}
+		}
+	}
+	return names
+}
+
+// ComputeDerivedColumns evaluates derived columns in order against the row's
+// source cells and extracted values, extracted values taking precedence.
+// Each result is coerced to the column type, and its confidence is the
+// lowest confidence among the columns it references; source cells count as
+// certain. Every derived column gets a value, nil when it cannot be
+// computed, so saving the values clears results of earlier runs.
+func ComputeDerivedColumns(
+	extractedData map[string]interface{},
+	sourceValues map[string]string,
+	confidence map[string]*models.FieldConfidenceInfo,
+	derived []*models.ColumnMetadata,
+	opts CoercionOptions,
+) (map[string]interface{}, map[string]*models.FieldConfidenceInfo) {
+	row := make(map[string]interface{}, len(sourceValues)+len(extractedData)+len(derived))
+	for k, v := range sourceValues {
+		// An empty cell has no value, like a column that was not found.
+		if v != "" {
+			row[k] = v
+		}
+	}
+	for k, v := range extractedData {
+		row[k] = v
+	}
+	scores := make(map[string]float64, len(confidence))
+	for k, c := range confidence {
+		if c != nil {
+			scores[k] = c.Score
+		}
+	}
+
+	values := make(map[string]interface{})
+	derivedConfidence := make(map[string]*models.FieldConfidenceInfo)
+
+	for _, col := range derived {
+		if col.Expression == nil {
+			continue
+		}
+		values[col.Name] = nil
+		conf := &models.FieldConfidenceInfo{
+			Score:  1.0,
+			Reason: fmt.Sprintf("Derived from expression: %s", *col.Expression),
+		}
+
+		parsed, err := expr.Parse(*col.Expression)
+		if err != nil {
+			conf.Score = 0.0
+			conf.Reason += fmt.Sprintf(" (Error: %v)", err)
+			derivedConfidence[col.Name] = conf
+			continue
+		}
+		for _, ref := range parsed.Identifiers() {
+			if score, ok := scores[ref]; ok && score < conf.Score {
+				conf.Score = score
+			}
+		}
+
+		value, err := parsed.Eval(row)
+		if err != nil {
+			conf.Score = 0.0
+			conf.Reason += fmt.Sprintf(" (Error: %v)", err)
+			derivedConfidence[col.Name] = conf
+			continue
+		}
+		if value == nil {
+			conf.Reason += " (Note: An input column has no value)"
+			derivedConfidence[col.Name] = conf
+			continue
+		}
+
+		fieldConf := map[string]*models.FieldConfidenceInfo{col.Name: conf}
+		coerced := ValidateAndCoerceTypes(map[string]interface{}{col.Name: value}, []*models.ColumnMetadata{col}, fieldConf, opts)
+		if v, ok := coerced[col.Name]; ok && v != nil {
+			values[col.Name] = v
+			row[col.Name] = v
+			scores[col.Name] = conf.Score
+		}
+		derivedConfidence[col.Name] = conf
+	}
+	return values, derivedConfidence
+}
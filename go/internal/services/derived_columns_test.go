@@ -0,0 +1,83 @@
+package services
+
+import (
+	"testing"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+func derivedColumn(name string, colType models.ColumnType, expression string) *models.ColumnMetadata {
+	return &models.ColumnMetadata{Name: name, Type: colType, JobType: models.JobTypeDerived, Expression: &expression}
+}
+
+func TestValidateDerivedColumns(t *testing.T) {
+	revenue := &models.ColumnMetadata{Name: "revenue", Type: models.ColumnTypeNumber, JobType: models.JobTypeEnrichment}
+	employees := &models.ColumnMetadata{Name: "employees", Type: models.ColumnTypeNumber, JobType: models.JobTypeEnrichment}
+
+	tests := []struct {
+		name    string
+		cols    []*models.ColumnMetadata
+		wantErr bool
+	}{
+		{"valid", []*models.ColumnMetadata{revenue, employees, derivedColumn("rpe", models.ColumnTypeNumber, "revenue / employees")}, false},
+		{"chained derived", []*models.ColumnMetadata{revenue, derivedColumn("k", models.ColumnTypeNumber, "revenue / 1000"), derivedColumn("m", models.ColumnTypeNumber, "k / 1000")}, false},
+		{"forward reference", []*models.ColumnMetadata{revenue, derivedColumn("m", models.ColumnTypeNumber, "k / 1000"), derivedColumn("k", models.ColumnTypeNumber, "revenue / 1000")}, true},
+		{"unknown column", []*models.ColumnMetadata{revenue, derivedColumn("rpe", models.ColumnTypeNumber, "revenue / headcount")}, true},
+		{"source column", []*models.ColumnMetadata{employees, derivedColumn("growth", models.ColumnTypeNumber, "employees / headcount_2020")}, false},
+		{"missing expression", []*models.ColumnMetadata{revenue, {Name: "x", Type: models.ColumnTypeNumber, JobType: models.JobTypeDerived}}, true},
+		{"invalid expression", []*models.ColumnMetadata{revenue, derivedColumn("x", models.ColumnTypeNumber, "revenue +")}, true},
+		{"only derived", []*models.ColumnMetadata{derivedColumn("x", models.ColumnTypeNumber, "1 + 1")}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDerivedColumns(tt.cols, []string{"company", "headcount_2020"})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateDerivedColumns() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestComputeDerivedColumns(t *testing.T) {
+	data := map[string]interface{}{"revenue": 1000000.0, "employees": 0.0, "name": "acme"}
+	source := map[string]string{"headcount_2020": "40", "name": "Acme Source", "segment": ""}
+	confidence := map[string]*models.FieldConfidenceInfo{
+		"revenue":   {Score: 0.8, Reason: "From website"},
+		"employees": {Score: 0.9, Reason: "From LinkedIn"},
+		"name":      {Score: 0.95, Reason: "From website"},
+	}
+	derived := []*models.ColumnMetadata{
+		derivedColumn("revenue_k", models.ColumnTypeNumber, "revenue / 1000"),
+		derivedColumn("revenue_m", models.ColumnTypeNumber, "revenue_k / 1000"),
+		derivedColumn("display", models.ColumnTypeString, "upper(name)"),
+		derivedColumn("per_employee", models.ColumnTypeNumber, "revenue / employees"),
+		derivedColumn("missing", models.ColumnTypeNumber, "funding * 2"),
+		derivedColumn("per_2020_head", models.ColumnTypeNumber, "revenue / headcount_2020"),
+		derivedColumn("segment_upper", models.ColumnTypeString, "upper(segment)"),
+	}
+
+	values, conf := ComputeDerivedColumns(data, source, confidence, derived, CoercionOptions{Locale: DefaultLocale})
+
+	if values["revenue_k"] != 1000.0 || values["revenue_m"] != 1.0 || values["display"] != "ACME" {
+		t.Errorf("unexpected values: %v", values)
+	}
+	if values["per_2020_head"] != 25000.0 || conf["per_2020_head"].Score != 0.8 {
+		t.Errorf("per_2020_head = %v (confidence %v), want 25000 from the source cell", values["per_2020_head"], conf["per_2020_head"].Score)
+	}
+	if v, ok := values["segment_upper"]; !ok || v != nil {
+		t.Errorf("segment_upper = %v, want an explicit nil for an empty source cell", v)
+	}
+	if conf["revenue_m"].Score != 0.8 {
+		t.Errorf("revenue_m confidence = %v, want lowest input confidence 0.8", conf["revenue_m"].Score)
+	}
+	if v, ok := values["per_employee"]; !ok || v != nil {
+		t.Errorf("per_employee = %v, want an explicit nil on division by zero", v)
+	}
+	if conf["per_employee"] == nil || conf["per_employee"].Score != 0 {
+		t.Errorf("per_employee confidence = %v, want score 0 with error", conf["per_employee"])
+	}
+	if v, ok := values["missing"]; !ok || v != nil {
+		t.Errorf("missing = %v, want an explicit nil when its input is missing", v)
+	}
+}
@@ -0,0 +1,178 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"unicode/utf8"
+
+	"github.com/blagoySimandov/ampledata/go/internal/gcs"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/google/uuid"
+)
+
+// DialectOverride sets how a CSV source is parsed. Empty fields keep the
+// detected value.
+type DialectOverride struct {
+	Delimiter string
+	Encoding  string
+	HeaderRow int
+}
+
+// GetSourceDialect returns the dialect a CSV source is read with, detecting
+// and storing it if the file has not been read yet.
+func (s *sourcesService) GetSourceDialect(ctx context.Context, sourceID uuid.UUID, userID string) (*models.CSVDialect, error) {
+	source, err := s.getOwnedSource(ctx, sourceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	meta, err := csvMetadata(source)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.storeDetectedDialect(ctx, source); err != nil {
+		return nil, err
+	}
+	return meta.Dialect, nil
+}
+
+// storeDetectedDialect detects the dialect of a CSV source without one and
+// stores it. An upload source is created before its file is uploaded, so
+// this runs on the first read of the file; every later read, and the
+// revision history, then use the stored dialect.
+func (s *sourcesService) storeDetectedDialect(ctx context.Context, source *models.Source) error {
+	meta, ok := source.Metadata.(*models.CSVSourceMetadata)
+	if !ok || !gcs.IsCSV(meta.ContentType) || meta.Dialect != nil {
+		return nil
+	}
+	detected, err := s.reader.DetectDialect(ctx, meta.FileURI)
+	if err != nil {
+		return fmt.Errorf("failed to detect dialect: %w", err)
+	}
+	dialect := csvDialect(detected)
+	err = s.store.UpdateSource(ctx, source.ID, func(current *models.Source) error {
+		currentMeta, ok := current.Metadata.(*models.CSVSourceMetadata)
+		if !ok || currentMeta.FileURI != meta.FileURI {
+			return nil
+		}
+		if currentMeta.Dialect == nil {
+			currentMeta.Dialect = dialect
+		}
+		dialect = currentMeta.Dialect
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	meta.Dialect = dialect
+	return nil
+}
+
+// SetSourceDialect overrides the detected dialect of a CSV source. A nil
+// override drops an earlier override and detects the dialect again.
+func (s *sourcesService) SetSourceDialect(ctx context.Context, sourceID uuid.UUID, userID string, override *DialectOverride) (*models.CSVDialect, error) {
+	if override != nil {
+		if err := validateDialectOverride(override); err != nil {
+			return nil, err
+		}
+	}
+	source, err := s.getOwnedSource(ctx, sourceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	meta, err := csvMetadata(source)
+	if err != nil {
+		return nil, err
+	}
+	detected, err := s.reader.DetectDialect(ctx, meta.FileURI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect dialect: %w", err)
+	}
+	dialect := csvDialect(detected)
+	if override != nil {
+		dialect.Manual = true
+		if override.Delimiter != "" {
+			dialect.Delimiter = override.Delimiter
+		}
+		if override.Encoding != "" {
+			dialect.Encoding = override.Encoding
+		}
+		if override.HeaderRow > 0 {
+			dialect.HeaderRow = override.HeaderRow
+		}
+	}
+	err = s.store.UpdateSource(ctx, sourceID, func(source *models.Source) error {
+		current, err := csvMetadata(source)
+		if err != nil {
+			return err
+		}
+		if current.FileURI != meta.FileURI {
+			return newValidationError("the source file changed, please retry")
+		}
+		current.Dialect = dialect
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dialect, nil
+}
+
+// detectRevisionDialect detects the dialect of a confirmed CSV revision, so
+// that it is stored once instead of being detected on every read. It returns
+// nil when the source keeps its dialect or is not a CSV file.
+func (s *sourcesService) detectRevisionDialect(ctx context.Context, meta models.RevisionedMetadata, pending models.PendingRevision) (*models.CSVDialect, error) {
+	csvMeta, ok := meta.(*models.CSVSourceMetadata)
+	if !ok || !gcs.IsCSV(pending.ContentType) || csvMeta.Dialect != nil && csvMeta.Dialect.Manual {
+		return nil, nil
+	}
+	detected, err := s.reader.DetectDialect(ctx, pending.FileURI)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect dialect: %w", err)
+	}
+	return csvDialect(detected), nil
+}
+
+func csvMetadata(source *models.Source) (*models.CSVSourceMetadata, error) {
+	meta, ok := source.Metadata.(*models.CSVSourceMetadata)
+	if !ok || !gcs.IsCSV(meta.ContentType) {
+		return nil, newValidationError("source is not a CSV file")
+	}
+	return meta, nil
+}
+
+func validateDialectOverride(o *DialectOverride) error {
+	if o.Delimiter != "" {
+		r, size := utf8.DecodeRuneInString(o.Delimiter)
+		if size != len(o.Delimiter) || r == '"' || r == '\r' || r == '\n' || r == utf8.RuneError {
+			return newValidationError("delimiter must be a single character other than a quote or line break")
+		}
+	}
+	if o.Encoding != "" && !gcs.IsSupportedEncoding(o.Encoding) {
+		return newValidationError(fmt.Sprintf("encoding must be one of %v", gcs.Encodings))
+	}
+	if o.HeaderRow < 0 {
+		return newValidationError("header_row must be at least 1")
+	}
+	return nil
+}
+
+func csvDialect(d gcs.Dialect) *models.CSVDialect {
+	return &models.CSVDialect{
+		Delimiter: string(d.Delimiter),
+		Encoding:  d.Encoding,
+		BOM:       d.BOM,
+		HeaderRow: d.HeaderRow,
+	}
+}
+
+// applyDialect sets how a CSV file is parsed. Without a dialect the file is
+// detected as it is read.
+func applyDialect(file *gcs.SourceFile, d *models.CSVDialect) {
+	file.Delimiter, file.Encoding, file.HeaderRow = 0, "", 0
+	if d == nil {
+		return
+	}
+	file.Delimiter, _ = utf8.DecodeRuneInString(d.Delimiter)
+	file.Encoding = d.Encoding
+	file.HeaderRow = d.HeaderRow
+}
@@ -0,0 +1,30 @@
+package services
+
+import (
+	"testing"
+)
+
+func TestValidateDialectOverride(t *testing.T) {
+	tests := []struct {
+		name     string
+		override DialectOverride
+		wantErr  bool
+	}{
+		{"empty keeps detection", DialectOverride{}, false},
+		{"semicolon", DialectOverride{Delimiter: ";", Encoding: "windows-1252", HeaderRow: 2}, false},
+		{"tab", DialectOverride{Delimiter: "\t"}, false},
+		{"multi-character delimiter", DialectOverride{Delimiter: ";;"}, true},
+		{"quote delimiter", DialectOverride{Delimiter: `"`}, true},
+		{"newline delimiter", DialectOverride{Delimiter: "\n"}, true},
+		{"unknown encoding", DialectOverride{Encoding: "latin-9"}, true},
+		{"negative header row", DialectOverride{HeaderRow: -1}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateDialectOverride(&tt.override)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateDialectOverride() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,417 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/state"
+)
+
+// DiffInput selects two jobs on the same source to compare. Columns and
+// Changes narrow the reported cells and default to all of them.
+type DiffInput struct {
+	JobID     string
+	BaseJobID string
+	UserID    string
+	Columns   []string
+	Changes   []models.DiffChange
+	Offset    int
+	Limit     int
+}
+
+// DiffService compares the results of two jobs on the same source.
+type DiffService struct {
+	store state.Store
+
+	mu sync.Mutex
+	// totals caches the summaries of whole comparisons by diffTotalsKey, so
+	// paging through a diff compares every row once.
+	totals map[string]diffTotals
+}
+
+// diffTotals are the column summaries and differing row count of a
+// comparison, valid while both jobs' rows are at version.
+type diffTotals struct {
+	version   state.RowsVersion
+	rows      int
+	summaries []*models.ColumnDiffSummary
+}
+
+func NewDiffService(store state.Store) *DiffService {
+	return &DiffService{store: store, totals: make(map[string]diffTotals)}
+}
+
+// diffBatchSize is how many row keys are compared at a time. Only one batch
+// of rows is held in memory.
+const diffBatchSize = 1000
+
+// maxCachedDiffs bounds the comparisons whose totals are kept; the cache is
+// emptied when it is full.
+const maxCachedDiffs = 256
+
+// errDiffPageFull stops a walk once a page has its rows.
+var errDiffPageFull = errors.New("diff page full")
+
+// Diff compares the jobs and returns a page of the differing rows. The
+// column summaries always cover every row; they are computed once per
+// version of the jobs' rows, after which a page only compares the rows up
+// to its end.
+func (s *DiffService) Diff(ctx context.Context, input DiffInput) (*models.JobDiff, error) {
+	walk, err := s.prepare(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	version, err := s.store.GetRowsVersion(ctx, []string{input.BaseJobID, input.JobID})
+	if err != nil {
+		return nil, err
+	}
+	key := diffTotalsKey(input)
+	cached, ok := s.cachedTotals(key, version)
+
+	rows := []*models.RowDiff{}
+	total := 0
+	summaries, err := walk(func(diff *models.RowDiff) error {
+		if total >= input.Offset && (input.Limit <= 0 || len(rows) < input.Limit) {
+			rows = append(rows, diff)
+		}
+		total++
+		if ok && input.Limit > 0 && len(rows) == input.Limit {
+			return errDiffPageFull
+		}
+		return nil
+	})
+	switch {
+	case ok && (err == nil || errors.Is(err, errDiffPageFull)):
+		total, summaries = cached.rows, cached.summaries
+	case err != nil:
+		return nil, err
+	default:
+		s.cacheTotals(key, diffTotals{version: version, rows: total, summaries: summaries})
+	}
+	return &models.JobDiff{
+		BaseJobID: input.BaseJobID,
+		JobID:     input.JobID,
+		Columns:   summaries,
+		Rows:      rows,
+		Pagination: &models.PaginationInfo{
+			Total:   total,
+			Offset:  input.Offset,
+			Limit:   input.Limit,
+			HasMore: input.Offset+len(rows) < total,
+		},
+	}, nil
+}
+
+func (s *DiffService) cachedTotals(key string, version state.RowsVersion) (diffTotals, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	t, ok := s.totals[key]
+	if !ok || t.version.Count != version.Count || !t.version.UpdatedAt.Equal(version.UpdatedAt) {
+		return diffTotals{}, false
+	}
+	return t, true
+}
+
+func (s *DiffService) cacheTotals(key string, t diffTotals) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.totals) >= maxCachedDiffs {
+		clear(s.totals)
+	}
+	s.totals[key] = t
+}
+
+// diffTotalsKey identifies a comparison. Column and change filters are
+// matched regardless of their order.
+func diffTotalsKey(input DiffInput) string {
+	columns := slices.Sorted(slices.Values(input.Columns))
+	changes := make([]string, len(input.Changes))
+	for i, c := range input.Changes {
+		changes[i] = string(c)
+	}
+	slices.Sort(changes)
+	return strings.Join([]string{input.BaseJobID, input.JobID, strings.Join(columns, "\x01"), strings.Join(changes, "\x01")}, "\x00")
+}
+
+// DiffCSV checks the comparison and returns a function that writes every
+// compared cell as one CSV line, so the diff can be reviewed in a
+// spreadsheet. Rows are written as they are compared.
+func (s *DiffService) DiffCSV(ctx context.Context, input DiffInput) (func(w io.Writer) error, error) {
+	walk, err := s.prepare(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	return func(w io.Writer) error {
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"key", "column", "change", "base_value", "value", "base_confidence", "confidence", "confidence_delta"})
+		_, err := walk(func(row *models.RowDiff) error {
+			for _, cell := range row.Cells {
+				cw.Write([]string{
+					row.Key,
+					cell.Column,
+					string(cell.Change),
+					exportCell(cell.BaseValue),
+					exportCell(cell.Value),
+					formatScore(cell.BaseConfidence),
+					formatScore(cell.Confidence),
+					formatScore(cell.ConfidenceDelta),
+				})
+			}
+			return cw.Error()
+		})
+		if err != nil {
+			return err
+		}
+		cw.Flush()
+		return cw.Error()
+	}, nil
+}
+
+// diffWalk passes each differing row to visit in key order and returns the
+// column summaries of all rows.
+type diffWalk func(visit func(*models.RowDiff) error) ([]*models.ColumnDiffSummary, error)
+
+// prepare checks the input and returns a walk over both jobs' rows. Rows are
+// read a batch of keys at a time; rows without one of the requested changes
+// are counted in the summaries but not visited.
+func (s *DiffService) prepare(ctx context.Context, input DiffInput) (diffWalk, error) {
+	for _, change := range input.Changes {
+		switch change {
+		case models.DiffAdded, models.DiffRemoved, models.DiffChanged, models.DiffUnchanged:
+		default:
+			return nil, newValidationError(fmt.Sprintf("unknown change %q", change))
+		}
+	}
+	base, job, err := s.jobPair(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := diffColumns(base, job, input.Columns)
+	if err != nil {
+		return nil, err
+	}
+	return func(visit func(*models.RowDiff) error) ([]*models.ColumnDiffSummary, error) {
+		differ := newRowDiffer(columns)
+		var afterKey *string
+		for {
+			keys, err := s.store.GetJobPairKeys(ctx, base.JobID, job.JobID, afterKey, diffBatchSize)
+			if err != nil {
+				return nil, err
+			}
+			baseRows, err := s.store.GetRowStates(ctx, base.JobID, keys)
+			if err != nil {
+				return nil, err
+			}
+			rows, err := s.store.GetRowStates(ctx, job.JobID, keys)
+			if err != nil {
+				return nil, err
+			}
+			for _, key := range keys {
+				diff := filterDiffCells(differ.diff(key, baseRows[key], rows[key]), input.Changes)
+				if diff == nil {
+					continue
+				}
+				if err := visit(diff); err != nil {
+					return nil, err
+				}
+			}
+			if len(keys) < diffBatchSize {
+				return differ.summaries(), nil
+			}
+			afterKey = &keys[len(keys)-1]
+		}
+	}, nil
+}
+
+func (s *DiffService) jobPair(ctx context.Context, input DiffInput) (*models.Job, *models.Job, error) {
+	if input.BaseJobID == input.JobID {
+		return nil, nil, newValidationError("a job cannot be compared with itself")
+	}
+	var jobs [2]*models.Job
+	for i, id := range []string{input.BaseJobID, input.JobID} {
+		job, err := s.store.GetJob(ctx, id)
+		if err != nil {
+			return nil, nil, ErrJobNotFound
+		}
+		if job.UserID != input.UserID {
+			return nil, nil, ErrJobForbidden
+		}
+		jobs[i] = job
+	}
+	if jobs[0].SourceID != jobs[1].SourceID {
+		return nil, nil, newValidationError("jobs enrich different sources")
+	}
+	return jobs[0], jobs[1], nil
+}
+
+// diffColumns lists the columns compared: the job's enriched columns
+// followed by those only the base job had. A non-empty selection must name
+// columns of either job.
+func diffColumns(base, job *models.Job, selected []string) ([]string, error) {
+	var columns []string
+	for _, cols := range [][]*models.ColumnMetadata{job.ColumnsMetadata, base.ColumnsMetadata} {
+		for _, col := range cols {
+			if !slices.Contains(columns, col.Name) {
+				columns = append(columns, col.Name)
+			}
+		}
+	}
+	if len(selected) == 0 {
+		return columns, nil
+	}
+	for _, name := range selected {
+		if !slices.Contains(columns, name) {
+			return nil, newValidationError(fmt.Sprintf("column %q is not enriched by either job", name))
+		}
+	}
+	return slices.DeleteFunc(columns, func(name string) bool { return !slices.Contains(selected, name) }), nil
+}
+
+// rowDiffer compares the rows of two jobs one key at a time and sums up
+// the changes of each column. Cells empty in both jobs are left out, and
+// rows with no compared cells are not reported.
+type rowDiffer struct {
+	columns     []string
+	totals      []*models.ColumnDiffSummary
+	deltaSums   []float64
+	deltaCounts []int
+}
+
+func newRowDiffer(columns []string) *rowDiffer {
+	d := &rowDiffer{
+		columns:     columns,
+		totals:      make([]*models.ColumnDiffSummary, len(columns)),
+		deltaSums:   make([]float64, len(columns)),
+		deltaCounts: make([]int, len(columns)),
+	}
+	for i, col := range columns {
+		d.totals[i] = &models.ColumnDiffSummary{Column: col}
+	}
+	return d
+}
+
+// diff compares the rows of one key. A row only one job has counts as
+// added or removed.
+func (d *rowDiffer) diff(key string, base, row *models.RowState) *models.RowDiff {
+	diff := &models.RowDiff{Key: key}
+	for i, col := range d.columns {
+		cell := diffCell(col, base, row)
+		if cell == nil {
+			continue
+		}
+		countChange(d.totals[i], cell.Change)
+		if cell.ConfidenceDelta != nil {
+			d.deltaSums[i] += *cell.ConfidenceDelta
+			d.deltaCounts[i]++
+		}
+		diff.Cells = append(diff.Cells, cell)
+	}
+	if len(diff.Cells) == 0 {
+		return nil
+	}
+	return diff
+}
+
+func (d *rowDiffer) summaries() []*models.ColumnDiffSummary {
+	for i, summary := range d.totals {
+		if d.deltaCounts[i] > 0 {
+			mean := roundScore(d.deltaSums[i] / float64(d.deltaCounts[i]))
+			summary.MeanConfidenceDelta = &mean
+		}
+	}
+	return d.totals
+}
+
+func diffCell(column string, base, row *models.RowState) *models.CellDiff {
+	baseValue, baseScore := cellWithScore(base, column)
+	value, score := cellWithScore(row, column)
+	cell := &models.CellDiff{
+		Column:         column,
+		BaseValue:      baseValue,
+		Value:          value,
+		BaseConfidence: baseScore,
+		Confidence:     score,
+	}
+	switch {
+	case baseValue == nil && value == nil:
+		return nil
+	case baseValue == nil:
+		cell.Change = models.DiffAdded
+	case value == nil:
+		cell.Change = models.DiffRemoved
+	case reflect.DeepEqual(baseValue, value):
+		cell.Change = models.DiffUnchanged
+	default:
+		cell.Change = models.DiffChanged
+	}
+	if baseScore != nil && score != nil {
+		delta := roundScore(*score - *baseScore)
+		cell.ConfidenceDelta = &delta
+	}
+	return cell
+}
+
+func cellWithScore(row *models.RowState, column string) (interface{}, *float64) {
+	if row == nil {
+		return nil, nil
+	}
+	var score *float64
+	if c := row.Confidence[column]; c != nil {
+		score = &c.Score
+	}
+	return row.ExtractedData[column], score
+}
+
+func countChange(s *models.ColumnDiffSummary, change models.DiffChange) {
+	switch change {
+	case models.DiffAdded:
+		s.Added++
+	case models.DiffRemoved:
+		s.Removed++
+	case models.DiffChanged:
+		s.Changed++
+	case models.DiffUnchanged:
+		s.Unchanged++
+	}
+}
+
+// filterDiffCells keeps the cells with one of the given changes and drops
+// a row left without cells. No changes keeps everything.
+func filterDiffCells(diff *models.RowDiff, changes []models.DiffChange) *models.RowDiff {
+	if diff == nil || len(changes) == 0 {
+		return diff
+	}
+	var cells []*models.CellDiff
+	for _, cell := range diff.Cells {
+		if slices.Contains(changes, cell.Change) {
+			cells = append(cells, cell)
+		}
+	}
+	if len(cells) == 0 {
+		return nil
+	}
+	return &models.RowDiff{Key: diff.Key, Cells: cells}
+}
+
+// roundScore drops the float noise of subtracting scores, which are given
+// to two decimals.
+func roundScore(v float64) float64 {
+	return math.Round(v*1e4) / 1e4
+}
+
+func formatScore(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return strconv.FormatFloat(*v, 'f', -1, 64)
+}
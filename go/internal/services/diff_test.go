@@ -0,0 +1,222 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/state"
+	"github.com/google/uuid"
+)
+
+func TestRowDiffer(t *testing.T) {
+	score := func(v float64) *float64 { return &v }
+	row := func(key string, data map[string]interface{}, scores map[string]float64) *models.RowState {
+		conf := make(map[string]*models.FieldConfidenceInfo)
+		for k, v := range scores {
+			conf[k] = &models.FieldConfidenceInfo{Score: v}
+		}
+		return &models.RowState{Key: key, ExtractedData: data, Confidence: conf}
+	}
+	base := map[string]*models.RowState{
+		"Acme":   row("Acme", map[string]interface{}{"ceo": "Jane", "revenue": 1.0e6, "city": "Berlin"}, map[string]float64{"ceo": 0.8, "revenue": 0.6}),
+		"Globex": row("Globex", map[string]interface{}{"ceo": "Hank"}, nil),
+		"Hooli":  row("Hooli", map[string]interface{}{"ceo": nil}, nil),
+	}
+	rows := map[string]*models.RowState{
+		"Acme":    row("Acme", map[string]interface{}{"ceo": "Jane", "revenue": 1.2e6, "tags": []interface{}{"b2b"}}, map[string]float64{"ceo": 0.9, "revenue": 0.7}),
+		"Hooli":   row("Hooli", nil, nil),
+		"Initech": row("Initech", map[string]interface{}{"ceo": "Bill", "revenue": nil}, nil),
+	}
+	columns := []string{"ceo", "revenue", "city", "tags"}
+
+	differ := newRowDiffer(columns)
+	var diffs []*models.RowDiff
+	for _, key := range []string{"Acme", "Globex", "Hooli", "Initech"} {
+		if diff := differ.diff(key, base[key], rows[key]); diff != nil {
+			diffs = append(diffs, diff)
+		}
+	}
+	summaries := differ.summaries()
+
+	wantDiffs := []*models.RowDiff{
+		{Key: "Acme", Cells: []*models.CellDiff{
+			{Column: "ceo", Change: models.DiffUnchanged, BaseValue: "Jane", Value: "Jane", BaseConfidence: score(0.8), Confidence: score(0.9), ConfidenceDelta: score(0.1)},
+			{Column: "revenue", Change: models.DiffChanged, BaseValue: 1.0e6, Value: 1.2e6, BaseConfidence: score(0.6), Confidence: score(0.7), ConfidenceDelta: score(0.1)},
+			{Column: "city", Change: models.DiffRemoved, BaseValue: "Berlin"},
+			{Column: "tags", Change: models.DiffAdded, Value: []interface{}{"b2b"}},
+		}},
+		{Key: "Globex", Cells: []*models.CellDiff{
+			{Column: "ceo", Change: models.DiffRemoved, BaseValue: "Hank"},
+		}},
+		{Key: "Initech", Cells: []*models.CellDiff{
+			{Column: "ceo", Change: models.DiffAdded, Value: "Bill"},
+		}},
+	}
+	if !reflect.DeepEqual(diffs, wantDiffs) {
+		for i, d := range diffs {
+			for _, c := range d.Cells {
+				t.Logf("row %d: %+v", i, *c)
+			}
+		}
+		t.Fatalf("diff() rows differ from want")
+	}
+
+	wantSummaries := []*models.ColumnDiffSummary{
+		{Column: "ceo", Added: 1, Removed: 1, Unchanged: 1, MeanConfidenceDelta: score(0.1)},
+		{Column: "revenue", Changed: 1, MeanConfidenceDelta: score(0.1)},
+		{Column: "city", Removed: 1},
+		{Column: "tags", Added: 1},
+	}
+	for i, s := range summaries {
+		if !reflect.DeepEqual(s, wantSummaries[i]) {
+			t.Errorf("summary %s = %+v, want %+v", s.Column, *s, *wantSummaries[i])
+		}
+	}
+
+	var keys []string
+	for _, diff := range diffs {
+		d := filterDiffCells(diff, []models.DiffChange{models.DiffAdded})
+		if d == nil {
+			continue
+		}
+		keys = append(keys, d.Key)
+		if len(d.Cells) != 1 || d.Cells[0].Change != models.DiffAdded {
+			t.Errorf("filtered row %s kept cells %+v", d.Key, d.Cells)
+		}
+	}
+	if want := []string{"Acme", "Initech"}; !reflect.DeepEqual(keys, want) {
+		t.Errorf("filtered keys = %v, want %v", keys, want)
+	}
+}
+
+func TestDiffColumns(t *testing.T) {
+	base := &models.Job{ColumnsMetadata: []*models.ColumnMetadata{{Name: "ceo"}, {Name: "city"}}}
+	job := &models.Job{ColumnsMetadata: []*models.ColumnMetadata{{Name: "revenue"}, {Name: "ceo"}}}
+	tests := []struct {
+		name     string
+		selected []string
+		want     []string
+		wantErr  bool
+	}{
+		{"all columns", nil, []string{"revenue", "ceo", "city"}, false},
+		{"selection keeps job order", []string{"city", "revenue"}, []string{"revenue", "city"}, false},
+		{"unknown column", []string{"founded"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := diffColumns(base, job, tt.selected)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("diffColumns() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("diffColumns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// diffStore holds the rows of two jobs for DiffService.
+type diffStore struct {
+	state.Store
+	jobs    map[string]*models.Job
+	rows    map[string]map[string]*models.RowState
+	version state.RowsVersion
+}
+
+func (f *diffStore) GetJob(_ context.Context, jobID string) (*models.Job, error) {
+	return f.jobs[jobID], nil
+}
+
+func (f *diffStore) GetRowsVersion(context.Context, []string) (state.RowsVersion, error) {
+	return f.version, nil
+}
+
+func (f *diffStore) GetJobPairKeys(_ context.Context, baseJobID, jobID string, afterKey *string, limit int) ([]string, error) {
+	var keys []string
+	for _, id := range []string{baseJobID, jobID} {
+		for key := range f.rows[id] {
+			if (afterKey == nil || key > *afterKey) && !slices.Contains(keys, key) {
+				keys = append(keys, key)
+			}
+		}
+	}
+	slices.Sort(keys)
+	return keys[:min(limit, len(keys))], nil
+}
+
+func (f *diffStore) GetRowStates(_ context.Context, jobID string, keys []string) (map[string]*models.RowState, error) {
+	rows := make(map[string]*models.RowState)
+	for _, key := range keys {
+		if row, ok := f.rows[jobID][key]; ok {
+			rows[key] = row
+		}
+	}
+	return rows, nil
+}
+
+func TestDiff_CachesTotals(t *testing.T) {
+	sourceID := uuid.New()
+	cols := []*models.ColumnMetadata{{Name: "ceo"}}
+	store := &diffStore{
+		jobs: map[string]*models.Job{
+			"base": {JobID: "base", UserID: "u", SourceID: sourceID, ColumnsMetadata: cols},
+			"job":  {JobID: "job", UserID: "u", SourceID: sourceID, ColumnsMetadata: cols},
+		},
+		rows:    map[string]map[string]*models.RowState{"base": {}, "job": {}},
+		version: state.RowsVersion{Count: 10, UpdatedAt: time.Unix(100, 0)},
+	}
+	for i := range 5 {
+		key := fmt.Sprintf("row-%d", i)
+		store.rows["base"][key] = &models.RowState{Key: key, ExtractedData: map[string]interface{}{"ceo": "Jane"}}
+		store.rows["job"][key] = &models.RowState{Key: key, ExtractedData: map[string]interface{}{"ceo": "Hank"}}
+	}
+	svc := NewDiffService(store)
+	input := DiffInput{BaseJobID: "base", JobID: "job", UserID: "u", Limit: 2}
+
+	first, err := svc.Diff(context.Background(), input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Pagination.Total != 5 || first.Columns[0].Changed != 5 {
+		t.Fatalf("first page: total %d, changed %d, want 5 and 5", first.Pagination.Total, first.Columns[0].Changed)
+	}
+
+	input.Offset = 2
+	second, err := svc.Diff(context.Background(), input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := []string{second.Rows[0].Key, second.Rows[1].Key}; !slices.Equal(got, []string{"row-2", "row-3"}) {
+		t.Errorf("second page rows = %v", got)
+	}
+	if second.Pagination.Total != 5 || !second.Pagination.HasMore || second.Columns[0].Changed != 5 {
+		t.Errorf("second page: %+v, changed %d", second.Pagination, second.Columns[0].Changed)
+	}
+
+	// A changed row bumps the version, so the totals are computed again.
+	store.rows["job"]["row-4"] = &models.RowState{Key: "row-4", ExtractedData: map[string]interface{}{"ceo": "Jane"}}
+	store.version.UpdatedAt = time.Unix(200, 0)
+	third, err := svc.Diff(context.Background(), input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if third.Columns[0].Changed != 4 || third.Columns[0].Unchanged != 1 {
+		t.Errorf("after update: %+v", third.Columns[0])
+	}
+}
+
+func TestDiffTotalsKey(t *testing.T) {
+	a := diffTotalsKey(DiffInput{BaseJobID: "base", JobID: "job", Columns: []string{"ceo", "revenue"}, Changes: []models.DiffChange{models.DiffAdded, models.DiffChanged}})
+	b := diffTotalsKey(DiffInput{BaseJobID: "base", JobID: "job", Columns: []string{"revenue", "ceo"}, Changes: []models.DiffChange{models.DiffChanged, models.DiffAdded}})
+	if a != b {
+		t.Errorf("keys differ for reordered filters: %q, %q", a, b)
+	}
+	if c := diffTotalsKey(DiffInput{BaseJobID: "base", JobID: "job", Columns: []string{"ceo"}}); c == a {
+		t.Errorf("key %q shared by different columns", c)
+	}
+}
@@ -0,0 +1,552 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/blagoySimandov/ampledata/go/internal/blob"
+	"github.com/blagoySimandov/ampledata/go/internal/gcs"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/state"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrJobNotFound    = errors.New("job not found")
+	ErrJobForbidden   = errors.New("forbidden")
+	ErrExportNotFound = errors.New("export not found")
+)
+
+// exportBatchSize is how many source rows are joined to their results per
+// store query.
+const exportBatchSize = 500
+
+// exportURLExpiry is how long a download link stays valid.
+const exportURLExpiry = 15 * time.Minute
+
+// Names of the optional row-level export columns.
+const (
+	exportSourcesColumn = "enrichment_sources"
+	exportErrorColumn   = "enrichment_error"
+)
+
+// IExportRunner builds an export in the background.
+type IExportRunner interface {
+	StartExport(ctx context.Context, exportID uuid.UUID) error
+}
+
+// ISourceRowOpener streams the rows of the source revision a job read.
+type ISourceRowOpener interface {
+	OpenSourceRevisionRows(ctx context.Context, source *models.Source, revision int) (gcs.RowIterator, error)
+}
+
+// exportFormat describes how an export is encoded and stored.
+type exportFormat struct {
+	extension   string
+	contentType string
+	newWriter   func(w io.Writer, layout *exportLayout, dialect *models.CSVDialect) exportWriter
+}
+
+var exportFormats = map[models.ExportFormat]exportFormat{
+	models.ExportFormatCSV:     {extension: "csv", contentType: gcs.ContentTypeCSV, newWriter: newCSVExportWriter},
+	models.ExportFormatXLSX:    {extension: "xlsx", contentType: gcs.ContentTypeXLSX, newWriter: newXLSXExportWriter},
+	models.ExportFormatNDJSON:  {extension: "ndjson", contentType: contentTypeNDJSON, newWriter: newNDJSONExportWriter},
+	models.ExportFormatParquet: {extension: "parquet", contentType: contentTypeParquet, newWriter: newParquetExportWriter},
+}
+
+// exportWriter encodes the rows of an export, header first.
+type exportWriter interface {
+	WriteHeader(headers []string) error
+	WriteRow(row *exportRow) error
+	// Flush finishes the file; it does not close the underlying writer.
+	Flush() error
+	// Close releases temporary storage. It is safe to call after Flush.
+	Close() error
+}
+
+// exportRow is a source row merged with its result.
+type exportRow struct {
+	cells []string
+	// state is the row's result, nil when it has none.
+	state *models.RowState
+	// enriched lists the cells that were filled from the result.
+	enriched []enrichedCell
+}
+
+type enrichedCell struct {
+	index  int
+	column string
+}
+
+// ExportDownload is an export and, once it has been built, a link to it.
+type ExportDownload struct {
+	Export    *models.Export
+	URL       *string
+	ExpiresAt *time.Time
+}
+
+// ExportService creates exports of job results and hands out download links.
+type ExportService struct {
+	store  state.Store
+	blobs  blob.Store
+	runner IExportRunner
+}
+
+func NewExportService(store state.Store, blobs blob.Store, runner IExportRunner) *ExportService {
+	return &ExportService{store: store, blobs: blobs, runner: runner}
+}
+
+// CreateExport records an export of a job and starts building it.
+func (s *ExportService) CreateExport(ctx context.Context, jobID, userID string, format models.ExportFormat, opts models.ExportOptions) (*models.Export, error) {
+	if _, ok := exportFormats[format]; !ok {
+		return nil, newValidationError(fmt.Sprintf("unsupported export format %q", format))
+	}
+	job, err := s.store.GetJob(ctx, jobID)
+	if err != nil {
+		return nil, ErrJobNotFound
+	}
+	if job.UserID != userID {
+		return nil, ErrJobForbidden
+	}
+	if job.Source == nil {
+		return nil, newValidationError("job has no source to export")
+	}
+
+	exportDB := &models.ExportDB{
+		ID:        uuid.New(),
+		JobID:     jobID,
+		UserID:    userID,
+		Format:    format,
+		Options:   opts,
+		Status:    models.ExportStatusPending,
+		CreatedAt: time.Now(),
+	}
+	if err := s.store.CreateExport(ctx, exportDB); err != nil {
+		return nil, err
+	}
+	if err := s.runner.StartExport(ctx, exportDB.ID); err != nil {
+		msg := err.Error()
+		if finishErr := s.store.FinishExport(ctx, exportDB.ID, "", 0, &msg); finishErr != nil {
+			return nil, errors.Join(err, finishErr)
+		}
+		return nil, err
+	}
+	return exportDB.ToExport(), nil
+}
+
+// GetExport returns an export with a download link once it is completed.
+func (s *ExportService) GetExport(ctx context.Context, exportID uuid.UUID, userID string) (*ExportDownload, error) {
+	export, err := s.store.GetExport(ctx, exportID)
+	if err != nil {
+		return nil, ErrExportNotFound
+	}
+	if export.UserID != userID {
+		return nil, ErrJobForbidden
+	}
+	download := &ExportDownload{Export: export}
+	if export.Status != models.ExportStatusCompleted || export.ObjectName == nil {
+		return download, nil
+	}
+	filename := fmt.Sprintf("enriched-%s.%s", export.JobID, exportFormats[export.Format].extension)
+	url, err := s.blobs.SignedDownloadURL(ctx, *export.ObjectName, filename, exportURLExpiry)
+	if err != nil {
+		return nil, err
+	}
+	expiresAt := time.Now().Add(exportURLExpiry)
+	download.URL, download.ExpiresAt = &url, &expiresAt
+	return download, nil
+}
+
+// ListExports returns the exports of a job, newest first.
+func (s *ExportService) ListExports(ctx context.Context, jobID, userID string) ([]*models.Export, error) {
+	job, err := s.store.GetJob(ctx, jobID)
+	if err != nil {
+		return nil, ErrJobNotFound
+	}
+	if job.UserID != userID {
+		return nil, ErrJobForbidden
+	}
+	return s.store.GetExportsByJob(ctx, jobID)
+}
+
+// Exporter builds export files: it streams the job's source, joins every
+// row to its result by composite key and writes the merged rows to blob
+// storage.
+type Exporter struct {
+	store   state.Store
+	sources ISourceRowOpener
+	blobs   blob.Store
+}
+
+func NewExporter(store state.Store, sources ISourceRowOpener, blobs blob.Store) *Exporter {
+	return &Exporter{store: store, sources: sources, blobs: blobs}
+}
+
+// BuildExport writes the file of an export and records the outcome. It
+// returns the number of rows written.
+func (e *Exporter) BuildExport(ctx context.Context, exportID uuid.UUID) (int, error) {
+	export, err := e.store.GetExport(ctx, exportID)
+	if err != nil {
+		return 0, err
+	}
+	if err := e.store.SetExportStatus(ctx, exportID, models.ExportStatusRunning); err != nil {
+		return 0, err
+	}
+	objectName, rows, err := e.build(ctx, export)
+	if err != nil {
+		msg := err.Error()
+		if finishErr := e.store.FinishExport(ctx, exportID, "", 0, &msg); finishErr != nil {
+			return 0, errors.Join(err, finishErr)
+		}
+		return 0, err
+	}
+	return rows, e.store.FinishExport(ctx, exportID, objectName, rows, nil)
+}
+
+func (e *Exporter) build(ctx context.Context, export *models.Export) (string, int, error) {
+	format, ok := exportFormats[export.Format]
+	if !ok {
+		return "", 0, fmt.Errorf("unsupported export format %q", export.Format)
+	}
+	job, err := e.store.GetJob(ctx, export.JobID)
+	if err != nil {
+		return "", 0, err
+	}
+	if job.Source == nil {
+		return "", 0, fmt.Errorf("job %s has no source", job.JobID)
+	}
+	it, err := e.sources.OpenSourceRevisionRows(ctx, job.Source, job.SourceRevision)
+	if err != nil {
+		return "", 0, err
+	}
+	defer it.Close()
+	layout, err := newExportLayout(it.Headers(), job, export.Options)
+	if err != nil {
+		return "", 0, err
+	}
+	var dialect *models.CSVDialect
+	if meta, ok := job.Source.Metadata.(*models.CSVSourceMetadata); ok {
+		dialect = meta.RevisionDialect(job.SourceRevision)
+	}
+
+	objectName := path.Join("exports", job.JobID, export.ID.String()+"."+format.extension)
+	pr, pw := io.Pipe()
+	rows := 0
+	done := make(chan error, 1)
+	go func() {
+		var err error
+		w := format.newWriter(pw, layout, dialect)
+		rows, err = writeExportRows(ctx, it, layout, e.lookupRows(job), w)
+		if closeErr := w.Close(); err == nil {
+			err = closeErr
+		}
+		pw.CloseWithError(err)
+		done <- err
+	}()
+	writeErr := e.blobs.Write(ctx, objectName, format.contentType, pr)
+	// Unblock the row writer if the upload stopped reading early.
+	pr.CloseWithError(writeErr)
+	rowsErr := <-done
+	// A failed row read also fails the upload, which then reports it.
+	if writeErr != nil {
+		return "", 0, writeErr
+	}
+	if rowsErr != nil {
+		return "", 0, rowsErr
+	}
+	return objectName, rows, nil
+}
+
+// lookupRows fetches the results of a batch of source row keys. Rows merged
+// by key normalization share the result of their canonical row.
+func (e *Exporter) lookupRows(job *models.Job) rowLookup {
+	return func(ctx context.Context, keys []string) (map[string]*models.RowState, error) {
+		canonical := make([]string, 0, len(keys))
+		seen := make(map[string]bool, len(keys))
+		for _, key := range keys {
+			if target, ok := job.KeyMapping[key]; ok {
+				key = target
+			}
+			if !seen[key] {
+				seen[key] = true
+				canonical = append(canonical, key)
+			}
+		}
+		states, err := e.store.GetRowStates(ctx, job.JobID, canonical)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			if target, ok := job.KeyMapping[key]; ok {
+				states[key] = states[target]
+			}
+		}
+		return states, nil
+	}
+}
+
+type rowLookup func(ctx context.Context, keys []string) (map[string]*models.RowState, error)
+
+// writeExportRows joins source rows to their results in batches and writes
+// them after the header. Rows without a result keep empty enriched cells.
+func writeExportRows(ctx context.Context, it gcs.RowIterator, layout *exportLayout, lookup rowLookup, w exportWriter) (int, error) {
+	if err := w.WriteHeader(layout.headers); err != nil {
+		return 0, err
+	}
+	written := 0
+	batch := make([][]string, 0, exportBatchSize)
+	flush := func() error {
+		keys := make([]string, len(batch))
+		for i, cells := range batch {
+			keys[i] = layout.key(cells)
+		}
+		states, err := lookup(ctx, keys)
+		if err != nil {
+			return err
+		}
+		for i, cells := range batch {
+			if err := w.WriteRow(layout.row(cells, states[keys[i]])); err != nil {
+				return err
+			}
+		}
+		written += len(batch)
+		batch = batch[:0]
+		return nil
+	}
+
+	for {
+		row, err := it.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return written, err
+		}
+		batch = append(batch, row.Cells)
+		if len(batch) == exportBatchSize {
+			if err := flush(); err != nil {
+				return written, err
+			}
+		}
+	}
+	if err := flush(); err != nil {
+		return written, err
+	}
+	return written, w.Flush()
+}
+
+// exportLayout places a job's results next to the source columns. Enriched
+// columns that already exist in the source, such as imputed ones, fill that
+// column's empty cells; the others are appended. Optional confidence columns
+// follow each enriched column, and sources and error columns end the row.
+type exportLayout struct {
+	headers []string
+	// fields describes each header for formats that keep value types.
+	fields      []exportField
+	sourceWidth int
+	keyIndices  []int
+	columns     []exportColumn
+	opts        models.ExportOptions
+}
+
+// exportField is what a cell of the merged row holds.
+type exportField struct {
+	kind exportFieldKind
+	// column is the enriched column of value and confidence fields.
+	column *models.ColumnMetadata
+}
+
+type exportFieldKind int
+
+const (
+	exportFieldSource exportFieldKind = iota
+	exportFieldValue
+	exportFieldConfidence
+	exportFieldCitationURL
+	exportFieldCitationQuote
+	exportFieldSources
+	exportFieldError
+)
+
+type exportColumn struct {
+	name string
+	// sourceIndex is the source column the values fill, or -1 when appended.
+	sourceIndex int
+	// cell is the index of the cell holding the values.
+	cell int
+}
+
+func newExportLayout(sourceHeaders []string, job *models.Job, opts models.ExportOptions) (*exportLayout, error) {
+	keyIndices, err := gcs.FindColumnIndices(sourceHeaders, job.KeyColumns)
+	if err != nil {
+		return nil, fmt.Errorf("source no longer matches the job: %w", err)
+	}
+	l := &exportLayout{
+		headers:     slices.Clone(sourceHeaders),
+		fields:      make([]exportField, len(sourceHeaders)),
+		sourceWidth: len(sourceHeaders),
+		keyIndices:  keyIndices,
+		opts:        opts,
+	}
+	for _, col := range job.ColumnsMetadata {
+		c := exportColumn{name: col.Name, sourceIndex: slices.Index(sourceHeaders, col.Name)}
+		c.cell = c.sourceIndex
+		if c.sourceIndex < 0 {
+			c.cell = len(l.headers)
+			l.add(col.Name, exportField{kind: exportFieldValue, column: col})
+		} else {
+			l.fields[c.sourceIndex] = exportField{kind: exportFieldValue, column: col}
+		}
+		if opts.IncludeConfidence {
+			l.add(col.Name+"_confidence", exportField{kind: exportFieldConfidence, column: col})
+		}
+		if opts.IncludeCitations {
+			l.add(col.Name+"_citation_url", exportField{kind: exportFieldCitationURL, column: col})
+			l.add(col.Name+"_citation_quote", exportField{kind: exportFieldCitationQuote, column: col})
+		}
+		l.columns = append(l.columns, c)
+	}
+	if opts.IncludeSources {
+		l.add(exportSourcesColumn, exportField{kind: exportFieldSources})
+	}
+	if opts.IncludeError {
+		l.add(exportErrorColumn, exportField{kind: exportFieldError})
+	}
+	return l, nil
+}
+
+func (l *exportLayout) add(header string, field exportField) {
+	l.headers = append(l.headers, header)
+	l.fields = append(l.fields, field)
+}
+
+func (l *exportLayout) key(cells []string) string {
+	return gcs.CompositeKey(cells, l.keyIndices)
+}
+
+// row merges a source row with its result, which may be nil.
+func (l *exportLayout) row(cells []string, result *models.RowState) *exportRow {
+	out := make([]string, l.sourceWidth, len(l.headers))
+	copy(out, cells)
+	row := &exportRow{state: result}
+	state := result
+	if state == nil {
+		state = &models.RowState{}
+	}
+	for _, col := range l.columns {
+		raw := state.ExtractedData[col.name]
+		value := exportCell(raw)
+		if col.sourceIndex < 0 {
+			out = append(out, "")
+		}
+		if out[col.cell] == "" && raw != nil {
+			out[col.cell] = value
+			row.enriched = append(row.enriched, enrichedCell{index: col.cell, column: col.name})
+		}
+		if l.opts.IncludeConfidence {
+			confidence := ""
+			if c := state.Confidence[col.name]; c != nil {
+				confidence = strconv.FormatFloat(c.Score, 'f', -1, 64)
+			}
+			out = append(out, confidence)
+		}
+		if l.opts.IncludeCitations {
+			url, quote := citationCells(state.Confidence[col.name])
+			out = append(out, url, quote)
+		}
+	}
+	if l.opts.IncludeSources {
+		out = append(out, strings.Join(state.Sources, " | "))
+	}
+	if l.opts.IncludeError {
+		out = append(out, Deref(state.Error))
+	}
+	row.cells = out
+	return row
+}
+
+// citationCells returns the citation URL and quote of an enriched cell.
+// Quotes that were not found in the fetched pages are left out.
+func citationCells(c *models.FieldConfidenceInfo) (string, string) {
+	if c == nil || c.Citation == nil {
+		return "", ""
+	}
+	if !c.Citation.Verified {
+		return c.Citation.URL, ""
+	}
+	return c.Citation.URL, c.Citation.Quote
+}
+
+// exportCell renders an enriched value as text. Lists and objects are
+// written as JSON.
+func exportCell(v interface{}) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(t)
+	case map[string]interface{}, []interface{}:
+		encoded, err := json.Marshal(t)
+		if err != nil {
+			return fmt.Sprint(t)
+		}
+		return string(encoded)
+	default:
+		return fmt.Sprint(t)
+	}
+}
+
+type csvExportWriter struct {
+	w   io.Writer
+	csv *csv.Writer
+	bom bool
+}
+
+// newCSVExportWriter writes UTF-8 CSV. Exports of CSV sources keep the
+// source's delimiter and byte order mark.
+func newCSVExportWriter(w io.Writer, _ *exportLayout, dialect *models.CSVDialect) exportWriter {
+	cw := csv.NewWriter(w)
+	bom := false
+	if dialect != nil {
+		if r, _ := utf8.DecodeRuneInString(dialect.Delimiter); r != utf8.RuneError {
+			cw.Comma = r
+		}
+		bom = dialect.BOM
+	}
+	return &csvExportWriter{w: w, csv: cw, bom: bom}
+}
+
+func (w *csvExportWriter) WriteHeader(headers []string) error {
+	if w.bom {
+		if _, err := w.w.Write([]byte("\uFEFF")); err != nil {
+			return err
+		}
+		w.bom = false
+	}
+	return w.csv.Write(headers)
+}
+
+func (w *csvExportWriter) WriteRow(row *exportRow) error {
+	return w.csv.Write(row.cells)
+}
+
+func (w *csvExportWriter) Flush() error {
+	w.csv.Flush()
+	return w.csv.Error()
+}
+
+func (w *csvExportWriter) Close() error { return nil }
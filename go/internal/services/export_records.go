@@ -0,0 +1,285 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/parquet"
+)
+
+const (
+	contentTypeNDJSON  = "application/x-ndjson"
+	contentTypeParquet = "application/vnd.apache.parquet"
+	secondsPerDay      = 24 * 60 * 60
+)
+
+// typedValue returns cell i of a row for formats that keep value types, nil
+// when it is empty. Enriched values keep the type of their column: numbers,
+// booleans, ISO 8601 dates and lists of text. Source cells stay text, except
+// in enriched columns the source already had, where they are parsed as the
+// column's type. Confidence scores are numbers and sources a list of URLs.
+func (r *exportRow) typedValue(f exportField, i int) interface{} {
+	state := r.state
+	if state == nil {
+		state = &models.RowState{}
+	}
+	switch f.kind {
+	case exportFieldValue:
+		if r.isEnriched(i) {
+			return typedColumnValue(f.column.Type, state.ExtractedData[f.column.Name])
+		}
+		return typedColumnValue(f.column.Type, r.cells[i])
+	case exportFieldConfidence:
+		if c := state.Confidence[f.column.Name]; c != nil {
+			return c.Score
+		}
+		return nil
+	case exportFieldCitationURL, exportFieldCitationQuote:
+		if r.cells[i] == "" {
+			return nil
+		}
+		return r.cells[i]
+	case exportFieldSources:
+		if len(state.Sources) == 0 {
+			return nil
+		}
+		sources := make([]interface{}, len(state.Sources))
+		for i, s := range state.Sources {
+			sources[i] = s
+		}
+		return sources
+	case exportFieldError:
+		if state.Error == nil {
+			return nil
+		}
+		return *state.Error
+	default:
+		return r.cells[i]
+	}
+}
+
+func (r *exportRow) isEnriched(i int) bool {
+	for _, e := range r.enriched {
+		if e.index == i {
+			return true
+		}
+	}
+	return false
+}
+
+// typedColumnValue converts an extracted value or a source cell to the type
+// of a column. Values that do not convert are dropped.
+func typedColumnValue(colType models.ColumnType, v interface{}) interface{} {
+	if s, ok := v.(string); ok && strings.TrimSpace(s) == "" {
+		return nil
+	}
+	switch colType {
+	case models.ColumnTypeNumber:
+		switch t := v.(type) {
+		case float64:
+			return t
+		case string:
+			if f, err := strconv.ParseFloat(strings.TrimSpace(t), 64); err == nil {
+				return f
+			}
+		}
+		return nil
+	case models.ColumnTypeBoolean:
+		switch t := v.(type) {
+		case bool:
+			return t
+		case string:
+			if b, err := strconv.ParseBool(strings.TrimSpace(t)); err == nil {
+				return b
+			}
+		}
+		return nil
+	case models.ColumnTypeDate:
+		s, ok := v.(string)
+		if !ok {
+			return nil
+		}
+		if _, err := time.Parse(time.DateOnly, strings.TrimSpace(s)); err != nil {
+			return nil
+		}
+		return strings.TrimSpace(s)
+	case models.ColumnTypeList:
+		switch t := v.(type) {
+		case nil:
+			return nil
+		case []interface{}:
+			items := make([]interface{}, 0, len(t))
+			for _, item := range t {
+				if item != nil {
+					items = append(items, exportCell(item))
+				}
+			}
+			if len(items) == 0 {
+				return nil
+			}
+			return items
+		default:
+			return []interface{}{exportCell(t)}
+		}
+	default:
+		if v == nil {
+			return nil
+		}
+		return exportCell(v)
+	}
+}
+
+// recordFieldNames makes headers usable as field names: empty headers are
+// named after their position and repeated ones get a numeric suffix.
+func recordFieldNames(headers []string) []string {
+	names := make([]string, len(headers))
+	seen := make(map[string]bool, len(headers))
+	for i, h := range headers {
+		name := h
+		if strings.TrimSpace(name) == "" {
+			name = fmt.Sprintf("column_%d", i+1)
+		}
+		base := name
+		for n := 2; seen[name]; n++ {
+			name = fmt.Sprintf("%s_%d", base, n)
+		}
+		seen[name] = true
+		names[i] = name
+	}
+	return names
+}
+
+type ndjsonExportWriter struct {
+	w      *bufio.Writer
+	layout *exportLayout
+	names  [][]byte
+	buf    bytes.Buffer
+}
+
+// newNDJSONExportWriter writes one JSON object per row, with fields in
+// header order.
+func newNDJSONExportWriter(w io.Writer, layout *exportLayout, _ *models.CSVDialect) exportWriter {
+	return &ndjsonExportWriter{w: bufio.NewWriter(w), layout: layout}
+}
+
+func (w *ndjsonExportWriter) WriteHeader(headers []string) error {
+	for _, name := range recordFieldNames(headers) {
+		encoded, err := json.Marshal(name)
+		if err != nil {
+			return err
+		}
+		w.names = append(w.names, encoded)
+	}
+	return nil
+}
+
+func (w *ndjsonExportWriter) WriteRow(row *exportRow) error {
+	w.buf.Reset()
+	w.buf.WriteByte('{')
+	for i, name := range w.names {
+		if i > 0 {
+			w.buf.WriteByte(',')
+		}
+		w.buf.Write(name)
+		w.buf.WriteByte(':')
+		encoded, err := json.Marshal(row.typedValue(w.layout.fields[i], i))
+		if err != nil {
+			return err
+		}
+		w.buf.Write(encoded)
+	}
+	w.buf.WriteString("}\n")
+	_, err := w.w.Write(w.buf.Bytes())
+	return err
+}
+
+func (w *ndjsonExportWriter) Flush() error { return w.w.Flush() }
+
+func (w *ndjsonExportWriter) Close() error { return nil }
+
+type parquetExportWriter struct {
+	w      io.Writer
+	layout *exportLayout
+	pw     *parquet.Writer
+	row    []interface{}
+}
+
+// newParquetExportWriter writes a Parquet file typed from the job's column
+// metadata: numbers are doubles, dates are dates and lists are repeated.
+func newParquetExportWriter(w io.Writer, layout *exportLayout, _ *models.CSVDialect) exportWriter {
+	return &parquetExportWriter{w: w, layout: layout}
+}
+
+func (w *parquetExportWriter) WriteHeader(headers []string) error {
+	names := recordFieldNames(headers)
+	columns := make([]parquet.Column, len(names))
+	for i, name := range names {
+		columns[i] = parquetColumn(name, w.layout.fields[i])
+	}
+	pw, err := parquet.NewWriter(w.w, columns)
+	if err != nil {
+		return err
+	}
+	w.pw, w.row = pw, make([]interface{}, len(columns))
+	return nil
+}
+
+func parquetColumn(name string, f exportField) parquet.Column {
+	text := parquet.Column{Name: name, Type: parquet.ByteArray, Converted: parquet.UTF8}
+	switch f.kind {
+	case exportFieldConfidence:
+		return parquet.Column{Name: name, Type: parquet.Double, Converted: parquet.NoConversion}
+	case exportFieldSources:
+		text.Repeated = true
+		return text
+	case exportFieldValue:
+		switch f.column.Type {
+		case models.ColumnTypeNumber:
+			return parquet.Column{Name: name, Type: parquet.Double, Converted: parquet.NoConversion}
+		case models.ColumnTypeBoolean:
+			return parquet.Column{Name: name, Type: parquet.Boolean, Converted: parquet.NoConversion}
+		case models.ColumnTypeDate:
+			return parquet.Column{Name: name, Type: parquet.Int32, Converted: parquet.Date}
+		case models.ColumnTypeList:
+			text.Repeated = true
+		}
+	}
+	return text
+}
+
+func (w *parquetExportWriter) WriteRow(row *exportRow) error {
+	for i, f := range w.layout.fields {
+		v := row.typedValue(f, i)
+		if s, ok := v.(string); ok && f.kind == exportFieldValue && f.column.Type == models.ColumnTypeDate {
+			v = parquetDate(s)
+		}
+		w.row[i] = v
+	}
+	return w.pw.Write(w.row)
+}
+
+// parquetDate converts a date to a count of days since the Unix epoch.
+// Division rounds down, so dates before 1970 count backwards from it.
+func parquetDate(s string) interface{} {
+	date, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return nil
+	}
+	secs := date.Unix()
+	days := secs / secondsPerDay
+	if secs%secondsPerDay < 0 {
+		days--
+	}
+	return int32(days)
+}
+
+func (w *parquetExportWriter) Flush() error { return w.pw.Close() }
+
+func (w *parquetExportWriter) Close() error { return nil }
@@ -0,0 +1,152 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/parquet"
+)
+
+func TestTypedColumnValue(t *testing.T) {
+	tests := []struct {
+		name    string
+		colType models.ColumnType
+		value   interface{}
+		want    interface{}
+	}{
+		{"number", models.ColumnTypeNumber, 42.5, 42.5},
+		{"number from source text", models.ColumnTypeNumber, " 1200 ", 1200.0},
+		{"unparseable number", models.ColumnTypeNumber, "about 10", nil},
+		{"boolean", models.ColumnTypeBoolean, true, true},
+		{"boolean from source text", models.ColumnTypeBoolean, "false", false},
+		{"date", models.ColumnTypeDate, "2024-02-29", "2024-02-29"},
+		{"invalid date", models.ColumnTypeDate, "Feb 2024", nil},
+		{"list", models.ColumnTypeList, []interface{}{"a", nil, 2.0}, []interface{}{"a", "2"}},
+		{"empty list", models.ColumnTypeList, []interface{}{}, nil},
+		{"list from source text", models.ColumnTypeList, "a", []interface{}{"a"}},
+		{"string", models.ColumnTypeString, "Berlin", "Berlin"},
+		{"string from number", models.ColumnTypeString, 3.0, "3"},
+		{"empty text", models.ColumnTypeString, "  ", nil},
+		{"missing", models.ColumnTypeNumber, nil, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := typedColumnValue(tt.colType, tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("typedColumnValue(%q, %v) = %#v, want %#v", tt.colType, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParquetDate(t *testing.T) {
+	tests := []struct {
+		date string
+		want interface{}
+	}{
+		{"1970-01-01", int32(0)},
+		{"1970-01-02", int32(1)},
+		{"1969-12-31", int32(-1)},
+		{"1900-01-01", int32(-25567)},
+		{"2024-02-29", int32(19782)},
+		{"Feb 2024", nil},
+	}
+	for _, tt := range tests {
+		if got := parquetDate(tt.date); got != tt.want {
+			t.Errorf("parquetDate(%q) = %v, want %v", tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestRecordFieldNames(t *testing.T) {
+	got := recordFieldNames([]string{"name", "", "name", "name_2", " "})
+	want := []string{"name", "column_2", "name_2", "name_2_2", "column_5"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("recordFieldNames() = %q, want %q", got, want)
+	}
+}
+
+func typedExportFixture(t *testing.T) (func() *sliceIterator, *exportLayout, rowLookup) {
+	t.Helper()
+	failed := "no results found"
+	states := map[string]*models.RowState{
+		"Acme": {
+			ExtractedData: map[string]interface{}{"revenue": 1.5e6, "founded": "1999-03-01", "tags": []interface{}{"b2b", "saas"}},
+			Confidence:    map[string]*models.FieldConfidenceInfo{"revenue": {Score: 0.9}},
+			Sources:       []string{"https://acme.example"},
+		},
+		"Globex": {Error: &failed},
+	}
+	job := &models.Job{
+		KeyColumns: []string{"name"},
+		ColumnsMetadata: []*models.ColumnMetadata{
+			{Name: "revenue", Type: models.ColumnTypeNumber},
+			{Name: "founded", Type: models.ColumnTypeDate},
+			{Name: "tags", Type: models.ColumnTypeList},
+		},
+	}
+	source := func() *sliceIterator {
+		return &sliceIterator{
+			headers: []string{"name", "revenue"},
+			rows:    [][]string{{"Acme", ""}, {"Globex", "2000"}},
+		}
+	}
+	opts := models.ExportOptions{IncludeConfidence: true, IncludeSources: true, IncludeError: true}
+	layout, err := newExportLayout(source().Headers(), job, opts)
+	if err != nil {
+		t.Fatalf("newExportLayout() error: %v", err)
+	}
+	lookup := func(_ context.Context, keys []string) (map[string]*models.RowState, error) {
+		return states, nil
+	}
+	return source, layout, lookup
+}
+
+func TestNDJSONExportWriter(t *testing.T) {
+	source, layout, lookup := typedExportFixture(t)
+	var buf bytes.Buffer
+	if _, err := writeExportRows(context.Background(), source(), layout, lookup, newNDJSONExportWriter(&buf, layout, nil)); err != nil {
+		t.Fatalf("writeExportRows() error: %v", err)
+	}
+	want := `{"name":"Acme","revenue":1500000,"revenue_confidence":0.9,"founded":"1999-03-01","founded_confidence":null,"tags":["b2b","saas"],"tags_confidence":null,"enrichment_sources":["https://acme.example"],"enrichment_error":null}` + "\n" +
+		`{"name":"Globex","revenue":2000,"revenue_confidence":null,"founded":null,"founded_confidence":null,"tags":null,"tags_confidence":null,"enrichment_sources":null,"enrichment_error":"no results found"}` + "\n"
+	if buf.String() != want {
+		t.Errorf("ndjson =\n%s\nwant\n%s", buf.String(), want)
+	}
+}
+
+func TestParquetExportWriter(t *testing.T) {
+	source, layout, lookup := typedExportFixture(t)
+	var buf bytes.Buffer
+	w := newParquetExportWriter(&buf, layout, nil)
+	if _, err := writeExportRows(context.Background(), source(), layout, lookup, w); err != nil {
+		t.Fatalf("writeExportRows() error: %v", err)
+	}
+	data := buf.String()
+	if !strings.HasPrefix(data, "PAR1") || !strings.HasSuffix(data, "PAR1") {
+		t.Fatal("export is not a Parquet file")
+	}
+
+	want := map[string]parquet.Column{
+		"name":               {Type: parquet.ByteArray, Converted: parquet.UTF8},
+		"revenue":            {Type: parquet.Double, Converted: parquet.NoConversion},
+		"revenue_confidence": {Type: parquet.Double, Converted: parquet.NoConversion},
+		"founded":            {Type: parquet.Int32, Converted: parquet.Date},
+		"tags":               {Type: parquet.ByteArray, Converted: parquet.UTF8, Repeated: true},
+		"enrichment_sources": {Type: parquet.ByteArray, Converted: parquet.UTF8, Repeated: true},
+		"enrichment_error":   {Type: parquet.ByteArray, Converted: parquet.UTF8},
+	}
+	for i, header := range layout.headers {
+		col, ok := want[header]
+		if !ok {
+			continue
+		}
+		col.Name = header
+		if got := parquetColumn(header, layout.fields[i]); got != col {
+			t.Errorf("parquetColumn(%q) = %+v, want %+v", header, got, col)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+
+	"github.com/blagoySimandov/ampledata/go/internal/gcs"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+type sliceIterator struct {
+	headers []string
+	rows    [][]string
+	offset  int
+}
+
+func (it *sliceIterator) Headers() []string { return it.headers }
+
+func (it *sliceIterator) Next() (*gcs.SourceRow, error) {
+	if it.offset >= len(it.rows) {
+		return nil, io.EOF
+	}
+	row := &gcs.SourceRow{Offset: it.offset, Cells: it.rows[it.offset]}
+	it.offset++
+	return row, nil
+}
+
+func (it *sliceIterator) Close() error { return nil }
+
+func TestWriteExportRows(t *testing.T) {
+	failed := "no results found"
+	states := map[string]*models.RowState{
+		"Acme||DE": {
+			ExtractedData: map[string]interface{}{"revenue": 1.5e6, "city": "Berlin", "tags": []interface{}{"b2b"}},
+			Confidence: map[string]*models.FieldConfidenceInfo{
+				"revenue": {Score: 0.9, Citation: &models.Citation{URL: "https://acme.example", Quote: "Revenue: $1.5M", Verified: true}},
+			},
+			Sources: []string{"https://acme.example", "https://news.example/acme"},
+		},
+		"Globex||FR": {Error: &failed},
+	}
+	job := &models.Job{
+		KeyColumns:      []string{"name", "country"},
+		ColumnsMetadata: []*models.ColumnMetadata{{Name: "revenue"}, {Name: "city"}, {Name: "tags"}},
+	}
+	source := func() gcs.RowIterator {
+		return &sliceIterator{
+			headers: []string{"name", "country", "city"},
+			rows: [][]string{
+				{"Acme", "DE", ""},
+				{"Globex", "FR", "Paris"},
+				{"Initech", "US"},
+			},
+		}
+	}
+	lookup := func(_ context.Context, keys []string) (map[string]*models.RowState, error) {
+		found := make(map[string]*models.RowState)
+		for _, k := range keys {
+			if s, ok := states[k]; ok {
+				found[k] = s
+			}
+		}
+		return found, nil
+	}
+
+	tests := []struct {
+		name    string
+		opts    models.ExportOptions
+		dialect *models.CSVDialect
+		want    string
+	}{
+		{
+			name: "values only",
+			want: "name,country,city,revenue,tags\n" +
+				"Acme,DE,Berlin,1500000,\"[\"\"b2b\"\"]\"\n" +
+				"Globex,FR,Paris,,\n" +
+				"Initech,US,,,\n",
+		},
+		{
+			name: "confidence, sources and error",
+			opts: models.ExportOptions{IncludeConfidence: true, IncludeSources: true, IncludeError: true},
+			want: "name,country,city,revenue,revenue_confidence,city_confidence,tags,tags_confidence,enrichment_sources,enrichment_error\n" +
+				"Acme,DE,Berlin,1500000,0.9,,\"[\"\"b2b\"\"]\",,https://acme.example | https://news.example/acme,\n" +
+				"Globex,FR,Paris,,,,,,,no results found\n" +
+				"Initech,US,,,,,,,,\n",
+		},
+		{
+			name: "citations",
+			opts: models.ExportOptions{IncludeCitations: true},
+			want: "name,country,city,revenue,revenue_citation_url,revenue_citation_quote,city_citation_url,city_citation_quote,tags,tags_citation_url,tags_citation_quote\n" +
+				"Acme,DE,Berlin,1500000,https://acme.example,Revenue: $1.5M,,,\"[\"\"b2b\"\"]\",,\n" +
+				"Globex,FR,Paris,,,,,,,,\n" +
+				"Initech,US,,,,,,,,,\n",
+		},
+		{
+			name:    "source dialect is kept",
+			dialect: &models.CSVDialect{Delimiter: ";", Encoding: gcs.EncodingWindows1252, BOM: true},
+			want: "\ufeffname;country;city;revenue;tags\n" +
+				"Acme;DE;Berlin;1500000;\"[\"\"b2b\"\"]\"\n" +
+				"Globex;FR;Paris;;\n" +
+				"Initech;US;;;\n",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			it := source()
+			layout, err := newExportLayout(it.Headers(), job, tt.opts)
+			if err != nil {
+				t.Fatalf("newExportLayout() error: %v", err)
+			}
+			var buf bytes.Buffer
+			rows, err := writeExportRows(context.Background(), it, layout, lookup, newCSVExportWriter(&buf, layout, tt.dialect))
+			if err != nil {
+				t.Fatalf("writeExportRows() error: %v", err)
+			}
+			if rows != 3 {
+				t.Errorf("rows = %d, want 3", rows)
+			}
+			if buf.String() != tt.want {
+				t.Errorf("export =\n%s\nwant\n%s", buf.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestCitationCells(t *testing.T) {
+	tests := []struct {
+		name      string
+		info      *models.FieldConfidenceInfo
+		wantURL   string
+		wantQuote string
+	}{
+		{"no confidence", nil, "", ""},
+		{"no citation", &models.FieldConfidenceInfo{Score: 0.8}, "", ""},
+		{
+			name:      "verified",
+			info:      &models.FieldConfidenceInfo{Citation: &models.Citation{URL: "https://acme.example", Quote: "Founded in 1999", Verified: true}},
+			wantURL:   "https://acme.example",
+			wantQuote: "Founded in 1999",
+		},
+		{
+			name:    "unverified quote is left out",
+			info:    &models.FieldConfidenceInfo{Citation: &models.Citation{URL: "https://acme.example", Quote: "Founded in 1999"}},
+			wantURL: "https://acme.example",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			url, quote := citationCells(tt.info)
+			if url != tt.wantURL || quote != tt.wantQuote {
+				t.Errorf("citationCells() = %q, %q, want %q, %q", url, quote, tt.wantURL, tt.wantQuote)
+			}
+		})
+	}
+}
+
+func TestNewExportLayout_MissingKeyColumn(t *testing.T) {
+	job := &models.Job{KeyColumns: []string{"domain"}}
+	if _, err := newExportLayout([]string{"name"}, job, models.ExportOptions{}); err == nil {
+		t.Error("newExportLayout() accepted a source without the key column")
+	}
+}
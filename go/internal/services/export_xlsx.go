@@ -0,0 +1,502 @@
+package services
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/blagoySimandov/ampledata/go/internal/gcs"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+// Confidence bands of the cell colors. Scores at or above
+// highConfidenceScore are not retried by the enrichment workflow.
+const (
+	highConfidenceScore   = 0.75
+	mediumConfidenceScore = 0.5
+)
+
+const (
+	// xlsxMaxRows is the row limit of a worksheet.
+	xlsxMaxRows = 1 << 20
+	// xlsxMaxText is the longest text a cell or comment holds.
+	xlsxMaxText = 32767
+)
+
+const (
+	xlsxResultsSheet = "Results"
+	xlsxHistorySheet = "History"
+	xlsxAuthor       = "AmpleData"
+)
+
+// Cell styles, indices into the cellXfs of xlsxStyles.
+const (
+	xlsxStyleDefault = iota
+	xlsxStyleHeader
+	xlsxStyleHighConfidence
+	xlsxStyleMediumConfidence
+	xlsxStyleLowConfidence
+)
+
+// xlsxExportWriter writes a workbook with the merged rows on a results sheet
+// and the extraction history of every result on a history sheet. Enriched
+// cells are colored by confidence and carry a comment with the confidence
+// reason and the row's sources.
+//
+// The results sheet is streamed into the zip as rows arrive. The history
+// sheet and the comments belong to later zip entries, so they are spooled to
+// temporary files until Flush.
+type xlsxExportWriter struct {
+	zw     *zip.Writer
+	layout *exportLayout
+	sheet  *bufio.Writer
+	rows   int
+	buf    bytes.Buffer
+
+	comments     spool
+	drawing      spool
+	commentCount int
+
+	history     spool
+	historyRows int
+	historySeen map[string]bool
+}
+
+func newXLSXExportWriter(w io.Writer, layout *exportLayout, _ *models.CSVDialect) exportWriter {
+	return &xlsxExportWriter{zw: zip.NewWriter(w), layout: layout, historySeen: make(map[string]bool)}
+}
+
+type xlsxCell struct {
+	value  string
+	number bool
+	style  int
+}
+
+func (w *xlsxExportWriter) WriteHeader(headers []string) error {
+	sheet, err := w.zw.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	w.sheet = bufio.NewWriter(sheet)
+	if _, err := w.sheet.WriteString(xlsxSheetStart); err != nil {
+		return err
+	}
+	return w.writeResultsRow(headerCells(headers))
+}
+
+func (w *xlsxExportWriter) WriteRow(row *exportRow) error {
+	cells := make([]xlsxCell, len(row.cells))
+	for i, value := range row.cells {
+		cells[i] = xlsxCell{value: value}
+	}
+	if row.state != nil && w.rows < xlsxMaxRows {
+		if err := w.annotate(cells, row, w.rows+1); err != nil {
+			return err
+		}
+	}
+	if err := w.writeResultsRow(cells); err != nil {
+		return err
+	}
+	return w.writeHistory(row.state)
+}
+
+func (w *xlsxExportWriter) writeResultsRow(cells []xlsxCell) error {
+	if w.rows == xlsxMaxRows {
+		return fmt.Errorf("export exceeds the %d row limit of an XLSX sheet", xlsxMaxRows)
+	}
+	w.rows++
+	w.buf.Reset()
+	appendXLSXRow(&w.buf, w.rows, cells)
+	_, err := w.sheet.Write(w.buf.Bytes())
+	return err
+}
+
+// annotate colors the enriched cells of a row by confidence and adds their
+// comments. number is the row's one-based row number.
+func (w *xlsxExportWriter) annotate(cells []xlsxCell, row *exportRow, number int) error {
+	state := row.state
+	for _, e := range row.enriched {
+		confidence := state.Confidence[e.column]
+		cells[e.index].style = confidenceStyle(confidence)
+		if _, ok := state.ExtractedData[e.column].(float64); ok {
+			cells[e.index].number = true
+		}
+		text := xlsxComment(confidence, state.Sources)
+		if text == "" {
+			continue
+		}
+		ref := gcs.ColumnName(e.index) + strconv.Itoa(number)
+		w.buf.Reset()
+		fmt.Fprintf(&w.buf, `<comment ref="%s" authorId="0"><text><t xml:space="preserve">`, ref)
+		escapeXLSXText(&w.buf, text)
+		w.buf.WriteString(`</t></text></comment>`)
+		if _, err := w.comments.Write(w.buf.Bytes()); err != nil {
+			return err
+		}
+		w.buf.Reset()
+		fmt.Fprintf(&w.buf, xlsxCommentShape, 1025+w.commentCount, e.index+1, number-1, e.index+4, number+4, number-1, e.index)
+		if _, err := w.drawing.Write(w.buf.Bytes()); err != nil {
+			return err
+		}
+		w.commentCount++
+	}
+	return nil
+}
+
+// writeHistory adds a row per extraction attempt of a result. Results shared
+// by several source rows are written once.
+func (w *xlsxExportWriter) writeHistory(state *models.RowState) error {
+	if state == nil || len(state.ExtractionHistory) == 0 || w.historySeen[state.Key] {
+		return nil
+	}
+	w.historySeen[state.Key] = true
+	for _, entry := range state.ExtractionHistory {
+		if w.historyRows+1 == xlsxMaxRows {
+			return fmt.Errorf("extraction history exceeds the %d row limit of an XLSX sheet", xlsxMaxRows)
+		}
+		w.historyRows++
+		cells := []xlsxCell{
+			{value: state.Key},
+			{value: strconv.Itoa(entry.AttemptNumber), number: true},
+		}
+		for _, col := range w.layout.columns {
+			value := entry.ExtractedData[col.name]
+			_, isNumber := value.(float64)
+			confidence := entry.Confidence[col.name]
+			score := xlsxCell{number: true}
+			if confidence != nil {
+				score.value = strconv.FormatFloat(confidence.Score, 'f', -1, 64)
+			}
+			cells = append(cells,
+				xlsxCell{value: exportCell(value), number: isNumber, style: confidenceStyle(confidence)},
+				score,
+			)
+		}
+		cells = append(cells,
+			xlsxCell{value: strings.Join(entry.Sources, " | ")},
+			xlsxCell{value: entry.Reasoning},
+		)
+		w.buf.Reset()
+		// The header takes the first row of the sheet.
+		appendXLSXRow(&w.buf, w.historyRows+1, cells)
+		if _, err := w.history.Write(w.buf.Bytes()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *xlsxExportWriter) historyHeaders() []string {
+	headers := []string{"row_key", "attempt"}
+	for _, col := range w.layout.columns {
+		headers = append(headers, col.name, col.name+"_confidence")
+	}
+	return append(headers, "sources", "reasoning")
+}
+
+func (w *xlsxExportWriter) Flush() error {
+	end := `</sheetData>`
+	if w.commentCount > 0 {
+		end += `<legacyDrawing r:id="rId1"/>`
+	}
+	if _, err := w.sheet.WriteString(end + `</worksheet>`); err != nil {
+		return err
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+
+	history, err := w.zw.Create("xl/worksheets/sheet2.xml")
+	if err != nil {
+		return err
+	}
+	w.buf.Reset()
+	w.buf.WriteString(xlsxSheetStart)
+	appendXLSXRow(&w.buf, 1, headerCells(w.historyHeaders()))
+	if _, err := history.Write(w.buf.Bytes()); err != nil {
+		return err
+	}
+	if err := w.history.copyTo(history); err != nil {
+		return err
+	}
+	if _, err := io.WriteString(history, `</sheetData></worksheet>`); err != nil {
+		return err
+	}
+
+	contentTypes := xlsxContentTypes
+	if w.commentCount > 0 {
+		contentTypes = xlsxContentTypesWithComments
+		if err := w.writeComments(); err != nil {
+			return err
+		}
+	}
+	parts := []struct{ name, content string }{
+		{"[Content_Types].xml", contentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", xlsxWorkbook},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+		{"xl/styles.xml", xlsxStyles},
+	}
+	for _, part := range parts {
+		if err := w.writePart(part.name, part.content); err != nil {
+			return err
+		}
+	}
+	return w.zw.Close()
+}
+
+func (w *xlsxExportWriter) writeComments() error {
+	if err := w.writePart("xl/worksheets/_rels/sheet1.xml.rels", xlsxSheetRels); err != nil {
+		return err
+	}
+	parts := []struct {
+		name       string
+		start, end string
+		body       *spool
+	}{
+		{"xl/comments1.xml", xlsxCommentsStart, xlsxCommentsEnd, &w.comments},
+		{"xl/drawings/vmlDrawing1.vml", xlsxDrawingStart, xlsxDrawingEnd, &w.drawing},
+	}
+	for _, part := range parts {
+		pw, err := w.zw.Create(part.name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(pw, part.start); err != nil {
+			return err
+		}
+		if err := part.body.copyTo(pw); err != nil {
+			return err
+		}
+		if _, err := io.WriteString(pw, part.end); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w *xlsxExportWriter) writePart(name, content string) error {
+	pw, err := w.zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(pw, content)
+	return err
+}
+
+func (w *xlsxExportWriter) Close() error {
+	return errors.Join(w.comments.Close(), w.drawing.Close(), w.history.Close())
+}
+
+func headerCells(headers []string) []xlsxCell {
+	cells := make([]xlsxCell, len(headers))
+	for i, h := range headers {
+		cells[i] = xlsxCell{value: h, style: xlsxStyleHeader}
+	}
+	return cells
+}
+
+// confidenceStyle picks the fill of a value by its confidence band. Values
+// without a confidence are not colored.
+func confidenceStyle(c *models.FieldConfidenceInfo) int {
+	switch {
+	case c == nil:
+		return xlsxStyleDefault
+	case c.Score >= highConfidenceScore:
+		return xlsxStyleHighConfidence
+	case c.Score >= mediumConfidenceScore:
+		return xlsxStyleMediumConfidence
+	default:
+		return xlsxStyleLowConfidence
+	}
+}
+
+// xlsxComment is the note of an enriched cell: its confidence, reason and
+// verified citation, followed by the pages the row was extracted from.
+func xlsxComment(c *models.FieldConfidenceInfo, sources []string) string {
+	var b strings.Builder
+	if c != nil {
+		b.WriteString("Confidence: " + strconv.FormatFloat(c.Score, 'f', -1, 64))
+		if c.Reason != "" {
+			b.WriteString("\n" + c.Reason)
+		}
+		if c.Citation != nil && c.Citation.Verified {
+			b.WriteString("\n\nCited from " + c.Citation.URL + ":\n\"" + c.Citation.Quote + "\"")
+		}
+	}
+	if len(sources) > 0 {
+		if b.Len() > 0 {
+			b.WriteString("\n\n")
+		}
+		b.WriteString("Sources:\n" + strings.Join(sources, "\n"))
+	}
+	return b.String()
+}
+
+// appendXLSXRow encodes a sheet row. Empty cells are left out; numbers are
+// written as values and all other text inline.
+func appendXLSXRow(buf *bytes.Buffer, number int, cells []xlsxCell) {
+	fmt.Fprintf(buf, `<row r="%d">`, number)
+	for i, c := range cells {
+		if c.value == "" {
+			continue
+		}
+		ref := gcs.ColumnName(i) + strconv.Itoa(number)
+		style := ""
+		if c.style != xlsxStyleDefault {
+			style = fmt.Sprintf(` s="%d"`, c.style)
+		}
+		if c.number {
+			fmt.Fprintf(buf, `<c r="%s"%s><v>%s</v></c>`, ref, style, c.value)
+			continue
+		}
+		fmt.Fprintf(buf, `<c r="%s"%s t="inlineStr"><is><t xml:space="preserve">`, ref, style)
+		escapeXLSXText(buf, c.value)
+		buf.WriteString(`</t></is></c>`)
+	}
+	buf.WriteString(`</row>`)
+}
+
+// escapeXLSXText writes text as XML, cut to the length a cell holds.
+func escapeXLSXText(buf *bytes.Buffer, text string) {
+	if utf8.RuneCountInString(text) > xlsxMaxText {
+		text = string([]rune(text)[:xlsxMaxText])
+	}
+	// Writes to a bytes.Buffer do not fail.
+	_ = xml.EscapeText(buf, []byte(text))
+}
+
+// spool buffers a workbook part in a temporary file, created on the first
+// write.
+type spool struct {
+	file *os.File
+	w    *bufio.Writer
+}
+
+func (s *spool) Write(p []byte) (int, error) {
+	if s.file == nil {
+		f, err := os.CreateTemp("", "export-*.xml")
+		if err != nil {
+			return 0, err
+		}
+		s.file, s.w = f, bufio.NewWriter(f)
+	}
+	return s.w.Write(p)
+}
+
+// copyTo writes everything spooled so far to w.
+func (s *spool) copyTo(w io.Writer) error {
+	if s.file == nil {
+		return nil
+	}
+	if err := s.w.Flush(); err != nil {
+		return err
+	}
+	if _, err := s.file.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	_, err := io.Copy(w, s.file)
+	return err
+}
+
+func (s *spool) Close() error {
+	if s.file == nil {
+		return nil
+	}
+	name := s.file.Name()
+	err := s.file.Close()
+	s.file, s.w = nil, nil
+	return errors.Join(err, os.Remove(name))
+}
+
+const xlsxSheetStart = xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheetViews><sheetView workbookViewId="0"><pane ySplit="1" topLeftCell="A2" activePane="bottomLeft" state="frozen"/></sheetView></sheetViews>` +
+	`<sheetData>`
+
+const xlsxContentTypesStart = xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+	`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+	`<Default Extension="xml" ContentType="application/xml"/>` +
+	`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`<Override PartName="/xl/worksheets/sheet2.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+	`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>`
+
+const xlsxContentTypes = xlsxContentTypesStart + `</Types>`
+
+const xlsxContentTypesWithComments = xlsxContentTypesStart +
+	`<Default Extension="vml" ContentType="application/vnd.openxmlformats-officedocument.vmlDrawing"/>` +
+	`<Override PartName="/xl/comments1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.comments+xml"/>` +
+	`</Types>`
+
+const xlsxRootRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`</Relationships>`
+
+const xlsxWorkbook = xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+	`<sheets><sheet name="` + xlsxResultsSheet + `" sheetId="1" r:id="rId1"/><sheet name="` + xlsxHistorySheet + `" sheetId="2" r:id="rId2"/></sheets>` +
+	`</workbook>`
+
+const xlsxWorkbookRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet2.xml"/>` +
+	`<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>` +
+	`</Relationships>`
+
+const xlsxSheetRels = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/vmlDrawing" Target="../drawings/vmlDrawing1.vml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/comments" Target="../comments1.xml"/>` +
+	`</Relationships>`
+
+// xlsxStyles defines the cell styles in the order of the xlsxStyle
+// constants. The fills are Excel's good, neutral and bad colors.
+const xlsxStyles = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="5">` +
+	`<fill><patternFill patternType="none"/></fill>` +
+	`<fill><patternFill patternType="gray125"/></fill>` +
+	`<fill><patternFill patternType="solid"><fgColor rgb="FFC6EFCE"/><bgColor indexed="64"/></patternFill></fill>` +
+	`<fill><patternFill patternType="solid"><fgColor rgb="FFFFEB9C"/><bgColor indexed="64"/></patternFill></fill>` +
+	`<fill><patternFill patternType="solid"><fgColor rgb="FFFFC7CE"/><bgColor indexed="64"/></patternFill></fill>` +
+	`</fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="5">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`<xf numFmtId="0" fontId="0" fillId="2" borderId="0" xfId="0" applyFill="1"/>` +
+	`<xf numFmtId="0" fontId="0" fillId="3" borderId="0" xfId="0" applyFill="1"/>` +
+	`<xf numFmtId="0" fontId="0" fillId="4" borderId="0" xfId="0" applyFill="1"/>` +
+	`</cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
+
+const xlsxCommentsStart = xml.Header + `<comments xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<authors><author>` + xlsxAuthor + `</author></authors><commentList>`
+
+const xlsxCommentsEnd = `</commentList></comments>`
+
+// Excel only shows comments that also have a legacy VML note shape.
+const xlsxDrawingStart = `<xml xmlns:v="urn:schemas-microsoft-com:vml" xmlns:o="urn:schemas-microsoft-com:office:office" xmlns:x="urn:schemas-microsoft-com:office:excel">` +
+	`<o:shapelayout v:ext="edit"><o:idmap v:ext="edit" data="1"/></o:shapelayout>` +
+	`<v:shapetype id="_x0000_t202" coordsize="21600,21600" o:spt="202" path="m,l,21600r21600,l21600,xe">` +
+	`<v:stroke joinstyle="miter"/><v:path gradientshapeok="t" o:connecttype="rect"/></v:shapetype>`
+
+const xlsxDrawingEnd = `</xml>`
+
+// xlsxCommentShape is a hidden note next to its cell. The verbs are the
+// shape id, the anchor's left column, top row, right column and bottom row,
+// and the zero-based row and column of the cell.
+const xlsxCommentShape = `<v:shape id="_x0000_s%d" type="#_x0000_t202" style="position:absolute;width:240pt;height:120pt;z-index:1;visibility:hidden" fillcolor="#ffffe1" o:insetmode="auto">` +
+	`<v:fill color2="#ffffe1"/><v:shadow on="t" color="black" obscured="t"/><v:path o:connecttype="none"/>` +
+	`<v:textbox style="mso-direction-alt:auto"><div style="text-align:left"></div></v:textbox>` +
+	`<x:ClientData ObjectType="Note"><x:MoveWithCells/><x:SizeWithCells/>` +
+	`<x:Anchor>%d, 15, %d, 10, %d, 15, %d, 4</x:Anchor><x:AutoFill>False</x:AutoFill>` +
+	`<x:Row>%d</x:Row><x:Column>%d</x:Column></x:ClientData></v:shape>`
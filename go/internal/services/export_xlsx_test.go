@@ -0,0 +1,169 @@
+package services
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/blagoySimandov/ampledata/go/internal/blob"
+	"github.com/blagoySimandov/ampledata/go/internal/gcs"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+func TestXLSXExportWriter(t *testing.T) {
+	ctx := context.Background()
+	state := &models.RowState{
+		Key:           "Acme",
+		ExtractedData: map[string]interface{}{"revenue": 1.5e6, "ceo": "Jane Doe"},
+		Confidence: map[string]*models.FieldConfidenceInfo{
+			"revenue": {Score: 0.9, Reason: "Annual report"},
+			"ceo":     {Score: 0.3, Reason: "Old press release"},
+		},
+		Sources: []string{"https://acme.example/report"},
+		ExtractionHistory: []*models.ExtractionHistoryEntry{
+			{AttemptNumber: 1, ExtractedData: map[string]interface{}{"ceo": "John Doe"}, Confidence: map[string]*models.FieldConfidenceInfo{"ceo": {Score: 0.2}}},
+			{AttemptNumber: 2, ExtractedData: map[string]interface{}{"revenue": 1.5e6, "ceo": "Jane Doe"}, Reasoning: "Found the report"},
+		},
+	}
+	job := &models.Job{
+		KeyColumns:      []string{"name"},
+		ColumnsMetadata: []*models.ColumnMetadata{{Name: "revenue"}, {Name: "ceo"}},
+		KeyMapping:      map[string]string{"ACME": "Acme"},
+	}
+	it := &sliceIterator{headers: []string{"name"}, rows: [][]string{{"Acme"}, {"ACME"}, {"Initech"}}}
+	layout, err := newExportLayout(it.Headers(), job, models.ExportOptions{})
+	if err != nil {
+		t.Fatalf("newExportLayout() error: %v", err)
+	}
+	lookup := func(_ context.Context, keys []string) (map[string]*models.RowState, error) {
+		return map[string]*models.RowState{"Acme": state, "ACME": state}, nil
+	}
+
+	var buf bytes.Buffer
+	w := newXLSXExportWriter(&buf, layout, nil)
+	defer w.Close()
+	if _, err := writeExportRows(ctx, it, layout, lookup, w); err != nil {
+		t.Fatalf("writeExportRows() error: %v", err)
+	}
+
+	store, err := blob.NewLocalStore(t.TempDir(), "http://localhost", []byte("secret"), 1<<20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Write(ctx, "export.xlsx", gcs.ContentTypeXLSX, bytes.NewReader(buf.Bytes())); err != nil {
+		t.Fatal(err)
+	}
+	reader := gcs.NewCSVReader(store)
+	file := gcs.SourceFile{ObjectName: "export.xlsx", ContentType: gcs.ContentTypeXLSX}
+
+	sheets, err := reader.ListSheets(ctx, file)
+	if err != nil {
+		t.Fatalf("ListSheets() error: %v", err)
+	}
+	if strings.Join(sheets, ",") != "Results,History" {
+		t.Errorf("sheets = %v", sheets)
+	}
+
+	tests := []struct {
+		sheet string
+		want  [][]string
+	}{
+		{
+			sheet: "Results",
+			want: [][]string{
+				{"name", "revenue", "ceo"},
+				{"Acme", "1500000", "Jane Doe"},
+				{"ACME", "1500000", "Jane Doe"},
+				{"Initech"},
+			},
+		},
+		{
+			// The result shared by both spellings of Acme is listed once.
+			sheet: "History",
+			want: [][]string{
+				{"row_key", "attempt", "revenue", "revenue_confidence", "ceo", "ceo_confidence", "sources", "reasoning"},
+				{"Acme", "1", "", "", "John Doe", "0.2"},
+				{"Acme", "2", "1500000", "", "Jane Doe", "", "", "Found the report"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.sheet, func(t *testing.T) {
+			file.Sheet = tt.sheet
+			rows, err := reader.OpenSource(ctx, file)
+			if err != nil {
+				t.Fatalf("OpenSource() error: %v", err)
+			}
+			defer rows.Close()
+			got := [][]string{rows.Headers()}
+			for {
+				row, err := rows.Next()
+				if err == io.EOF {
+					break
+				}
+				if err != nil {
+					t.Fatalf("Next() error: %v", err)
+				}
+				got = append(got, row.Cells)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("got %d rows, want %d: %q", len(got), len(tt.want), got)
+			}
+			for i := range tt.want {
+				if strings.Join(got[i], ",") != strings.Join(tt.want[i], ",") {
+					t.Errorf("row %d = %q, want %q", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+
+	zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	parts := map[string]string{}
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, _ := io.ReadAll(rc)
+		rc.Close()
+		parts[f.Name] = string(data)
+	}
+	for part, want := range map[string][]string{
+		"xl/worksheets/sheet1.xml": {`<c r="B2" s="2"><v>1500000</v></c>`, `<c r="C2" s="4" t="inlineStr">`, `<legacyDrawing r:id="rId1"/>`},
+		"xl/comments1.xml": {
+			`<comment ref="B2" authorId="0"><text><t xml:space="preserve">Confidence: 0.9&#xA;Annual report&#xA;&#xA;Sources:&#xA;https://acme.example/report</t></text></comment>`,
+			`<comment ref="C3" authorId="0">`,
+		},
+		"xl/drawings/vmlDrawing1.vml": {`<x:Row>1</x:Row><x:Column>1</x:Column>`, `<x:Row>2</x:Row><x:Column>2</x:Column>`},
+	} {
+		for _, w := range want {
+			if !strings.Contains(parts[part], w) {
+				t.Errorf("%s does not contain %s", part, w)
+			}
+		}
+	}
+}
+
+func TestConfidenceStyle(t *testing.T) {
+	tests := []struct {
+		confidence *models.FieldConfidenceInfo
+		want       int
+	}{
+		{nil, xlsxStyleDefault},
+		{&models.FieldConfidenceInfo{Score: 1}, xlsxStyleHighConfidence},
+		{&models.FieldConfidenceInfo{Score: 0.75}, xlsxStyleHighConfidence},
+		{&models.FieldConfidenceInfo{Score: 0.6}, xlsxStyleMediumConfidence},
+		{&models.FieldConfidenceInfo{Score: 0.49}, xlsxStyleLowConfidence},
+	}
+	for _, tt := range tests {
+		if got := confidenceStyle(tt.confidence); got != tt.want {
+			t.Errorf("confidenceStyle(%v) = %d, want %d", tt.confidence, got, tt.want)
+		}
+	}
+}
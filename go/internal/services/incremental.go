@@ -0,0 +1,191 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/blagoySimandov/ampledata/go/internal/gcs"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+// incrementalPlan splits the rows of an incremental run into rows that need
+// enriching and rows whose results are carried forward from the base job.
+type incrementalPlan struct {
+	baseJob *models.Job
+	rowKeys []string
+	// carried maps row keys of the new job to the base job's row keys.
+	carried map[string]string
+}
+
+// planIncremental compares the current revision of a source with the
+// revision its latest completed job ran on. Rows that are new, whose input
+// columns changed, or that the base job did not complete are enriched again;
+// the rest keep the base job's results. When a requested column is defined
+// differently than in the base job, no result is carried and every row is
+// enriched again.
+func (s *sourcesService) planIncremental(ctx context.Context, source *models.Source, keyColumns, rowKeys []string, cols []*models.ColumnMetadata, contextColumns []string) (*incrementalPlan, error) {
+	meta, ok := source.Metadata.(models.RevisionedMetadata)
+	if !ok {
+		return nil, newValidationError("incremental enrichment is only supported for uploaded files")
+	}
+	jobs, err := s.store.GetJobsBySource(ctx, source.ID)
+	if err != nil {
+		return nil, err
+	}
+	baseJob := latestCompletedJob(jobs)
+	if baseJob == nil {
+		return nil, newValidationError("incremental enrichment needs a completed job on this source")
+	}
+	if !slices.Equal(baseJob.KeyColumns, keyColumns) {
+		return nil, newValidationError(fmt.Sprintf("incremental enrichment must use the key columns of job %s: %v", baseJob.JobID, baseJob.KeyColumns))
+	}
+	if missing := missingColumns(baseJob.ColumnsMetadata, cols); len(missing) > 0 {
+		return nil, newValidationError(fmt.Sprintf("job %s did not enrich %v; run a full enrichment instead", baseJob.JobID, missing))
+	}
+	redefined := len(changedColumns(baseJob.ColumnsMetadata, cols)) > 0
+
+	inputColumns := incrementalInputColumns(cols, contextColumns)
+	current, err := s.readRevisionValues(ctx, meta, meta.CurrentRevision(), keyColumns, inputColumns)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := s.readRevisionValues(ctx, meta, max(baseJob.SourceRevision, 1), keyColumns, inputColumns)
+	if err != nil {
+		return nil, err
+	}
+	completedKeys, err := s.store.GetRowKeysAtStage(ctx, baseJob.JobID, models.StageCompleted)
+	if err != nil {
+		return nil, err
+	}
+	completed := make(map[string]bool, len(completedKeys))
+	for _, k := range completedKeys {
+		completed[k] = true
+	}
+
+	plan := &incrementalPlan{baseJob: baseJob, carried: make(map[string]string)}
+	for _, key := range rowKeys {
+		baseKey := key
+		if canonical, ok := baseJob.KeyMapping[key]; ok {
+			baseKey = canonical
+		}
+		prev, existed := previous[key]
+		if !redefined && existed && completed[baseKey] && maps.Equal(prev, current[key]) {
+			plan.carried[key] = baseKey
+			continue
+		}
+		plan.rowKeys = append(plan.rowKeys, key)
+	}
+	return plan, nil
+}
+
+// readRevisionValues reads the input columns of every row of one revision.
+// A revision lacking one of the columns yields no rows, so that every row is
+// treated as changed.
+func (s *sourcesService) readRevisionValues(ctx context.Context, meta models.RevisionedMetadata, revision int, keyColumns, columns []string) (map[string]map[string]string, error) {
+	file, err := sourceFileForRevision(meta, revision)
+	if err != nil {
+		return nil, err
+	}
+	it, err := s.reader.OpenSource(ctx, file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read revision %d: %w", revision, err)
+	}
+	defer it.Close()
+	for _, col := range append(slices.Clone(keyColumns), columns...) {
+		if !slices.Contains(it.Headers(), col) {
+			return nil, nil
+		}
+	}
+	return gcs.ScanRowValues(it, keyColumns, columns)
+}
+
+// sourceFileForRevision describes how to read a revision of a file source.
+// Earlier revisions keep the current sheet selection and their own CSV
+// dialect.
+func sourceFileForRevision(meta models.RevisionedMetadata, revision int) (gcs.SourceFile, error) {
+	file, err := SourceFileFor(meta)
+	if err != nil || revision == meta.CurrentRevision() {
+		return file, err
+	}
+	previous, ok := meta.PreviousRevision(revision)
+	if !ok {
+		return gcs.SourceFile{}, fmt.Errorf("revision %d not found", revision)
+	}
+	file.ObjectName = previous.FileURI
+	file.ContentType = previous.ContentType
+	if csvMeta, ok := meta.(*models.CSVSourceMetadata); ok {
+		applyDialect(&file, csvMeta.RevisionDialect(revision))
+	}
+	return file, nil
+}
+
+// incrementalInputColumns lists the columns whose changes invalidate a row's
+// previous result: everything carried into prompts and the columns being
+// imputed.
+func incrementalInputColumns(cols []*models.ColumnMetadata, contextColumns []string) []string {
+	names := rowValueColumns(cols, contextColumns)
+	for _, name := range imputationColumnNames(cols) {
+		if !slices.Contains(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func latestCompletedJob(jobs []*models.Job) *models.Job {
+	var latest *models.Job
+	for _, j := range jobs {
+		if j.Status == models.JobStatusCompleted && (latest == nil || j.CreatedAt.After(latest.CreatedAt)) {
+			latest = j
+		}
+	}
+	return latest
+}
+
+// missingColumns lists the requested columns a job did not enrich.
+func missingColumns(enriched, requested []*models.ColumnMetadata) []string {
+	names := make(map[string]bool, len(enriched))
+	for _, col := range enriched {
+		names[col.Name] = true
+	}
+	var missing []string
+	for _, col := range requested {
+		if !names[col.Name] {
+			missing = append(missing, col.Name)
+		}
+	}
+	return missing
+}
+
+// changedColumns lists the requested columns a job enriched under a different
+// definition, whose carried results would no longer match the request.
+func changedColumns(enriched, requested []*models.ColumnMetadata) []string {
+	byName := make(map[string]*models.ColumnMetadata, len(enriched))
+	for _, col := range enriched {
+		byName[col.Name] = col
+	}
+	var changed []string
+	for _, col := range requested {
+		if prev, ok := byName[col.Name]; ok && !sameColumnDefinition(prev, col) {
+			changed = append(changed, col.Name)
+		}
+	}
+	return changed
+}
+
+func sameColumnDefinition(a, b *models.ColumnMetadata) bool {
+	return a.Type == b.Type &&
+		a.JobType == b.JobType &&
+		equalStringPtr(a.Description, b.Description) &&
+		equalStringPtr(a.Expression, b.Expression) &&
+		slices.Equal(a.InputColumns, b.InputColumns)
+}
+
+func equalStringPtr(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
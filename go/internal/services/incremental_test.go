@@ -0,0 +1,152 @@
+package services
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/blagoySimandov/ampledata/go/internal/gcs"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+func addRevision(meta models.RevisionedMetadata, fileURI, contentType string) int {
+	revision := meta.StageRevision(fileURI, contentType, time.Now())
+	meta.PromoteRevision(revision, time.Now())
+	return revision
+}
+
+func TestStageRevision(t *testing.T) {
+	meta := &models.CSVSourceMetadata{FileURI: "v1.csv", ContentType: gcs.ContentTypeCSV}
+	first := meta.StageRevision("v2.csv", gcs.ContentTypeCSV, time.Now())
+	if meta.CurrentRevision() != 1 || meta.FileURI != "v1.csv" {
+		t.Fatalf("staging changed the current file to %s (revision %d)", meta.FileURI, meta.CurrentRevision())
+	}
+	second := meta.StageRevision("v3.csv", gcs.ContentTypeCSV, time.Now())
+	if second == first {
+		t.Fatalf("restaging reused revision %d", first)
+	}
+	if meta.PromoteRevision(first, time.Now()) {
+		t.Fatal("superseded revision was promoted")
+	}
+	if !meta.PromoteRevision(second, time.Now()) {
+		t.Fatal("pending revision was not promoted")
+	}
+	if meta.FileURI != "v3.csv" || meta.CurrentRevision() != second || meta.Pending != nil {
+		t.Errorf("after promote: file %s, revision %d, pending %+v", meta.FileURI, meta.CurrentRevision(), meta.Pending)
+	}
+	if meta.PromoteRevision(second, time.Now()) {
+		t.Error("revision promoted twice")
+	}
+}
+
+func TestSourceFileForRevision(t *testing.T) {
+	meta := &models.XLSXSourceMetadata{FileURI: "v1.xlsx", ContentType: gcs.ContentTypeXLSX, SheetName: "Leads", HeaderRow: 2}
+	if got := meta.CurrentRevision(); got != 1 {
+		t.Fatalf("CurrentRevision() = %d, want 1", got)
+	}
+	if got := addRevision(meta, "v2.xlsx", gcs.ContentTypeXLSX); got != 2 {
+		t.Fatalf("addRevision() = %d, want 2", got)
+	}
+	addRevision(meta, "v3.xlsx", gcs.ContentTypeXLSX)
+
+	tests := []struct {
+		revision int
+		want     gcs.SourceFile
+		wantErr  bool
+	}{
+		{1, gcs.SourceFile{ObjectName: "v1.xlsx", ContentType: gcs.ContentTypeXLSX, Sheet: "Leads", HeaderRow: 2}, false},
+		{2, gcs.SourceFile{ObjectName: "v2.xlsx", ContentType: gcs.ContentTypeXLSX, Sheet: "Leads", HeaderRow: 2}, false},
+		{3, gcs.SourceFile{ObjectName: "v3.xlsx", ContentType: gcs.ContentTypeXLSX, Sheet: "Leads", HeaderRow: 2}, false},
+		{4, gcs.SourceFile{}, true},
+	}
+	for _, tt := range tests {
+		got, err := sourceFileForRevision(meta, tt.revision)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("revision %d: error = %v, wantErr %v", tt.revision, err, tt.wantErr)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("revision %d: got %+v, want %+v", tt.revision, got, tt.want)
+		}
+	}
+}
+
+func TestSourceFileForRevision_CSVDialect(t *testing.T) {
+	meta := &models.CSVSourceMetadata{FileURI: "v1.csv", ContentType: gcs.ContentTypeCSV}
+	meta.Dialect = &models.CSVDialect{Delimiter: ";", Encoding: gcs.EncodingWindows1252, HeaderRow: 2}
+	addRevision(meta, "v2.csv", gcs.ContentTypeCSV)
+	if meta.Dialect != nil {
+		t.Fatalf("detected dialect kept for new revision: %+v", meta.Dialect)
+	}
+	meta.Dialect = &models.CSVDialect{Delimiter: "|", Encoding: gcs.EncodingUTF8, HeaderRow: 1, Manual: true}
+	addRevision(meta, "v3.csv", gcs.ContentTypeCSV)
+	if meta.Dialect == nil {
+		t.Fatal("manual dialect dropped for new revision")
+	}
+
+	tests := []struct {
+		revision int
+		want     gcs.SourceFile
+	}{
+		{1, gcs.SourceFile{ObjectName: "v1.csv", ContentType: gcs.ContentTypeCSV, Delimiter: ';', Encoding: gcs.EncodingWindows1252, HeaderRow: 2}},
+		{2, gcs.SourceFile{ObjectName: "v2.csv", ContentType: gcs.ContentTypeCSV, Delimiter: '|', Encoding: gcs.EncodingUTF8, HeaderRow: 1}},
+		{3, gcs.SourceFile{ObjectName: "v3.csv", ContentType: gcs.ContentTypeCSV, Delimiter: '|', Encoding: gcs.EncodingUTF8, HeaderRow: 1}},
+	}
+	for _, tt := range tests {
+		got, err := sourceFileForRevision(meta, tt.revision)
+		if err != nil {
+			t.Fatalf("revision %d: %v", tt.revision, err)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("revision %d: got %+v, want %+v", tt.revision, got, tt.want)
+		}
+	}
+}
+
+func TestChangedColumns(t *testing.T) {
+	desc, other := "Annual revenue in USD", "Annual revenue in EUR"
+	expr := "revenue / employees"
+	enriched := []*models.ColumnMetadata{
+		{Name: "revenue", Type: models.ColumnTypeNumber, JobType: models.JobTypeEnrichment, Description: &desc},
+		{Name: "per_head", Type: models.ColumnTypeNumber, JobType: models.JobTypeDerived, Expression: &expr},
+		{Name: "segment", Type: models.ColumnTypeString, JobType: models.JobTypeClassify, InputColumns: []string{"industry"}},
+	}
+	tests := []struct {
+		name      string
+		requested []*models.ColumnMetadata
+		want      []string
+	}{
+		{"unchanged", enriched, nil},
+		{"new column", []*models.ColumnMetadata{{Name: "ceo", Type: models.ColumnTypeString}}, nil},
+		{"description", []*models.ColumnMetadata{{Name: "revenue", Type: models.ColumnTypeNumber, JobType: models.JobTypeEnrichment, Description: &other}}, []string{"revenue"}},
+		{"type", []*models.ColumnMetadata{{Name: "revenue", Type: models.ColumnTypeString, JobType: models.JobTypeEnrichment, Description: &desc}}, []string{"revenue"}},
+		{"expression dropped", []*models.ColumnMetadata{{Name: "per_head", Type: models.ColumnTypeNumber, JobType: models.JobTypeDerived}}, []string{"per_head"}},
+		{"input columns", []*models.ColumnMetadata{{Name: "segment", Type: models.ColumnTypeString, JobType: models.JobTypeClassify, InputColumns: []string{"industry", "country"}}}, []string{"segment"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := changedColumns(enriched, tt.requested); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("changedColumns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMissingColumns(t *testing.T) {
+	enriched := []*models.ColumnMetadata{{Name: "revenue"}, {Name: "ceo"}}
+	tests := []struct {
+		name      string
+		requested []*models.ColumnMetadata
+		want      []string
+	}{
+		{"subset", []*models.ColumnMetadata{{Name: "ceo"}}, nil},
+		{"new column", []*models.ColumnMetadata{{Name: "ceo"}, {Name: "employees"}}, []string{"employees"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := missingColumns(enriched, tt.requested); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("missingColumns() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,299 @@
+package services
+
+import (
+	"net/url"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/blagoySimandov/ampledata/go/internal/gcs"
+)
+
+// legalSuffixes are company-form words dropped from the end of a key, so that
+// "Stripe, Inc." and "Stripe" land on the same row.
+var legalSuffixes = map[string]bool{
+	"inc": true, "incorporated": true, "llc": true, "llp": true, "lp": true,
+	"ltd": true, "limited": true, "corp": true, "corporation": true,
+	"company": true, "plc": true, "gmbh": true,
+	"sas": true, "sarl": true, "srl": true, "spa": true, "bv": true, "nv": true,
+	"oy": true, "ab": true, "aps": true, "pty": true, "pte": true,
+	"kk": true, "kg": true, "ug": true, "&": true,
+}
+
+// shortLegalSuffixes double as ordinary name words ("Sa Sa", "Je Se"), so
+// they are only dropped after a comma or a name of at least
+// minNameBeforeShortSuffix characters.
+var shortLegalSuffixes = map[string]bool{
+	"as": true, "se": true, "sa": true, "co": true, "ag": true,
+}
+
+const minNameBeforeShortSuffix = 4
+
+// fuzzyPrefixLen is the number of leading characters fuzzy candidates must
+// share, and fuzzyBucketLimit caps the candidates kept per prefix. Together
+// they bound fuzzy matching to a constant number of comparisons per key, so
+// normalizing stays linear in the number of rows. Keys beyond the cap are
+// counted and reported by NormalizeKeys.
+const (
+	fuzzyPrefixLen   = 2
+	fuzzyBucketLimit = 200
+)
+
+// KeyNormalization configures how row keys are merged before a job is
+// created. FuzzyThreshold is a Jaro-Winkler similarity in (0, 1]; zero only
+// merges keys that normalize to the same text.
+type KeyNormalization struct {
+	Enabled        bool
+	FuzzyThreshold float64
+}
+
+// NormalizeKeys merges keys that refer to the same entity. It returns the
+// canonical keys in first-seen order, each being the first original key of
+// its group, and maps every other original key to its canonical key. Fuzzy
+// matches are only looked for among keys sharing the first fuzzyPrefixLen
+// characters, which Jaro-Winkler weighs most, and once a prefix holds
+// fuzzyBucketLimit keys later keys with it only merge on exact matches.
+// unbucketed counts those later keys so callers can report them.
+func NormalizeKeys(keys []string, fuzzyThreshold float64) (canonical []string, mapping map[string]string, unbucketed int) {
+	canonical = make([]string, 0, len(keys))
+	mapping = make(map[string]string)
+	byNormalized := make(map[string]string, len(keys))
+	buckets := make(map[string][]string)
+
+	for _, key := range keys {
+		normalized := NormalizeKey(key)
+		if target, ok := byNormalized[normalized]; ok {
+			if target != key {
+				mapping[key] = target
+			}
+			continue
+		}
+		if fuzzyThreshold > 0 {
+			if match := closestKey(normalized, buckets[fuzzyPrefix(normalized)], fuzzyThreshold); match != "" {
+				byNormalized[normalized] = byNormalized[match]
+				mapping[key] = byNormalized[match]
+				continue
+			}
+		}
+		byNormalized[normalized] = key
+		if prefix := fuzzyPrefix(normalized); len(buckets[prefix]) < fuzzyBucketLimit {
+			buckets[prefix] = append(buckets[prefix], normalized)
+		} else if fuzzyThreshold > 0 {
+			unbucketed++
+		}
+		canonical = append(canonical, key)
+	}
+	return canonical, mapping, unbucketed
+}
+
+func closestKey(normalized string, candidates []string, threshold float64) string {
+	best, bestScore := "", threshold
+	n := utf8.RuneCountInString(normalized)
+	for _, c := range candidates {
+		if jaroWinklerBound(n, utf8.RuneCountInString(c)) < bestScore {
+			continue
+		}
+		if score := jaroWinkler(normalized, c); score >= bestScore {
+			best, bestScore = c, score
+		}
+	}
+	return best
+}
+
+func fuzzyPrefix(s string) string {
+	i := 0
+	for n := range s {
+		if i == fuzzyPrefixLen {
+			return s[:n]
+		}
+		i++
+	}
+	return s
+}
+
+// jaroWinklerBound is the highest Jaro-Winkler similarity two strings of the
+// given lengths can reach, used to skip comparisons that cannot match.
+func jaroWinklerBound(a, b int) float64 {
+	if a == 0 || b == 0 {
+		return 1
+	}
+	jaro := (2 + float64(min(a, b))/float64(max(a, b))) / 3
+	return jaro + 0.4*(1-jaro)
+}
+
+// NormalizeKey reduces every part of a composite key to a comparable form:
+// trimmed and case-folded, URLs reduced to their domain and company names
+// stripped of legal suffixes.
+func NormalizeKey(key string) string {
+	parts := strings.Split(key, gcs.CompositeKeyDelimiter)
+	for i, part := range parts {
+		parts[i] = normalizeKeyPart(part)
+	}
+	return strings.Join(parts, gcs.CompositeKeyDelimiter)
+}
+
+func normalizeKeyPart(part string) string {
+	part = strings.ToLower(strings.TrimSpace(part))
+	if domain, ok := urlDomain(part); ok {
+		return domain
+	}
+	words := splitKeyWords(part)
+	end := len(words)
+	for end > 1 && isLegalSuffix(words[:end]) {
+		end--
+	}
+	texts := make([]string, end)
+	for i, w := range words[:end] {
+		texts[i] = w.text
+	}
+	return strings.Join(texts, " ")
+}
+
+type keyWord struct {
+	text       string
+	afterComma bool
+}
+
+// splitKeyWords splits on spaces, commas and dots, noting which words follow
+// a comma.
+func splitKeyWords(part string) []keyWord {
+	var words []keyWord
+	start, comma := -1, false
+	for i, r := range part {
+		if !unicode.IsSpace(r) && r != ',' && r != '.' {
+			if start < 0 {
+				start = i
+			}
+			continue
+		}
+		if start >= 0 {
+			words = append(words, keyWord{text: part[start:i], afterComma: comma})
+			start, comma = -1, false
+		}
+		if r == ',' {
+			comma = true
+		}
+	}
+	if start >= 0 {
+		words = append(words, keyWord{text: part[start:], afterComma: comma})
+	}
+	return words
+}
+
+// isLegalSuffix reports whether the last word is a company form that can be
+// dropped from the words before it.
+func isLegalSuffix(words []keyWord) bool {
+	last := words[len(words)-1]
+	if legalSuffixes[last.text] {
+		return true
+	}
+	if !shortLegalSuffixes[last.text] {
+		return false
+	}
+	if last.afterComma {
+		return true
+	}
+	name := 0
+	for _, w := range words[:len(words)-1] {
+		name += utf8.RuneCountInString(w.text)
+	}
+	return name >= minNameBeforeShortSuffix
+}
+
+// urlDomain recognises URLs and bare domains such as "www.stripe.com/about"
+// and returns the host without its "www." prefix.
+func urlDomain(s string) (string, bool) {
+	if strings.ContainsAny(s, " \t") {
+		return "", false
+	}
+	raw := s
+	if !strings.Contains(raw, "://") {
+		host, _, _ := strings.Cut(raw, "/")
+		if !isDomain(host) {
+			return "", false
+		}
+		raw = "http://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil || u.Hostname() == "" {
+		return "", false
+	}
+	return strings.TrimPrefix(u.Hostname(), "www."), true
+}
+
+func isDomain(host string) bool {
+	labels := strings.Split(host, ".")
+	if len(labels) < 2 {
+		return false
+	}
+	for _, label := range labels {
+		if label == "" {
+			return false
+		}
+		for _, r := range label {
+			if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '-' {
+				return false
+			}
+		}
+	}
+	tld := labels[len(labels)-1]
+	for _, r := range tld {
+		if !unicode.IsLetter(r) {
+			return false
+		}
+	}
+	return len(tld) >= 2
+}
+
+// jaroWinkler returns the Jaro-Winkler similarity of two strings, from 0 for
+// nothing in common to 1 for identical strings.
+func jaroWinkler(a, b string) float64 {
+	s1, s2 := []rune(a), []rune(b)
+	if len(s1) == 0 && len(s2) == 0 {
+		return 1
+	}
+	if len(s1) == 0 || len(s2) == 0 {
+		return 0
+	}
+	window := max(len(s1), len(s2))/2 - 1
+	if window < 0 {
+		window = 0
+	}
+	matched1 := make([]bool, len(s1))
+	matched2 := make([]bool, len(s2))
+	matches := 0
+	for i := range s1 {
+		lo, hi := max(0, i-window), min(len(s2), i+window+1)
+		for j := lo; j < hi; j++ {
+			if !matched2[j] && s1[i] == s2[j] {
+				matched1[i], matched2[j] = true, true
+				matches++
+				break
+			}
+		}
+	}
+	if matches == 0 {
+		return 0
+	}
+	transpositions, j := 0, 0
+	for i := range s1 {
+		if !matched1[i] {
+			continue
+		}
+		for !matched2[j] {
+			j++
+		}
+		if s1[i] != s2[j] {
+			transpositions++
+		}
+		j++
+	}
+	m := float64(matches)
+	jaro := (m/float64(len(s1)) + m/float64(len(s2)) + (m-float64(transpositions)/2)/m) / 3
+
+	prefix := 0
+	for prefix < min(4, len(s1), len(s2)) && s1[prefix] == s2[prefix] {
+		prefix++
+	}
+	return jaro + float64(prefix)*0.1*(1-jaro)
+}
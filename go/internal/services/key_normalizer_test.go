@@ -0,0 +1,152 @@
+package services
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{" Stripe ", "stripe"},
+		{"Stripe, Inc.", "stripe"},
+		{"Acme Holdings Co. Ltd", "acme holdings"},
+		{"Johnson & Johnson", "johnson & johnson"},
+		{"https://www.stripe.com/about", "stripe.com"},
+		{"WWW.Stripe.com", "stripe.com"},
+		{"stripe.com/pricing", "stripe.com"},
+		{"Inc", "inc"},
+		{"Stripe Inc||US", "stripe||us"},
+		{"Volkswagen AG", "volkswagen"},
+		{"Acme Co.", "acme"},
+		{"Ace, Co.", "ace"},
+		{"Sa Sa", "sa sa"},
+		{"Je Se", "je se"},
+		{"Art Co", "art co"},
+		{"Equinor ASA", "equinor asa"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.key, func(t *testing.T) {
+			if got := NormalizeKey(tt.key); got != tt.want {
+				t.Errorf("NormalizeKey(%q) = %q, want %q", tt.key, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeKeys(t *testing.T) {
+	tests := []struct {
+		name          string
+		keys          []string
+		threshold     float64
+		wantCanonical []string
+		wantMapping   map[string]string
+	}{
+		{
+			name:          "exact normalization",
+			keys:          []string{"Stripe", "Stripe, Inc.", " stripe ", "Plaid"},
+			wantCanonical: []string{"Stripe", "Plaid"},
+			wantMapping:   map[string]string{"Stripe, Inc.": "Stripe", " stripe ": "Stripe"},
+		},
+		{
+			name:          "urls collapse to domain",
+			keys:          []string{"stripe.com", "https://www.stripe.com/", "http://stripe.com/docs"},
+			wantCanonical: []string{"stripe.com"},
+			wantMapping:   map[string]string{"https://www.stripe.com/": "stripe.com", "http://stripe.com/docs": "stripe.com"},
+		},
+		{
+			name:          "typos kept apart without threshold",
+			keys:          []string{"Microsoft", "Microsfot"},
+			wantCanonical: []string{"Microsoft", "Microsfot"},
+			wantMapping:   map[string]string{},
+		},
+		{
+			name:          "fuzzy threshold merges typos",
+			keys:          []string{"Microsoft", "Microsfot", "Stripe"},
+			threshold:     0.9,
+			wantCanonical: []string{"Microsoft", "Stripe"},
+			wantMapping:   map[string]string{"Microsfot": "Microsoft"},
+		},
+		{
+			name:          "fuzzy threshold keeps distinct names",
+			keys:          []string{"Stripe", "Shopify"},
+			threshold:     0.9,
+			wantCanonical: []string{"Stripe", "Shopify"},
+			wantMapping:   map[string]string{},
+		},
+		{
+			name:          "fuzzy candidates share a prefix",
+			keys:          []string{"Microsoft", "Mciroosft"},
+			threshold:     0.8,
+			wantCanonical: []string{"Microsoft", "Mciroosft"},
+			wantMapping:   map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			canonical, mapping, _ := NormalizeKeys(tt.keys, tt.threshold)
+			if !reflect.DeepEqual(canonical, tt.wantCanonical) {
+				t.Errorf("canonical = %v, want %v", canonical, tt.wantCanonical)
+			}
+			if !reflect.DeepEqual(mapping, tt.wantMapping) {
+				t.Errorf("mapping = %v, want %v", mapping, tt.wantMapping)
+			}
+		})
+	}
+}
+
+func TestNormalizeKeys_ReportsUnbucketed(t *testing.T) {
+	keys := make([]string, fuzzyBucketLimit+3)
+	for i := range keys {
+		keys[i] = fmt.Sprintf("ab%d", i)
+	}
+	canonical, _, unbucketed := NormalizeKeys(keys, 0.99)
+	if len(canonical) != len(keys) {
+		t.Errorf("canonical = %d keys, want %d", len(canonical), len(keys))
+	}
+	if unbucketed != 3 {
+		t.Errorf("unbucketed = %d, want 3", unbucketed)
+	}
+	if _, _, unbucketed := NormalizeKeys(keys, 0); unbucketed != 0 {
+		t.Errorf("unbucketed without fuzzy matching = %d, want 0", unbucketed)
+	}
+}
+
+func TestJaroWinkler(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{"martha", "marhta", 0.961},
+		{"dixon", "dicksonx", 0.813},
+		{"same", "same", 1},
+		{"abc", "", 0},
+	}
+	for _, tt := range tests {
+		got := jaroWinkler(tt.a, tt.b)
+		if got < tt.want-0.001 || got > tt.want+0.001 {
+			t.Errorf("jaroWinkler(%q, %q) = %.3f, want %.3f", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestJaroWinklerBound(t *testing.T) {
+	pairs := [][2]string{
+		{"martha", "marhta"},
+		{"dixon", "dicksonx"},
+		{"stripe", "stripe inc"},
+		{"a", "abcdefghij"},
+	}
+	for _, p := range pairs {
+		bound := jaroWinklerBound(len(p[0]), len(p[1]))
+		if score := jaroWinkler(p[0], p[1]); score > bound {
+			t.Errorf("jaroWinkler(%q, %q) = %.3f exceeds bound %.3f", p[0], p[1], score, bound)
+		}
+	}
+	if bound := jaroWinklerBound(1, 10); bound >= 0.9 {
+		t.Errorf("jaroWinklerBound(1, 10) = %.3f, want below 0.9", bound)
+	}
+}
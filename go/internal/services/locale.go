@@ -0,0 +1,58 @@
+package services
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Locale controls how ambiguous numbers and dates in extracted values are
+// read. It is configured per job as a BCP 47 tag such as "en-US" or "de-DE".
+type Locale struct {
+	Tag      string
+	Number   NumberLocale
+	DayFirst bool
+}
+
+// DefaultLocale is used for jobs without a configured locale.
+var DefaultLocale = Locale{Tag: "en-US", Number: NumberLocaleEnglish, DayFirst: false}
+
+var localeTagPattern = regexp.MustCompile(`^[a-zA-Z]{2,3}([-_][a-zA-Z0-9]{2,8})*$`)
+
+var monthFirstRegions = map[string]bool{
+	"US": true, "PH": true, "FM": true, "MH": true, "PW": true, "BZ": true,
+}
+
+var englishNumberLanguages = map[string]bool{
+	"en": true, "ja": true, "zh": true, "ko": true, "he": true, "th": true, "hi": true, "ms": true,
+}
+
+// ValidLocaleTag reports whether tag looks like a BCP 47 language tag.
+func ValidLocaleTag(tag string) bool {
+	return localeTagPattern.MatchString(tag)
+}
+
+// ResolveLocale maps a locale tag to its number and date conventions. Unknown
+// or empty tags resolve to DefaultLocale.
+func ResolveLocale(tag string) Locale {
+	if !ValidLocaleTag(tag) {
+		return DefaultLocale
+	}
+	parts := strings.FieldsFunc(tag, func(r rune) bool { return r == '-' || r == '_' })
+	lang := strings.ToLower(parts[0])
+	region := ""
+	if len(parts) > 1 {
+		region = strings.ToUpper(parts[len(parts)-1])
+	}
+
+	loc := Locale{Tag: tag, Number: NumberLocaleEuropean, DayFirst: true}
+	if englishNumberLanguages[lang] {
+		loc.Number = NumberLocaleEnglish
+	}
+	if region == "CH" || region == "LI" {
+		loc.Number = NumberLocaleSwiss
+	}
+	if monthFirstRegions[region] || (lang == "en" && region == "") {
+		loc.DayFirst = false
+	}
+	return loc
+}
@@ -0,0 +1,117 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+// ErrLookupTimeout is returned when a lookup does not finish in time. The
+// lookup is stopped and nothing is charged for it, since usage is only
+// reported once a result has arrived.
+var ErrLookupTimeout = errors.New("lookup timed out")
+
+type lookupRunner interface {
+	Lookup(ctx context.Context, lookup *models.EntityLookup) (*models.EntityLookupResult, error)
+}
+
+type usageReporter interface {
+	ReportUsage(ctx context.Context, stripeCustomerID string, credits int) error
+}
+
+// LookupService enriches single entities synchronously, for integrations
+// that cannot wait for a batch job.
+type LookupService struct {
+	enricher lookupRunner
+	billing  usageReporter
+	timeout  time.Duration
+}
+
+func NewLookupService(enricher lookupRunner, billing usageReporter, timeout time.Duration) *LookupService {
+	return &LookupService{enricher: enricher, billing: billing, timeout: timeout}
+}
+
+type LookupInput struct {
+	DBUser               *models.User
+	Key                  string
+	KeyColumnDescription *string
+	ColumnsMetadata      []*models.ColumnMetadata
+	Locale               *string
+	// Timeout shortens the service's timeout when it is positive.
+	Timeout time.Duration
+}
+
+// Lookup enriches one entity the way a row of a job is enriched and
+// returns what was found. Credits are checked up front and charged like a
+// row of a batch job, but only once a successful result is in hand.
+func (s *LookupService) Lookup(ctx context.Context, input LookupInput) (*models.EntityLookupResult, error) {
+	key := strings.TrimSpace(input.Key)
+	if err := validateLookup(key, input.ColumnsMetadata, input.Locale); err != nil {
+		return nil, err
+	}
+	credits := requiredCredits(1, input.ColumnsMetadata)
+	if !input.DBUser.CanEnrichCells(credits) {
+		return nil, ErrInsufficientCredits
+	}
+
+	timeout := s.timeout
+	if input.Timeout > 0 && input.Timeout < timeout {
+		timeout = input.Timeout
+	}
+	lookupCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	result, err := s.enricher.Lookup(lookupCtx, &models.EntityLookup{
+		UserID:               input.DBUser.ID,
+		Key:                  key,
+		KeyColumnDescription: Deref(input.KeyColumnDescription),
+		ColumnsMetadata:      input.ColumnsMetadata,
+		Locale:               Deref(input.Locale),
+	})
+	if errors.Is(err, context.DeadlineExceeded) {
+		return nil, ErrLookupTimeout
+	}
+	if err != nil {
+		return nil, err
+	}
+	if result.Success {
+		// The result is returned either way, so a client that disconnects
+		// now is still charged for it.
+		err := s.billing.ReportUsage(context.WithoutCancel(ctx), stripeCustomerIDOrEmpty(input.DBUser), int(credits))
+		if err != nil {
+			log.Printf("Failed to report lookup usage for user %s: %v", input.DBUser.ID, err)
+		}
+	}
+	return result, nil
+}
+
+// validateLookup checks what a lookup can enrich. Classify columns are
+// answered from other columns of a row, which a lookup does not have.
+func validateLookup(key string, cols []*models.ColumnMetadata, locale *string) error {
+	if key == "" {
+		return newValidationError("key is required")
+	}
+	if locale != nil && !ValidLocaleTag(*locale) {
+		return newValidationError(fmt.Sprintf("invalid locale %q", *locale))
+	}
+	enriched, _ := models.SplitDerivedColumns(cols)
+	if len(enriched) == 0 {
+		return newValidationError("at least one column to enrich is required")
+	}
+	for _, col := range enriched {
+		if col.JobType == models.JobTypeClassify {
+			return newValidationError(fmt.Sprintf("classify column %q needs source rows and cannot be looked up", col.Name))
+		}
+	}
+	// A lookup has no source row, so derived columns can only use the
+	// looked-up columns.
+	if err := ValidateDerivedColumns(cols, nil); err != nil {
+		return newValidationError(err.Error())
+	}
+	return nil
+}
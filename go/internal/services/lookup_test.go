@@ -0,0 +1,94 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+type fakeLookupRunner struct {
+	delay  time.Duration
+	fail   bool
+	lookup *models.EntityLookup
+}
+
+func (f *fakeLookupRunner) Lookup(ctx context.Context, lookup *models.EntityLookup) (*models.EntityLookupResult, error) {
+	f.lookup = lookup
+	select {
+	case <-time.After(f.delay):
+		return &models.EntityLookupResult{Key: lookup.Key, Success: !f.fail}, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// fakeUsage records the credits reported per customer.
+type fakeUsage map[string]int
+
+func (f fakeUsage) ReportUsage(ctx context.Context, stripeCustomerID string, credits int) error {
+	f[stripeCustomerID] += credits
+	return nil
+}
+
+func TestLookup(t *testing.T) {
+	expr := "revenue / 2"
+	search := &models.ColumnMetadata{Name: "revenue", Type: models.ColumnTypeNumber, JobType: models.JobTypeEnrichment}
+	classify := &models.ColumnMetadata{Name: "industry", Type: models.ColumnTypeString, JobType: models.JobTypeClassify, InputColumns: []string{"description"}}
+	derived := &models.ColumnMetadata{Name: "half", Type: models.ColumnTypeNumber, JobType: models.JobTypeDerived, Expression: &expr}
+	badLocale := "not a locale"
+	customerID := "cus_1"
+
+	tests := []struct {
+		name    string
+		input   LookupInput
+		delay   time.Duration
+		fail    bool
+		wantErr error
+		// wantInvalid expects a ValidationError.
+		wantInvalid bool
+		wantCharged int
+	}{
+		{"enriches the key", LookupInput{Key: " Acme ", ColumnsMetadata: []*models.ColumnMetadata{search, derived}}, 0, false, nil, false, 1},
+		{"does not charge failed lookups", LookupInput{Key: "Acme", ColumnsMetadata: []*models.ColumnMetadata{search}}, 0, true, nil, false, 0},
+		{"requires a key", LookupInput{Key: " ", ColumnsMetadata: []*models.ColumnMetadata{search}}, 0, false, nil, true, 0},
+		{"requires a searched column", LookupInput{Key: "Acme", ColumnsMetadata: []*models.ColumnMetadata{derived}}, 0, false, nil, true, 0},
+		{"rejects classify columns", LookupInput{Key: "Acme", ColumnsMetadata: []*models.ColumnMetadata{search, classify}}, 0, false, nil, true, 0},
+		{"rejects invalid locales", LookupInput{Key: "Acme", ColumnsMetadata: []*models.ColumnMetadata{search}, Locale: &badLocale}, 0, false, nil, true, 0},
+		{"checks credits", LookupInput{Key: "Acme", ColumnsMetadata: []*models.ColumnMetadata{search}, DBUser: &models.User{StripeCustomerID: &customerID, TokensUsed: 1 << 40}}, 0, false, ErrInsufficientCredits, false, 0},
+		{"times out without charging", LookupInput{Key: "Acme", ColumnsMetadata: []*models.ColumnMetadata{search}, Timeout: 10 * time.Millisecond}, time.Second, false, ErrLookupTimeout, false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			runner := &fakeLookupRunner{delay: tt.delay, fail: tt.fail}
+			usage := fakeUsage{}
+			svc := NewLookupService(runner, usage, time.Minute)
+			if tt.input.DBUser == nil {
+				tt.input.DBUser = &models.User{ID: "user-1", StripeCustomerID: &customerID}
+			}
+
+			result, err := svc.Lookup(context.Background(), tt.input)
+
+			var validErr ValidationError
+			switch {
+			case tt.wantInvalid:
+				if !errors.As(err, &validErr) {
+					t.Errorf("Lookup() error = %v, want a validation error", err)
+				}
+			case tt.wantErr != nil:
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Lookup() error = %v, want %v", err, tt.wantErr)
+				}
+			case err != nil:
+				t.Fatalf("Lookup() error = %v", err)
+			case result.Key != "Acme" || runner.lookup.Key != "Acme":
+				t.Errorf("Lookup() key = %q, want the trimmed key", result.Key)
+			}
+			if usage[customerID] != tt.wantCharged {
+				t.Errorf("charged %d credits, want %d", usage[customerID], tt.wantCharged)
+			}
+		})
+	}
+}
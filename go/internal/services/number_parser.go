@@ -0,0 +1,442 @@
+package services
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unicode"
+)
+
+// NumberLocale describes how a locale writes numbers: which rune separates the
+// fractional part and which runes may be used to group thousands.
+type NumberLocale struct {
+	DecimalSeparator rune
+	GroupSeparators  string
+}
+
+var (
+	NumberLocaleEnglish  = NumberLocale{DecimalSeparator: '.', GroupSeparators: ","}
+	NumberLocaleEuropean = NumberLocale{DecimalSeparator: ',', GroupSeparators: ". \u00a0\u202f"}
+	NumberLocaleSwiss    = NumberLocale{DecimalSeparator: '.', GroupSeparators: "'’"}
+)
+
+// fallbackNumberLocales are tried in order when a numeral is not valid in the
+// requested locale, e.g. "1,5" for English.
+var fallbackNumberLocales = []NumberLocale{NumberLocaleEnglish, NumberLocaleEuropean, NumberLocaleSwiss}
+
+var magnitudeWords = map[string]float64{
+	"k":         1e3,
+	"thousand":  1e3,
+	"thousands": 1e3,
+	"mn":        1e6,
+	"mio":       1e6,
+	"million":   1e6,
+	"millions":  1e6,
+	"bn":        1e9,
+	"mrd":       1e9,
+	"billion":   1e9,
+	"billions":  1e9,
+	"tn":        1e12,
+	"trillion":  1e12,
+	"trillions": 1e12,
+}
+
+// attachedMagnitudes are single letters that read as units when written
+// apart from the number ("5 m"), so they only count when attached to it, as
+// in "5M employees" or "1.2B".
+var attachedMagnitudes = map[string]float64{
+	"m": 1e6,
+	"b": 1e9,
+}
+
+// currencyMagnitudes are abbreviations that read as units even when
+// attached ("3t", "10mm"), so they only count on a currency amount such as
+// "$3t".
+var currencyMagnitudes = map[string]float64{
+	"mm": 1e6,
+	"t":  1e12,
+}
+
+var percentWords = []string{"%", "percent", "pct"}
+
+var rangeConnectors = []string{"-", "–", "—", "to", "~"}
+
+const currencySymbols = "$€£¥₹"
+
+// ParsedNumber is the result of parsing free-form numeric text.
+type ParsedNumber struct {
+	Value     float64
+	Min       float64
+	Max       float64
+	IsRange   bool
+	IsPercent bool
+	// Extracted is set when text around the number (currency, units, words)
+	// was discarded.
+	Extracted bool
+	// FallbackLocale is set when the numeral was not valid in the requested
+	// locale and had to be read with different separators.
+	FallbackLocale bool
+}
+
+type numberToken struct {
+	value          float64
+	multiplier     float64
+	isPercent      bool
+	fallbackLocale bool
+	currency       bool
+	start, end     int
+}
+
+// ParseNumber parses human-written numbers such as "1,234,567", "5k employees",
+// "$2.3 billion", "12%" or "50-100". Ranges resolve to their midpoint.
+func ParseNumber(s string, locale NumberLocale) (*ParsedNumber, error) {
+	text := []rune(strings.TrimSpace(s))
+	if len(text) == 0 {
+		return nil, fmt.Errorf("empty string")
+	}
+
+	first, ok := scanNumber(text, 0, locale)
+	if !ok {
+		return nil, fmt.Errorf("no number found in '%s'", s)
+	}
+
+	result := &ParsedNumber{
+		Value:          first.value,
+		IsPercent:      first.isPercent,
+		FallbackLocale: first.fallbackLocale,
+	}
+	end := first.end
+
+	if second, ok := scanRangeEnd(text, first.end, locale, first.currency); ok {
+		low := first
+		if low.multiplier == 0 && second.multiplier != 0 {
+			// "$2-3 million": the magnitude applies to both ends.
+			low.value *= second.multiplier
+		}
+		result.IsRange = true
+		result.Min = min(low.value, second.value)
+		result.Max = max(low.value, second.value)
+		result.Value = (low.value + second.value) / 2
+		result.IsPercent = first.isPercent || second.isPercent
+		result.FallbackLocale = result.FallbackLocale || second.fallbackLocale
+		end = second.end
+	}
+
+	result.Extracted = first.start > 0 || end < len(text)
+	return result, nil
+}
+
+// scanNumber finds the first number at or after pos.
+func scanNumber(text []rune, pos int, locale NumberLocale) (numberToken, bool) {
+	for i := pos; i < len(text); i++ {
+		if !unicode.IsDigit(text[i]) {
+			continue
+		}
+		start := i
+		if start > 0 && isLeadingDecimal(text, start-1, locale) {
+			// ".5" starts at its decimal separator.
+			start--
+		}
+		numStart := start
+		negative := false
+		if start > 0 && isMinusSign(text[start-1]) && (start == 1 || !isNumeralRune(text[start-2])) {
+			negative = true
+			start--
+		}
+		tok, ok := readNumber(text, numStart, locale, precededByCurrency(text, start))
+		if !ok {
+			return numberToken{}, false
+		}
+		if negative {
+			tok.value = -tok.value
+		}
+		tok.start = start
+		return tok, true
+	}
+	return numberToken{}, false
+}
+
+// scanRangeEnd checks whether a range connector and a second number follow pos.
+// A range that starts with a currency amount is one throughout.
+func scanRangeEnd(text []rune, pos int, locale NumberLocale, currency bool) (numberToken, bool) {
+	i := skipSpaces(text, pos)
+	matched := false
+	for _, connector := range rangeConnectors {
+		if hasWordPrefix(text[i:], connector) {
+			i += len([]rune(connector))
+			matched = true
+			break
+		}
+	}
+	if !matched {
+		return numberToken{}, false
+	}
+	i = skipSpaces(text, i)
+	for i < len(text) && strings.ContainsRune(currencySymbols, text[i]) {
+		currency = true
+		i++
+	}
+	i = skipSpaces(text, i)
+	if i >= len(text) || !unicode.IsDigit(text[i]) {
+		return numberToken{}, false
+	}
+	tok, ok := readNumber(text, i, locale, currency)
+	if !ok {
+		return numberToken{}, false
+	}
+	tok.start = i
+	return tok, true
+}
+
+// readNumber reads a numeral starting at pos followed by an optional magnitude
+// and percent sign. currency tells whether the numeral is a currency amount.
+func readNumber(text []rune, pos int, locale NumberLocale, currency bool) (numberToken, bool) {
+	end := pos
+	for end < len(text) {
+		r := text[end]
+		if unicode.IsDigit(r) {
+			end++
+			continue
+		}
+		// Separators only belong to the numeral when a digit follows them.
+		if isSeparatorRune(r) && end+1 < len(text) && unicode.IsDigit(text[end+1]) {
+			end++
+			continue
+		}
+		break
+	}
+
+	if text[pos] == locale.DecimalSeparator || text[pos] == '.' {
+		// A numeral read from its decimal separator has no group separators.
+		end = pos + 1
+		for end < len(text) && unicode.IsDigit(text[end]) {
+			end++
+		}
+	}
+
+	value, fallback, ok := parseNumeral(string(text[pos:end]), locale)
+	for !ok {
+		// "5 2020" is two numbers rather than a space-grouped one; retry up to
+		// the last space.
+		cut := lastSpace(text[pos:end])
+		if cut <= 0 {
+			return numberToken{}, false
+		}
+		end = pos + cut
+		value, fallback, ok = parseNumeral(string(text[pos:end]), locale)
+	}
+	if exp, n := readExponent(text, end); n > 0 {
+		value *= math.Pow10(exp)
+		end += n
+	}
+	tok := numberToken{value: value, fallbackLocale: fallback, currency: currency, end: end}
+
+	i := skipSpaces(text, end)
+	if word := readWord(text, i); word != "" {
+		multiplier, ok := magnitudeWords[strings.ToLower(word)]
+		if !ok && i == end {
+			multiplier, ok = attachedMagnitudes[strings.ToLower(word)]
+		}
+		if !ok && currency && i == end {
+			multiplier, ok = currencyMagnitudes[strings.ToLower(word)]
+		}
+		if ok {
+			tok.value *= multiplier
+			tok.multiplier = multiplier
+			i += len([]rune(word))
+			tok.end = i
+		}
+	}
+
+	i = skipSpaces(text, tok.end)
+	for _, word := range percentWords {
+		if hasWordPrefix(text[i:], word) {
+			tok.isPercent = true
+			tok.end = i + len([]rune(word))
+			break
+		}
+	}
+	return tok, true
+}
+
+// parseNumeral interprets digits and separators in the given locale, falling
+// back to other locales when the grouping is not valid.
+func parseNumeral(numeral string, locale NumberLocale) (float64, bool, bool) {
+	if v, ok := parseNumeralInLocale(numeral, locale); ok {
+		return v, false, true
+	}
+	for _, fallback := range fallbackNumberLocales {
+		if fallback == locale {
+			continue
+		}
+		if v, ok := parseNumeralInLocale(numeral, fallback); ok {
+			return v, true, true
+		}
+	}
+	return 0, false, false
+}
+
+// readExponent reads an exponent such as "e6" or "E-3" attached at pos and
+// returns it with its length in runes, or a length of 0 when there is none.
+func readExponent(text []rune, pos int) (int, int) {
+	if pos >= len(text) || (text[pos] != 'e' && text[pos] != 'E') {
+		return 0, 0
+	}
+	i := pos + 1
+	sign := 1
+	if i < len(text) && (text[i] == '+' || text[i] == '-') {
+		if text[i] == '-' {
+			sign = -1
+		}
+		i++
+	}
+	digits := i
+	exp := 0
+	for i < len(text) && unicode.IsDigit(text[i]) && i-digits < 3 {
+		exp = exp*10 + int(text[i]-'0')
+		i++
+	}
+	// "1e6x" or "3 eggs" are not exponents.
+	if i == digits || (i < len(text) && (unicode.IsLetter(text[i]) || unicode.IsDigit(text[i]))) {
+		return 0, 0
+	}
+	return sign * exp, i - pos
+}
+
+// isLeadingDecimal reports whether the rune at pos is a decimal separator
+// that starts a numeral, as in ".5", rather than one ending a word or
+// sentence.
+func isLeadingDecimal(text []rune, pos int, locale NumberLocale) bool {
+	if text[pos] != locale.DecimalSeparator && text[pos] != '.' {
+		return false
+	}
+	return pos == 0 || unicode.IsSpace(text[pos-1]) || isMinusSign(text[pos-1]) || strings.ContainsRune(currencySymbols, text[pos-1])
+}
+
+// parseNumeralInLocale accepts a leading group of one to three digits followed
+// by groups of exactly three, all separated by the same rune. The leading
+// group may be empty when a fractional part follows, as in ".5".
+func parseNumeralInLocale(numeral string, locale NumberLocale) (float64, bool) {
+	intPart, fracPart := numeral, ""
+	if idx := strings.IndexRune(numeral, locale.DecimalSeparator); idx >= 0 {
+		intPart = numeral[:idx]
+		fracPart = numeral[idx+len(string(locale.DecimalSeparator)):]
+		if !allDigits(fracPart) {
+			return 0, false
+		}
+	}
+
+	var groups []string
+	var sep rune
+	var current strings.Builder
+	for _, r := range intPart {
+		if strings.ContainsRune(locale.GroupSeparators, r) {
+			if sep != 0 && r != sep {
+				return 0, false
+			}
+			sep = r
+			groups = append(groups, current.String())
+			current.Reset()
+			continue
+		}
+		if r < '0' || r > '9' {
+			return 0, false
+		}
+		current.WriteRune(r)
+	}
+	groups = append(groups, current.String())
+
+	if len(groups) > 1 {
+		if len(groups[0]) < 1 || len(groups[0]) > 3 {
+			return 0, false
+		}
+		for _, g := range groups[1:] {
+			if len(g) != 3 {
+				return 0, false
+			}
+		}
+	} else if groups[0] == "" && fracPart == "" {
+		return 0, false
+	}
+
+	var value float64
+	for _, r := range strings.Join(groups, "") {
+		value = value*10 + float64(r-'0')
+	}
+	scale := 0.1
+	for _, r := range fracPart {
+		value += float64(r-'0') * scale
+		scale /= 10
+	}
+	return value, true
+}
+
+func allDigits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
+
+func isSeparatorRune(r rune) bool {
+	return strings.ContainsRune(".,'’ \u00a0\u202f", r)
+}
+
+func lastSpace(text []rune) int {
+	for i := len(text) - 1; i >= 0; i-- {
+		if unicode.IsSpace(text[i]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// precededByCurrency reports whether a currency symbol comes right before
+// pos, ignoring spaces.
+func precededByCurrency(text []rune, pos int) bool {
+	for i := pos - 1; i >= 0; i-- {
+		if unicode.IsSpace(text[i]) {
+			continue
+		}
+		return strings.ContainsRune(currencySymbols, text[i])
+	}
+	return false
+}
+
+func isNumeralRune(r rune) bool {
+	return unicode.IsDigit(r) || unicode.IsLetter(r)
+}
+
+func isMinusSign(r rune) bool {
+	return r == '-' || r == '−'
+}
+
+func skipSpaces(text []rune, pos int) int {
+	for pos < len(text) && unicode.IsSpace(text[pos]) {
+		pos++
+	}
+	return pos
+}
+
+func readWord(text []rune, pos int) string {
+	end := pos
+	for end < len(text) && unicode.IsLetter(text[end]) {
+		end++
+	}
+	return string(text[pos:end])
+}
+
+// hasWordPrefix reports whether text starts with prefix. Alphabetic prefixes
+// must not run into further letters, so "to" does not match "total".
+func hasWordPrefix(text []rune, prefix string) bool {
+	p := []rune(prefix)
+	if len(text) < len(p) || !strings.EqualFold(string(text[:len(p)]), prefix) {
+		return false
+	}
+	if unicode.IsLetter(p[len(p)-1]) && len(text) > len(p) && unicode.IsLetter(text[len(p)]) {
+		return false
+	}
+	return true
+}
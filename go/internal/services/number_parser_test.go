@@ -0,0 +1,123 @@
+package services
+
+import (
+	"math"
+	"testing"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+func TestParseNumber(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		locale    NumberLocale
+		want      float64
+		isRange   bool
+		isPercent bool
+		extracted bool
+	}{
+		{"plain integer", "42", NumberLocaleEnglish, 42, false, false, false},
+		{"plain decimal", "3.14", NumberLocaleEnglish, 3.14, false, false, false},
+		{"negative", "-12.5", NumberLocaleEnglish, -12.5, false, false, false},
+		{"thousands english", "1,234,567", NumberLocaleEnglish, 1234567, false, false, false},
+		{"thousands with decimal english", "1,234.56", NumberLocaleEnglish, 1234.56, false, false, false},
+		{"thousands european", "1.234.567", NumberLocaleEuropean, 1234567, false, false, false},
+		{"decimal comma european", "1.234,56", NumberLocaleEuropean, 1234.56, false, false, false},
+		{"space grouping european", "1 234 567", NumberLocaleEuropean, 1234567, false, false, false},
+		{"apostrophe grouping swiss", "1'234'567.5", NumberLocaleSwiss, 1234567.5, false, false, false},
+		{"decimal comma in english falls back", "1,5", NumberLocaleEnglish, 1.5, false, false, false},
+		{"k suffix", "5k employees", NumberLocaleEnglish, 5000, false, false, true},
+		{"currency billion", "$2.3 billion", NumberLocaleEnglish, 2.3e9, false, false, true},
+		{"M suffix", "€12M", NumberLocaleEnglish, 12e6, false, false, true},
+		{"bn suffix", "1.2bn", NumberLocaleEnglish, 1.2e9, false, false, false},
+		{"currency m suffix", "$5m", NumberLocaleEnglish, 5e6, false, false, true},
+		{"currency b suffix", "£1.5b", NumberLocaleEnglish, 1.5e9, false, false, true},
+		{"currency range m suffix", "$2-3m", NumberLocaleEnglish, 2.5e6, true, false, true},
+		{"metres not a magnitude", "5 m", NumberLocaleEnglish, 5, false, false, true},
+		{"tonnes not a magnitude", "3 t", NumberLocaleEnglish, 3, false, false, true},
+		{"millimetres not a magnitude", "10mm", NumberLocaleEnglish, 10, false, false, true},
+		{"detached currency letter", "$5 m", NumberLocaleEnglish, 5, false, false, true},
+		{"attached M suffix", "5M employees", NumberLocaleEnglish, 5e6, false, false, true},
+		{"attached B suffix", "1.2B", NumberLocaleEnglish, 1.2e9, false, false, false},
+		{"attached letter inside a word", "5mb", NumberLocaleEnglish, 5, false, false, true},
+		{"tonnes attached not a magnitude", "3t", NumberLocaleEnglish, 3, false, false, true},
+		{"leading decimal separator", ".5", NumberLocaleEnglish, 0.5, false, false, false},
+		{"negative leading decimal separator", "-.25", NumberLocaleEnglish, -0.25, false, false, false},
+		{"leading decimal comma european", ",5", NumberLocaleEuropean, 0.5, false, false, false},
+		{"exponent", "1e6", NumberLocaleEnglish, 1e6, false, false, false},
+		{"negative exponent", "2.5E-3", NumberLocaleEnglish, 0.0025, false, false, false},
+		{"exponent before word", "3e2 units", NumberLocaleEnglish, 300, false, false, true},
+		{"e starting a word is no exponent", "5 eggs", NumberLocaleEnglish, 5, false, false, true},
+		{"million word", "about 4 million users", NumberLocaleEnglish, 4e6, false, false, true},
+		{"german mrd", "2,5 Mrd", NumberLocaleEuropean, 2.5e9, false, false, false},
+		{"unit not a magnitude", "5 months", NumberLocaleEnglish, 5, false, false, true},
+		{"percent sign", "12%", NumberLocaleEnglish, 12, false, true, false},
+		{"percent word", "12.5 percent", NumberLocaleEnglish, 12.5, false, true, false},
+		{"range", "50-100", NumberLocaleEnglish, 75, true, false, false},
+		{"range with spaces and to", "50 to 100 employees", NumberLocaleEnglish, 75, true, false, true},
+		{"range with en dash", "10–20", NumberLocaleEnglish, 15, true, false, false},
+		{"range shared magnitude", "$2-3 million", NumberLocaleEnglish, 2.5e6, true, false, true},
+		{"range both magnitudes", "50k-100k", NumberLocaleEnglish, 75000, true, false, false},
+		{"percent range", "10-20%", NumberLocaleEnglish, 15, true, true, false},
+		{"hyphenated word is not negative", "COVID-19", NumberLocaleEnglish, 19, false, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseNumber(tt.input, tt.locale)
+			if err != nil {
+				t.Fatalf("ParseNumber(%q) error: %v", tt.input, err)
+			}
+			if math.Abs(got.Value-tt.want) > 1e-6*math.Max(1, math.Abs(tt.want)) {
+				t.Errorf("ParseNumber(%q) = %v, want %v", tt.input, got.Value, tt.want)
+			}
+			if got.IsRange != tt.isRange {
+				t.Errorf("ParseNumber(%q).IsRange = %v, want %v", tt.input, got.IsRange, tt.isRange)
+			}
+			if got.IsPercent != tt.isPercent {
+				t.Errorf("ParseNumber(%q).IsPercent = %v, want %v", tt.input, got.IsPercent, tt.isPercent)
+			}
+			if got.Extracted != tt.extracted {
+				t.Errorf("ParseNumber(%q).Extracted = %v, want %v", tt.input, got.Extracted, tt.extracted)
+			}
+		})
+	}
+}
+
+func TestParseNumber_Invalid(t *testing.T) {
+	tests := []string{"", "   ", "n/a", "unknown"}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			if got, err := ParseNumber(input, NumberLocaleEnglish); err == nil {
+				t.Errorf("ParseNumber(%q) = %v, want error", input, got.Value)
+			}
+		})
+	}
+}
+
+func TestParseNumber_RangeBounds(t *testing.T) {
+	got, err := ParseNumber("100-50", NumberLocaleEnglish)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got.Min != 50 || got.Max != 100 {
+		t.Errorf("got min=%v max=%v, want min=50 max=100", got.Min, got.Max)
+	}
+}
+
+func TestCoerceToNumber_RangeNote(t *testing.T) {
+	confidence := map[string]*models.FieldConfidenceInfo{
+		"employees": {Score: 0.9, Reason: "From website"},
+	}
+
+	got := coerceToNumber("50-100", "employees", confidence, NumberLocaleEnglish)
+	if got != 75.0 {
+		t.Errorf("coerceToNumber() = %v, want 75", got)
+	}
+	want := "From website (Note: Range 50 to 100, using midpoint)"
+	if confidence["employees"].Reason != want {
+		t.Errorf("Reason = %q, want %q", confidence["employees"].Reason, want)
+	}
+}
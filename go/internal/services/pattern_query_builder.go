@@ -1,11 +1,14 @@
 package services
 
 import (
+	"sort"
 	"strings"
 
 	"github.com/blagoySimandov/ampledata/go/internal/models"
 )
 
+const entityPlaceholder = "%entity"
+
 type PatternQueryBuilder struct {
 	patterns        []string
 	columnsMetadata []*models.ColumnMetadata
@@ -18,17 +21,62 @@ func NewPatternQueryBuilder(patterns []string, columnsMetadata []*models.ColumnM
 	}
 }
 
-func (pqb *PatternQueryBuilder) Build(entity string) []string {
+// Build fills each pattern with the entity and the row's context values.
+// Placeholders for context columns the row has no value for are dropped.
+func (pqb *PatternQueryBuilder) Build(entity string, rowContext map[string]string) []string {
+	replacer := newPlaceholderReplacer(entity, rowContext)
 	queries := make([]string, len(pqb.patterns))
 
 	for i, pattern := range pqb.patterns {
-		queries[i] = pqb.fillPattern(pattern, entity)
+		queries[i] = pqb.fillPattern(replacer, pattern)
 	}
 
 	return queries
 }
 
-func (pqb *PatternQueryBuilder) fillPattern(pattern string, entity string) string {
-	result := strings.ReplaceAll(pattern, "%entity", entity)
-	return result
+func (pqb *PatternQueryBuilder) fillPattern(replacer *strings.Replacer, pattern string) string {
+	result := replacer.Replace(pattern)
+	return strings.Join(strings.Fields(result), " ")
+}
+
+// ContextPlaceholder returns the query pattern placeholder for a context
+// column: %name for plain identifiers, %{name} otherwise.
+func ContextPlaceholder(name string) string {
+	for _, r := range name {
+		if !(r == '_' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+			return "%{" + name + "}"
+		}
+	}
+	return "%" + name
+}
+
+// newPlaceholderReplacer substitutes all placeholders in a single pass, so
+// values are never re-expanded. Longer placeholders are listed first so that
+// %website wins over a %web column.
+func newPlaceholderReplacer(entity string, rowContext map[string]string) *strings.Replacer {
+	placeholders := map[string]string{entityPlaceholder: entity}
+	for name, value := range rowContext {
+		placeholder := ContextPlaceholder(name)
+		if placeholder == entityPlaceholder {
+			continue
+		}
+		placeholders[placeholder] = value
+	}
+
+	keys := make([]string, 0, len(placeholders))
+	for k := range placeholders {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if len(keys[i]) != len(keys[j]) {
+			return len(keys[i]) > len(keys[j])
+		}
+		return keys[i] < keys[j]
+	})
+
+	pairs := make([]string, 0, len(keys)*2)
+	for _, k := range keys {
+		pairs = append(pairs, k, placeholders[k])
+	}
+	return strings.NewReplacer(pairs...)
 }
@@ -0,0 +1,83 @@
+package services
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestPatternQueryBuilder_Build(t *testing.T) {
+	rowContext := map[string]string{
+		"website":      "linear.app",
+		"web":          "should not match %website",
+		"Company City": "San Francisco",
+		"industry":     "",
+	}
+
+	tests := []struct {
+		name    string
+		pattern string
+		want    string
+	}{
+		{"entity only", "%entity CEO", "Linear CEO"},
+		{"plain placeholder", "%entity %website founder", "Linear linear.app founder"},
+		{"longest placeholder wins", "%web", "should not match %website"},
+		{"braced placeholder", "%entity %{Company City} office", "Linear San Francisco office"},
+		{"empty value is dropped", "%entity %industry revenue", "Linear revenue"},
+		{"unknown placeholder is kept", "%entity %country", "Linear %country"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewPatternQueryBuilder([]string{tt.pattern}, nil).Build("Linear", rowContext)
+			if !reflect.DeepEqual(got, []string{tt.want}) {
+				t.Errorf("Build(%q) = %q, want %q", tt.pattern, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestContextPlaceholder(t *testing.T) {
+	tests := map[string]string{
+		"website":      "%website",
+		"founded_year": "%founded_year",
+		"Company City": "%{Company City}",
+		"e-mail":       "%{e-mail}",
+	}
+	for name, want := range tests {
+		if got := ContextPlaceholder(name); got != want {
+			t.Errorf("ContextPlaceholder(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestFormatEntityContext(t *testing.T) {
+	tests := []struct {
+		name        string
+		description string
+		rowContext  map[string]string
+		want        string
+	}{
+		{"entity only", "", nil, `"Linear"`},
+		{"description", "software company", nil, `"Linear" (context: software company)`},
+		{"row context sorted", "", map[string]string{"website": "linear.app", "city": "San Francisco"}, `"Linear" (context: city: San Francisco, website: linear.app)`},
+		{"empty values skipped", "software company", map[string]string{"website": "linear.app", "city": " "}, `"Linear" (context: software company; website: linear.app)`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatEntityContext("Linear", tt.description, tt.rowContext); got != tt.want {
+				t.Errorf("formatEntityContext() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryPatternPrompt_ContextPlaceholders(t *testing.T) {
+	prompt := NewPromptService().QueryPatternPrompt(nil, []string{"website", "Company City"})
+	for _, want := range []string{"- %website", "- %{Company City}"} {
+		if !strings.Contains(prompt, want) {
+			t.Errorf("prompt is missing placeholder %q", want)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package services
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/blagoySimandov/ampledata/go/internal/models"
@@ -10,10 +11,11 @@ import (
 )
 
 type IPromptService interface {
-	ExtractionPrompt(entity, keyDescription string, columns []*models.ColumnMetadata, content string) string
-	DecisionMakerPrompt(entity, keyDescription string, columns []*models.ColumnMetadata, serp *models.GoogleSearchResults, maxURLs int, previousAttempts []*models.EnrichmentAttempt) string
-	QueryPatternPrompt(columns []*models.ColumnMetadata) string
-	QueryPatternWithFeedbackPrompt(columns []*models.ColumnMetadata, previousAttempts []*models.EnrichmentAttempt) string
+	ExtractionPrompt(entity, keyDescription, instructions string, rowContext map[string]string, columns []*models.ColumnMetadata, content string) string
+	ClassificationPrompt(entity, keyDescription, instructions string, columns []*models.ColumnMetadata, rowValues map[string]string) string
+	DecisionMakerPrompt(entity, keyDescription, instructions string, rowContext map[string]string, columns []*models.ColumnMetadata, serp *models.GoogleSearchResults, maxURLs int, previousAttempts []*models.EnrichmentAttempt) string
+	QueryPatternPrompt(columns []*models.ColumnMetadata, contextColumns []string) string
+	QueryPatternWithFeedbackPrompt(columns []*models.ColumnMetadata, contextColumns []string, previousAttempts []*models.EnrichmentAttempt) string
 	KeySelectorPrompt(headers []string, columns []*models.ColumnMetadata) string
 }
 
@@ -23,19 +25,30 @@ func NewPromptService() *PromptService {
 	return &PromptService{}
 }
 
-func (p *PromptService) ExtractionPrompt(entity, keyDescription string, columns []*models.ColumnMetadata, content string) string {
+func (p *PromptService) ExtractionPrompt(entity, keyDescription, instructions string, rowContext map[string]string, columns []*models.ColumnMetadata, content string) string {
 	return renderPrompt(prompts.Extraction, map[string]string{
-		"entity_context": formatEntityContext(entity, keyDescription),
+		"entity_context": formatEntityContext(entity, keyDescription, rowContext),
 		"columns":        columnsText(columns),
+		"instructions":   instructionsText(instructions),
 		"content":        content, // TODO: maybe truncate the content and have a fetch tool for the agent ?
 	})
 }
 
-func (p *PromptService) DecisionMakerPrompt(entity, keyDescription string, columns []*models.ColumnMetadata, serp *models.GoogleSearchResults, maxURLs int, previousAttempts []*models.EnrichmentAttempt) string {
+func (p *PromptService) ClassificationPrompt(entity, keyDescription, instructions string, columns []*models.ColumnMetadata, rowValues map[string]string) string {
+	return renderPrompt(prompts.Classification, map[string]string{
+		"entity_context": formatEntityContext(entity, keyDescription, nil),
+		"columns":        classifyColumnsText(columns),
+		"instructions":   instructionsText(instructions),
+		"row_data":       rowDataText(ClassifyInputColumns(columns), rowValues),
+	})
+}
+
+func (p *PromptService) DecisionMakerPrompt(entity, keyDescription, instructions string, rowContext map[string]string, columns []*models.ColumnMetadata, serp *models.GoogleSearchResults, maxURLs int, previousAttempts []*models.EnrichmentAttempt) string {
 	return renderPrompt(prompts.DecisionMaker, map[string]string{
-		"entity_context":    formatEntityContext(entity, keyDescription),
+		"entity_context":    formatEntityContext(entity, keyDescription, rowContext),
 		"entity":            entity,
 		"columns":           columnsText(columns),
+		"instructions":      instructionsText(instructions),
 		"search_results":    searchResultsText(serp),
 		"people_also_ask":   peopleAlsoAskText(serp),
 		"max_urls":          fmt.Sprintf("%d", maxURLs),
@@ -43,18 +56,20 @@ func (p *PromptService) DecisionMakerPrompt(entity, keyDescription string, colum
 	})
 }
 
-func (p *PromptService) QueryPatternPrompt(columns []*models.ColumnMetadata) string {
+func (p *PromptService) QueryPatternPrompt(columns []*models.ColumnMetadata, contextColumns []string) string {
 	return renderPrompt(prompts.QueryPattern, map[string]string{
 		"column_count": fmt.Sprintf("%d", len(columns)),
 		"columns":      columnsText(columns),
+		"placeholders": contextPlaceholdersText(contextColumns),
 		"feedback":     "",
 	})
 }
 
-func (p *PromptService) QueryPatternWithFeedbackPrompt(columns []*models.ColumnMetadata, previousAttempts []*models.EnrichmentAttempt) string {
+func (p *PromptService) QueryPatternWithFeedbackPrompt(columns []*models.ColumnMetadata, contextColumns []string, previousAttempts []*models.EnrichmentAttempt) string {
 	return renderPrompt(prompts.QueryPattern, map[string]string{
 		"column_count": fmt.Sprintf("%d", len(columns)),
 		"columns":      columnsText(columns),
+		"placeholders": contextPlaceholdersText(contextColumns),
 		"feedback":     feedbackText(previousAttempts),
 	})
 }
@@ -72,11 +87,56 @@ func (p *PromptService) KeySelectorPrompt(headers []string, columns []*models.Co
 	})
 }
 
-func formatEntityContext(entity, keyColumnDescription string) string {
-	if keyColumnDescription == "" {
+func formatEntityContext(entity, keyColumnDescription string, rowContext map[string]string) string {
+	var details []string
+	if keyColumnDescription != "" {
+		details = append(details, keyColumnDescription)
+	}
+	if known := rowContextText(rowContext); known != "" {
+		details = append(details, known)
+	}
+	if len(details) == 0 {
 		return fmt.Sprintf(`"%s"`, entity)
 	}
-	return fmt.Sprintf(`"%s" (context: %s)`, entity, keyColumnDescription)
+	return fmt.Sprintf(`"%s" (context: %s)`, entity, strings.Join(details, "; "))
+}
+
+// instructionsText renders a user's extra instructions for a re-run, or
+// nothing when there are none.
+func instructionsText(instructions string) string {
+	instructions = strings.TrimSpace(instructions)
+	if instructions == "" {
+		return ""
+	}
+	return fmt.Sprintf("\n<additional_instructions>\nThe user re-ran these fields with the following instructions. Follow them unless they conflict with the response format.\n%s\n</additional_instructions>\n", instructions)
+}
+
+// rowContextText lists the row's non-empty context values, sorted by column
+// name so prompts are stable across runs.
+func rowContextText(rowContext map[string]string) string {
+	names := make([]string, 0, len(rowContext))
+	for name, value := range rowContext {
+		if strings.TrimSpace(value) != "" {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	parts := make([]string, len(names))
+	for i, name := range names {
+		parts[i] = fmt.Sprintf("%s: %s", name, strings.TrimSpace(rowContext[name]))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func contextPlaceholdersText(contextColumns []string) string {
+	if len(contextColumns) == 0 {
+		return ""
+	}
+	lines := make([]string, len(contextColumns))
+	for i, name := range contextColumns {
+		lines[i] = fmt.Sprintf("- %s (value of the %q column for the row)", ContextPlaceholder(name), name)
+	}
+	return "\nCONTEXT PLACEHOLDERS (optional, use them when they make the query more specific):\n" + strings.Join(lines, "\n") + "\n"
 }
 
 func renderPrompt(tmpl string, vars map[string]string) string {
@@ -99,6 +159,26 @@ func columnsText(columns []*models.ColumnMetadata) string {
 	return strings.Join(lines, "\n")
 }
 
+func classifyColumnsText(columns []*models.ColumnMetadata) string {
+	lines := make([]string, 0, len(columns))
+	for _, col := range columns {
+		line := fmt.Sprintf("- %s [type: %s] [from: %s]", col.Name, col.Type, strings.Join(col.InputColumns, ", "))
+		if col.Description != nil {
+			line += fmt.Sprintf(" (%s)", *col.Description)
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func rowDataText(names []string, values map[string]string) string {
+	lines := make([]string, 0, len(names))
+	for _, name := range names {
+		lines = append(lines, fmt.Sprintf("%s: %s", name, values[name]))
+	}
+	return strings.Join(lines, "\n")
+}
+
 func searchResultsText(serp *models.GoogleSearchResults) string {
 	var sb strings.Builder
 	for i, r := range serp.Organic {
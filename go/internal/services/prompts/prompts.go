@@ -16,3 +16,6 @@ var KeySelector string
 
 //go:embed source-name.txt
 var SourceName string
+
+//go:embed classification.txt
+var Classification string
@@ -21,8 +21,8 @@ func NewPatternGenerator(aiClient IAIClient, promptService IPromptService) (*Pat
 	}, nil
 }
 
-func (g *PatternGenerator) GeneratePatterns(ctx context.Context, columnsMetadata []*models.ColumnMetadata) ([]string, error) {
-	prompt := g.promptService.QueryPatternPrompt(columnsMetadata)
+func (g *PatternGenerator) GeneratePatterns(ctx context.Context, columnsMetadata []*models.ColumnMetadata, contextColumns []string) ([]string, error) {
+	prompt := g.promptService.QueryPatternPrompt(columnsMetadata, contextColumns)
 	result, err := g.client.GenerateContent(ctx, prompt)
 	if err != nil {
 		return g.getFallbackPatterns(columnsMetadata), fmt.Errorf("failed to generate content: %w", err)
@@ -30,8 +30,8 @@ func (g *PatternGenerator) GeneratePatterns(ctx context.Context, columnsMetadata
 	return g.parseResponse(result, columnsMetadata)
 }
 
-func (g *PatternGenerator) GeneratePatternsWithFeedback(ctx context.Context, columnsMetadata []*models.ColumnMetadata, previousAttempts []*models.EnrichmentAttempt) ([]string, error) {
-	prompt := g.promptService.QueryPatternWithFeedbackPrompt(columnsMetadata, previousAttempts)
+func (g *PatternGenerator) GeneratePatternsWithFeedback(ctx context.Context, columnsMetadata []*models.ColumnMetadata, contextColumns []string, previousAttempts []*models.EnrichmentAttempt) ([]string, error) {
+	prompt := g.promptService.QueryPatternWithFeedbackPrompt(columnsMetadata, contextColumns, previousAttempts)
 	result, err := g.client.GenerateContent(ctx, prompt)
 	if err != nil {
 		return g.getFallbackPatterns(columnsMetadata), fmt.Errorf("failed to generate content: %w", err)
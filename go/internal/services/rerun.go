@@ -0,0 +1,204 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/blagoySimandov/ampledata/go/internal/gcs"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/state"
+)
+
+// IRowRerunner starts the re-run of some cells of a job.
+type IRowRerunner interface {
+	Rerun(ctx context.Context, rerun *models.RowRerun) error
+}
+
+// RerunInput selects the rows and columns of a finished job to enrich
+// again. Without Columns every enriched column of the rows is re-run.
+type RerunInput struct {
+	JobID        string
+	DBUser       *models.User
+	RowKeys      []string
+	Columns      []string
+	Instructions string
+	Merge        models.MergeMode
+}
+
+// RerunResult describes a started re-run.
+type RerunResult struct {
+	JobID   string
+	Rows    int
+	Credits int64
+}
+
+// RerunService re-enriches selected rows and columns of finished jobs.
+type RerunService struct {
+	store    state.Store
+	sources  ISourceRowOpener
+	rerunner IRowRerunner
+}
+
+func NewRerunService(store state.Store, sources ISourceRowOpener, rerunner IRowRerunner) *RerunService {
+	return &RerunService{store: store, sources: sources, rerunner: rerunner}
+}
+
+// Rerun starts the re-run of the selected cells. Cells a reviewer has
+// corrected are never re-run, and only the re-run cells are billed.
+func (s *RerunService) Rerun(ctx context.Context, input RerunInput) (*RerunResult, error) {
+	job, err := s.store.GetJob(ctx, input.JobID)
+	if err != nil {
+		return nil, ErrJobNotFound
+	}
+	if job.UserID != input.DBUser.ID {
+		return nil, ErrJobForbidden
+	}
+	if job.Status != models.JobStatusCompleted && job.Status != models.JobStatusCancelled {
+		return nil, newValidationError(fmt.Sprintf("job is %s; only completed or cancelled jobs can be re-run", job.Status))
+	}
+
+	merge, err := resolveMergeMode(input.Merge)
+	if err != nil {
+		return nil, err
+	}
+	columns, err := rerunColumns(job.ColumnsMetadata, input.Columns)
+	if err != nil {
+		return nil, err
+	}
+	keys := deduplicateKeys(input.RowKeys)
+	if len(keys) == 0 {
+		return nil, newValidationError("at least one row key is required")
+	}
+	rows, err := s.store.GetRowStates(ctx, job.JobID, keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load rows: %w", err)
+	}
+	for _, key := range keys {
+		if rows[key] == nil {
+			return nil, newValidationError(fmt.Sprintf("row %q is not part of this job", key))
+		}
+	}
+
+	rowColumns, credits := planRerun(rows, columns)
+	if len(rowColumns) == 0 {
+		return nil, newValidationError("every selected cell has been corrected by a reviewer")
+	}
+	if !input.DBUser.CanEnrichCells(credits) {
+		return nil, ErrInsufficientCredits
+	}
+
+	// Rows read their source cells from the store, like the job's own rows,
+	// so the re-run workflow only carries row keys. All of the job's input
+	// columns are stored, as derived columns read them too.
+	rowValues, err := s.readRowValues(ctx, job, rowValueColumns(job.ColumnsMetadata, job.ContextColumns), slices.Collect(maps.Keys(rowColumns)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read source: %w", err)
+	}
+	if len(rowValues) > 0 {
+		if err := s.store.SaveRowInputValues(ctx, job.JobID, rowValues); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.rerunner.Rerun(ctx, &models.RowRerun{
+		Job:              job,
+		UserID:           input.DBUser.ID,
+		StripeCustomerID: stripeCustomerIDOrEmpty(input.DBUser),
+		Columns:          rowColumns,
+		Instructions:     input.Instructions,
+		Merge:            merge,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &RerunResult{JobID: job.JobID, Rows: len(rowColumns), Credits: credits}, nil
+}
+
+// readRowValues reads the input columns of the re-run rows from the source
+// revision the job was enriched from.
+func (s *RerunService) readRowValues(ctx context.Context, job *models.Job, columns, keys []string) (map[string]map[string]string, error) {
+	if len(columns) == 0 {
+		return nil, nil
+	}
+	if job.Source == nil {
+		return nil, fmt.Errorf("job has no source")
+	}
+	it, err := s.sources.OpenSourceRevisionRows(ctx, job.Source, job.SourceRevision)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	return gcs.ScanRowValuesFor(it, job.KeyColumns, columns, keys)
+}
+
+func resolveMergeMode(mode models.MergeMode) (models.MergeMode, error) {
+	switch mode {
+	case "":
+		return models.MergeBest, nil
+	case models.MergeBest, models.MergeReplace:
+		return mode, nil
+	default:
+		return "", newValidationError(fmt.Sprintf("unknown merge mode %q", mode))
+	}
+}
+
+// rerunColumns resolves the selected column names against the job's
+// columns. Derived columns are recomputed from the others and cannot be
+// selected; without names, every enriched column is re-run.
+func rerunColumns(jobColumns []*models.ColumnMetadata, names []string) ([]*models.ColumnMetadata, error) {
+	enriched, _ := models.SplitDerivedColumns(jobColumns)
+	if len(names) == 0 {
+		if len(enriched) == 0 {
+			return nil, newValidationError("job has no enriched columns to re-run")
+		}
+		return enriched, nil
+	}
+
+	byName := make(map[string]*models.ColumnMetadata, len(jobColumns))
+	for _, col := range jobColumns {
+		byName[col.Name] = col
+	}
+	selected := make([]*models.ColumnMetadata, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		col := byName[name]
+		switch {
+		case col == nil:
+			return nil, newValidationError(fmt.Sprintf("column %q is not enriched by this job", name))
+		case col.JobType == models.JobTypeDerived:
+			return nil, newValidationError(fmt.Sprintf("column %q is derived and is recomputed with the others", name))
+		case !seen[name]:
+			seen[name] = true
+			selected = append(selected, col)
+		}
+	}
+	return selected, nil
+}
+
+// planRerun lists the columns each row re-runs, which are the selected
+// columns minus the cells reviewers have verified, and the credits that
+// costs. Rows with nothing left to re-run are left out.
+func planRerun(rows map[string]*models.RowState, columns []*models.ColumnMetadata) (map[string][]string, int64) {
+	plan := make(map[string][]string, len(rows))
+	searchedCells, classifyCells := 0, 0
+	for key, row := range rows {
+		var names []string
+		for _, col := range columns {
+			if row.IsVerified(col.Name) {
+				continue
+			}
+			names = append(names, col.Name)
+			if col.JobType == models.JobTypeClassify {
+				classifyCells++
+			} else {
+				searchedCells++
+			}
+		}
+		if len(names) > 0 {
+			plan[key] = names
+		}
+	}
+	return plan, int64(searchedCells + classifyCredits(classifyCells))
+}
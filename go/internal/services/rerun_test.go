@@ -0,0 +1,102 @@
+package services
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+)
+
+func TestRerunColumns(t *testing.T) {
+	jobColumns := []*models.ColumnMetadata{
+		{Name: "ceo", JobType: models.JobTypeEnrichment},
+		{Name: "industry", JobType: models.JobTypeClassify},
+		{Name: "ceo_upper", JobType: models.JobTypeDerived},
+	}
+	tests := []struct {
+		name    string
+		names   []string
+		want    []string
+		wantErr bool
+	}{
+		{"all enriched by default", nil, []string{"ceo", "industry"}, false},
+		{"subset", []string{"industry"}, []string{"industry"}, false},
+		{"duplicates", []string{"ceo", "ceo"}, []string{"ceo"}, false},
+		{"derived", []string{"ceo_upper"}, nil, true},
+		{"unknown", []string{"revenue"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cols, err := rerunColumns(jobColumns, tt.names)
+			if tt.wantErr {
+				var validErr ValidationError
+				if !errors.As(err, &validErr) {
+					t.Fatalf("error = %v, want a validation error", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got []string
+			for _, col := range cols {
+				got = append(got, col.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("columns = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanRerun(t *testing.T) {
+	columns := []*models.ColumnMetadata{
+		{Name: "ceo", JobType: models.JobTypeEnrichment},
+		{Name: "revenue", JobType: models.JobTypeEnrichment},
+		{Name: "industry", JobType: models.JobTypeClassify},
+	}
+	verified := func(names ...string) map[string]*models.FieldConfidenceInfo {
+		conf := make(map[string]*models.FieldConfidenceInfo)
+		for _, name := range names {
+			conf[name] = &models.FieldConfidenceInfo{Score: 1, HumanVerified: true}
+		}
+		return conf
+	}
+	tests := []struct {
+		name        string
+		rows        map[string]*models.RowState
+		want        map[string][]string
+		wantCredits int64
+	}{
+		{
+			name:        "every cell",
+			rows:        map[string]*models.RowState{"Acme": {}, "Globex": {}},
+			want:        map[string][]string{"Acme": {"ceo", "revenue", "industry"}, "Globex": {"ceo", "revenue", "industry"}},
+			wantCredits: int64(4 + classifyCredits(2)),
+		},
+		{
+			name:        "verified cells are skipped",
+			rows:        map[string]*models.RowState{"Acme": {Confidence: verified("ceo", "industry")}},
+			want:        map[string][]string{"Acme": {"revenue"}},
+			wantCredits: 1,
+		},
+		{
+			name:        "fully verified row is dropped",
+			rows:        map[string]*models.RowState{"Acme": {Confidence: verified("ceo", "revenue", "industry")}, "Globex": {}},
+			want:        map[string][]string{"Globex": {"ceo", "revenue", "industry"}},
+			wantCredits: int64(2 + classifyCredits(1)),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, credits := planRerun(tt.rows, columns)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("plan = %v, want %v", got, tt.want)
+			}
+			if credits != tt.wantCredits {
+				t.Errorf("credits = %d, want %d", credits, tt.wantCredits)
+			}
+		})
+	}
+}
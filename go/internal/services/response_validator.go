@@ -2,18 +2,31 @@ package services
 
 import (
 	"fmt"
+	"math"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/blagoySimandov/ampledata/go/internal/models"
 )
 
+// CoercionOptions carries the per-job settings used to coerce extracted values.
+type CoercionOptions struct {
+	Locale Locale
+	// ReferenceDate anchors relative dates such as "3 days ago". Defaults to now.
+	ReferenceDate time.Time
+}
+
 func ValidateAndCoerceTypes(
 	extractedData map[string]interface{},
 	columnsMetadata []*models.ColumnMetadata,
 	confidence map[string]*models.FieldConfidenceInfo,
+	opts CoercionOptions,
 ) map[string]interface{} {
 	validated := make(map[string]interface{})
+	if opts.ReferenceDate.IsZero() {
+		opts.ReferenceDate = time.Now()
+	}
 
 	for _, col := range columnsMetadata {
 		value, exists := extractedData[col.Name]
@@ -29,13 +42,16 @@ func ValidateAndCoerceTypes(
 			validated[col.Name] = coerceToString(value, col.Name, confidence)
 
 		case models.ColumnTypeNumber:
-			validated[col.Name] = coerceToNumber(value, col.Name, confidence)
+			validated[col.Name] = coerceToNumber(value, col.Name, confidence, opts.Locale.Number)
 
 		case models.ColumnTypeBoolean:
 			validated[col.Name] = coerceToBoolean(value, col.Name, confidence)
 
 		case models.ColumnTypeDate:
-			validated[col.Name] = coerceToDate(value, col.Name, confidence)
+			validated[col.Name] = coerceToDate(value, col.Name, confidence, opts)
+
+		case models.ColumnTypeList:
+			validated[col.Name] = coerceToList(value, col.Name, confidence)
 		}
 	}
 
@@ -65,7 +81,31 @@ func coerceToString(value interface{}, fieldName string, confidence map[string]*
 	}
 }
 
-func coerceToNumber(value interface{}, fieldName string, confidence map[string]*models.FieldConfidenceInfo) interface{} {
+func coerceToList(value interface{}, fieldName string, confidence map[string]*models.FieldConfidenceInfo) interface{} {
+	switch v := value.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		items := make([]interface{}, 0, len(v))
+		for _, item := range v {
+			if item == nil {
+				continue
+			}
+			items = append(items, coerceToString(item, fieldName, confidence))
+		}
+		return items
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return nil
+		}
+		// String → single item list
+		return []interface{}{v}
+	default:
+		return []interface{}{fmt.Sprintf("%v", value)}
+	}
+}
+
+func coerceToNumber(value interface{}, fieldName string, confidence map[string]*models.FieldConfidenceInfo, locale NumberLocale) interface{} {
 	switch v := value.(type) {
 	case float64:
 		return v
@@ -76,30 +116,18 @@ func coerceToNumber(value interface{}, fieldName string, confidence map[string]*
 	case int64:
 		return float64(v)
 	case string:
-		// Try to parse string to number
-		var num float64
-		_, err := fmt.Sscanf(v, "%f", &num)
-		if err == nil {
-			return num
-		}
-		// Try to extract number from string (e.g., "$100.50" → 100.50)
-		cleaned := extractNumberFromString(v)
-		if cleaned != "" {
-			_, err := fmt.Sscanf(cleaned, "%f", &num)
-			if err == nil {
-				// Add note for extracted numbers
-				if conf, exists := confidence[fieldName]; exists {
-					conf.Reason += " (Note: Number extracted from string)"
-				}
-				return num
+		parsed, err := ParseNumber(v, locale)
+		if err != nil {
+			if conf, exists := confidence[fieldName]; exists {
+				conf.Score = 0.0
+				conf.Reason += fmt.Sprintf(" (Error: Could not coerce string '%s' to number)", v)
 			}
+			return nil
 		}
-		// Failed to parse
 		if conf, exists := confidence[fieldName]; exists {
-			conf.Score = 0.0
-			conf.Reason += fmt.Sprintf(" (Error: Could not coerce string '%s' to number)", v)
+			conf.Reason += numberParseNotes(parsed)
 		}
-		return nil
+		return parsed.Value
 	case []interface{}:
 		// Array → take first numeric element or average
 		var numbers []float64
@@ -145,23 +173,25 @@ func coerceToNumber(value interface{}, fieldName string, confidence map[string]*
 	}
 }
 
-func extractNumberFromString(s string) string {
-	var result strings.Builder
-	hasDecimal := false
-
-	for i, char := range s {
-		if char >= '0' && char <= '9' {
-			result.WriteRune(char)
-		} else if char == '.' || char == ',' {
-			if !hasDecimal && i > 0 && i < len(s)-1 { // Not at start or end
-				hasDecimal = true
-				result.WriteRune('.')
-			}
-		} else if char == '-' && result.Len() == 0 { // Minus sign at start
-			result.WriteRune(char)
-		}
+func numberParseNotes(parsed *ParsedNumber) string {
+	var notes string
+	if parsed.IsRange {
+		notes += fmt.Sprintf(" (Note: Range %s to %s, using midpoint)", formatFloat(parsed.Min), formatFloat(parsed.Max))
 	}
-	return result.String()
+	if parsed.IsPercent {
+		notes += " (Note: Value is a percentage)"
+	}
+	if parsed.FallbackLocale {
+		notes += " (Note: Number separators did not match locale)"
+	}
+	if parsed.Extracted {
+		notes += " (Note: Number extracted from string)"
+	}
+	return notes
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
 }
 
 func coerceToBoolean(value interface{}, fieldName string, confidence map[string]*models.FieldConfidenceInfo) interface{} {
@@ -233,36 +263,54 @@ func coerceToBoolean(value interface{}, fieldName string, confidence map[string]
 	}
 }
 
-func coerceToDate(value interface{}, fieldName string, confidence map[string]*models.FieldConfidenceInfo) interface{} {
+// unscoredDateConfidence is the score of a date the extractor returned
+// without a confidence, matching values taken from search results without
+// one.
+const unscoredDateConfidence = 0.6
+
+// Whole numbers in this range are read as years rather than Unix
+// timestamps, which only reach them within hours of 1970.
+const (
+	minNumericYear = 1000
+	maxNumericYear = 9999
+)
+
+func coerceToDate(value interface{}, fieldName string, confidence map[string]*models.FieldConfidenceInfo, opts CoercionOptions) interface{} {
 	switch v := value.(type) {
 	case string:
-		// Try multiple date formats
-		dateFormats := []string{
-			"2006-01-02",                // ISO 8601
-			"01/02/2006",                // US format
-			"02/01/2006",                // EU format
-			"2006-01-02T15:04:05Z07:00", // RFC 3339
-			"2006-01-02 15:04:05",       // DateTime
-			"January 2, 2006",           // Long format
-			"Jan 2, 2006",               // Short format
-		}
-
-		for _, format := range dateFormats {
-			if parsed, err := time.Parse(format, strings.TrimSpace(v)); err == nil {
-				return parsed.Format("2006-01-02") // Return as ISO 8601
+		parsed, err := ParseDate(v, opts.Locale, opts.ReferenceDate)
+		if err != nil {
+			if conf, exists := confidence[fieldName]; exists {
+				conf.Score = 0.0
+				conf.Reason += fmt.Sprintf(" (Error: Could not parse date string '%s')", v)
 			}
+			return nil
 		}
-
-		// Failed to parse
+		setDatePrecision(confidence, fieldName, parsed.Precision)
 		if conf, exists := confidence[fieldName]; exists {
-			conf.Score = 0.0
-			conf.Reason += fmt.Sprintf(" (Error: Could not parse date string '%s')", v)
+			if parsed.Relative {
+				conf.Reason += fmt.Sprintf(" (Note: Relative date resolved against %s)", opts.ReferenceDate.Format("2006-01-02"))
+			}
+			if parsed.Ambiguous {
+				conf.Reason += fmt.Sprintf(" (Note: Day/month order taken from locale %s)", opts.Locale.Tag)
+			}
 		}
-		return nil
+		return parsed.Date.Format("2006-01-02") // Return as ISO 8601
 	case float64:
-		// Unix timestamp
-		parsed := time.Unix(int64(v), 0)
-		// Add note for timestamp conversion
+		if v == math.Trunc(v) && v >= minNumericYear && v <= maxNumericYear {
+			// A year such as founded_year: 2019.
+			setDatePrecision(confidence, fieldName, models.DatePrecisionYear)
+			return time.Date(int(v), time.January, 1, 0, 0, 0, 0, time.UTC).Format("2006-01-02")
+		}
+		if v <= maxNumericYear {
+			if conf, exists := confidence[fieldName]; exists {
+				conf.Score = 0.0
+				conf.Reason += fmt.Sprintf(" (Error: Number %v is neither a year nor a Unix timestamp)", v)
+			}
+			return nil
+		}
+		parsed := time.Unix(int64(v), 0).UTC()
+		setDatePrecision(confidence, fieldName, models.DatePrecisionDay)
 		if conf, exists := confidence[fieldName]; exists {
 			conf.Reason += " (Note: Coerced from Unix timestamp)"
 		}
@@ -270,7 +318,7 @@ func coerceToDate(value interface{}, fieldName string, confidence map[string]*mo
 	case []interface{}:
 		// Array → take first date element
 		for _, item := range v {
-			result := coerceToDate(item, fieldName, confidence)
+			result := coerceToDate(item, fieldName, confidence, opts)
 			if result != nil {
 				if conf, exists := confidence[fieldName]; exists {
 					conf.Reason += " (Note: Date extracted from array)"
@@ -291,3 +339,21 @@ func coerceToDate(value interface{}, fieldName string, confidence map[string]*mo
 		return nil
 	}
 }
+
+// setDatePrecision records the precision of a parsed date. A date without
+// a confidence entry is given one, so a partial date such as "2019" still
+// differs from a full first-of-year date.
+func setDatePrecision(confidence map[string]*models.FieldConfidenceInfo, fieldName string, precision models.DatePrecision) {
+	if confidence == nil {
+		return
+	}
+	conf, exists := confidence[fieldName]
+	if !exists {
+		conf = &models.FieldConfidenceInfo{
+			Score:  unscoredDateConfidence,
+			Reason: "No per-field confidence provided",
+		}
+		confidence[fieldName] = conf
+	}
+	conf.Precision = precision
+}
@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/blagoySimandov/ampledata/go/internal/gcs"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/sqlsource"
+	"github.com/blagoySimandov/ampledata/go/internal/state"
+)
+
+// writeBackPageSize is how many completed rows are read and upserted at once.
+const writeBackPageSize = 500
+
+// ResultWriteBack upserts the enriched results of a job into the write-back
+// table of its query source.
+type ResultWriteBack struct {
+	store       state.Store
+	connections *sqlsource.Registry
+}
+
+func NewResultWriteBack(store state.Store, connections *sqlsource.Registry) *ResultWriteBack {
+	return &ResultWriteBack{store: store, connections: connections}
+}
+
+// WriteBackResults writes every completed row of a job back to its source
+// and returns the number of rows written. Jobs on sources without a
+// write-back table are skipped.
+func (w *ResultWriteBack) WriteBackResults(ctx context.Context, jobID string) (int, error) {
+	job, err := w.store.GetJob(ctx, jobID)
+	if err != nil {
+		return 0, err
+	}
+	if job.Source == nil {
+		return 0, nil
+	}
+	meta, ok := job.Source.Metadata.(*models.SQLSourceMetadata)
+	if !ok || meta.WriteBack == nil {
+		return 0, nil
+	}
+	if err := w.connections.Authorize(meta.Connection, job.UserID); err != nil {
+		return 0, err
+	}
+	// The table was chosen by the user, so it is checked against the
+	// connection's write tables again in case the configuration changed.
+	if err := w.connections.AuthorizeWrite(meta.Connection, meta.WriteBack.Table); err != nil {
+		return 0, err
+	}
+
+	target := sqlsource.UpsertTarget{
+		Table:      meta.WriteBack.Table,
+		KeyColumns: job.KeyColumns,
+		Columns:    make([]string, len(job.ColumnsMetadata)),
+	}
+	for i, col := range job.ColumnsMetadata {
+		target.Columns[i] = col.Name
+	}
+
+	// Rows merged by key normalization get the result of their canonical row.
+	aliases := models.KeyAliases(job.KeyMapping)
+	written := 0
+	var afterKey *string
+	for {
+		rows, err := w.store.GetRowsAtStageAfterKey(ctx, jobID, models.StageCompleted, afterKey, writeBackPageSize)
+		if err != nil {
+			return written, err
+		}
+		if len(rows) == 0 {
+			return written, nil
+		}
+		values := make([][]interface{}, 0, len(rows))
+		for _, row := range rows {
+			for _, key := range append([]string{row.Key}, aliases[row.Key]...) {
+				v, err := writeBackValues(key, row.ExtractedData, target)
+				if err != nil {
+					return written, err
+				}
+				values = append(values, v)
+			}
+		}
+		if err := w.connections.Upsert(ctx, meta.Connection, target, values); err != nil {
+			return written, err
+		}
+		written += len(values)
+		if len(rows) < writeBackPageSize {
+			return written, nil
+		}
+		afterKey = &rows[len(rows)-1].Key
+	}
+}
+
+// writeBackValues lays out a row as its key parts followed by the enriched
+// values. Structured values are written as JSON.
+func writeBackValues(key string, data map[string]interface{}, target sqlsource.UpsertTarget) ([]interface{}, error) {
+	keyParts := strings.Split(key, gcs.CompositeKeyDelimiter)
+	if len(keyParts) != len(target.KeyColumns) {
+		return nil, fmt.Errorf("row key %q does not match key columns %v", key, target.KeyColumns)
+	}
+	values := make([]interface{}, 0, len(keyParts)+len(target.Columns))
+	for _, part := range keyParts {
+		values = append(values, part)
+	}
+	for _, col := range target.Columns {
+		switch v := data[col].(type) {
+		case map[string]interface{}, []interface{}:
+			encoded, err := json.Marshal(v)
+			if err != nil {
+				return nil, fmt.Errorf("failed to encode %s: %w", col, err)
+			}
+			values = append(values, string(encoded))
+		default:
+			values = append(values, v)
+		}
+	}
+	return values, nil
+}
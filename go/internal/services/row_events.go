@@ -0,0 +1,104 @@
+package services
+
+import (
+	"context"
+	"time"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/state"
+)
+
+// rowEventBatchSize is how many row events are read per store query.
+const rowEventBatchSize = 500
+
+// rowEventHeartbeat is how often an idle stream checks the store for
+// events it was not signalled about and sends a heartbeat.
+const rowEventHeartbeat = 15 * time.Second
+
+// IRowEventSubscriber signals new row events of a job.
+type IRowEventSubscriber interface {
+	Subscribe(jobID string) (<-chan struct{}, func())
+}
+
+// RowEventService streams the row stage transitions of jobs.
+type RowEventService struct {
+	store  state.Store
+	broker IRowEventSubscriber
+}
+
+func NewRowEventService(store state.Store, broker IRowEventSubscriber) *RowEventService {
+	return &RowEventService{store: store, broker: broker}
+}
+
+// RowEventStream follows the row events of one job. It must be closed.
+type RowEventStream struct {
+	store   state.Store
+	jobID   string
+	signals <-chan struct{}
+	Close   func()
+}
+
+// OpenStream subscribes to the row events of a job owned by the user.
+func (s *RowEventService) OpenStream(ctx context.Context, jobID, userID string) (*RowEventStream, error) {
+	job, err := s.store.GetJob(ctx, jobID)
+	if err != nil {
+		return nil, ErrJobNotFound
+	}
+	if job.UserID != userID {
+		return nil, ErrJobForbidden
+	}
+	signals, unsubscribe := s.broker.Subscribe(jobID)
+	return &RowEventStream{store: s.store, jobID: jobID, signals: signals, Close: unsubscribe}, nil
+}
+
+// Run sends the job's events after lastEventID, then every new event as it
+// is stored, until ctx is done or send fails. heartbeat is called after
+// rowEventHeartbeat without events, so idle connections stay open.
+func (st *RowEventStream) Run(ctx context.Context, lastEventID int64, send func(*models.RowEvent) error, heartbeat func() error) error {
+	ticker := time.NewTicker(rowEventHeartbeat)
+	defer ticker.Stop()
+
+	last := lastEventID
+	for {
+		sent, err := st.sendAfter(ctx, &last, send)
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-st.signals:
+		case <-ticker.C:
+			if sent == 0 {
+				if err := heartbeat(); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// sendAfter sends every stored event after *last, advancing it, and
+// returns how many were sent.
+func (st *RowEventStream) sendAfter(ctx context.Context, last *int64, send func(*models.RowEvent) error) (int, error) {
+	sent := 0
+	for {
+		events, err := st.store.GetRowEvents(ctx, st.jobID, *last, rowEventBatchSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return sent, nil
+			}
+			return sent, err
+		}
+		for _, event := range events {
+			if err := send(event); err != nil {
+				return sent, err
+			}
+			*last = event.ID
+			sent++
+		}
+		if len(events) < rowEventBatchSize {
+			return sent, nil
+		}
+	}
+}
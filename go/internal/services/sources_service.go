@@ -6,18 +6,28 @@ import (
 	"errors"
 	"fmt"
 	"mime"
+	"slices"
 	"time"
 
 	"github.com/blagoySimandov/ampledata/go/internal/gcs"
+	"github.com/blagoySimandov/ampledata/go/internal/logger"
 	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/sqlsource"
 	"github.com/blagoySimandov/ampledata/go/internal/state"
 	"github.com/google/uuid"
 )
 
 type IEnricher interface {
-	Enrich(ctx context.Context, jobID, userID, stripeCustomerID string, rowKeys []string, columnsMetadata []*models.ColumnMetadata, keyColumnDescription *string) error
+	Enrich(ctx context.Context, jobID, userID, stripeCustomerID string, rowKeys []string, columnsMetadata []*models.ColumnMetadata, keyColumnDescription *string, locale string, contextColumns []string) error
 }
 
+// maxContextColumns bounds how many source columns are carried with every row
+// into the workflow and prompts.
+const maxContextColumns = 10
+
+// maxSourcePageSize bounds how many source rows GetSourceData returns at once.
+const maxSourcePageSize = 1000
+
 var (
 	ErrSourceNotFound      = errors.New("source not found")
 	ErrSourceForbidden     = errors.New("forbidden")
@@ -40,10 +50,39 @@ type EnrichSourceInput struct {
 	AuthUserID           string
 	DBUser               *models.User
 	KeyColumns           []string
+	ContextColumns       []string
 	KeyColumnDescription *string
+	Locale               *string
 	ColumnsMetadata      []*models.ColumnMetadata
 	RowLimit             *int
 	TemplateID           *uuid.UUID
+	KeyNormalization     KeyNormalization
+	// Incremental only enriches rows that are new or changed since the
+	// source's latest completed job and carries the other results forward.
+	Incremental bool
+}
+
+// jobRows is what a new job enriches and what it takes over from elsewhere.
+type jobRows struct {
+	keys       []string
+	keyMapping map[string]string
+	values     map[string]map[string]string
+	revision   int
+	// incremental is set when results are carried forward from a base job.
+	incremental *incrementalPlan
+}
+
+// SheetSelection picks which sheet of an uploaded workbook is read and which
+// 1-based row holds its headers. Zero values mean the first sheet and row.
+type SheetSelection struct {
+	SheetName string
+	HeaderRow int
+}
+
+// SourceSheets lists the sheets of a workbook source and the current selection.
+type SourceSheets struct {
+	Sheets    []string
+	Selection SheetSelection
 }
 
 type ISourceNameGeneratorPromptService interface {
@@ -53,13 +92,14 @@ type ISourceNameGeneratorPromptService interface {
 type sourcesService struct {
 	store         state.Store
 	reader        *gcs.CSVReader
+	connections   *sqlsource.Registry
 	enricher      IEnricher
 	aiClient      IAIClient
 	promptService ISourceNameGeneratorPromptService
 }
 
-func NewSourcesService(store state.Store, reader *gcs.CSVReader, enr IEnricher, aiclient IAIClient, promptService ISourceNameGeneratorPromptService) *sourcesService {
-	return &sourcesService{store: store, reader: reader, enricher: enr, aiClient: aiclient, promptService: promptService}
+func NewSourcesService(store state.Store, reader *gcs.CSVReader, connections *sqlsource.Registry, enr IEnricher, aiclient IAIClient, promptService ISourceNameGeneratorPromptService) *sourcesService {
+	return &sourcesService{store: store, reader: reader, connections: connections, enricher: enr, aiClient: aiclient, promptService: promptService}
 }
 
 func (s *sourcesService) ListSources(ctx context.Context, userID string, offset, limit int) ([]*SourceWithJobs, error) {
@@ -97,29 +137,142 @@ func (s *sourcesService) GetSource(ctx context.Context, sourceID uuid.UUID, user
 	return &SourceWithJobs{Source: source, Jobs: jobs}, nil
 }
 
-func (s *sourcesService) GetSourceData(ctx context.Context, sourceID uuid.UUID, userID string) (*gcs.CSVResult, error) {
-	source, err := s.store.GetSource(ctx, sourceID)
+// GetSourceData pages through the rows of a source. Rows are streamed, so
+// only the requested window is held in memory.
+func (s *sourcesService) GetSourceData(ctx context.Context, sourceID uuid.UUID, userID string, offset, limit int) (*gcs.SourcePage, error) {
+	source, err := s.getOwnedSource(ctx, sourceID, userID)
 	if err != nil {
-		return nil, ErrSourceNotFound
+		return nil, err
 	}
-	if source.UserID != userID {
-		return nil, ErrSourceForbidden
+	if offset < 0 {
+		offset = 0
+	}
+	if limit <= 0 || limit > maxSourcePageSize {
+		limit = maxSourcePageSize
+	}
+	it, err := s.OpenSourceRows(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	return gcs.PageRows(it, offset, limit)
+}
+
+// SourceHeaders returns the column headers of a source.
+func (s *sourcesService) SourceHeaders(ctx context.Context, source *models.Source) ([]string, error) {
+	it, err := s.OpenSourceRows(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	return it.Headers(), nil
+}
+
+// OpenSourceRows streams the rows of any source type: uploaded files are
+// read from blob storage and query sources from their connection. The
+// caller must close the iterator.
+func (s *sourcesService) OpenSourceRows(ctx context.Context, source *models.Source) (gcs.RowIterator, error) {
+	if meta, ok := source.Metadata.(*models.SQLSourceMetadata); ok {
+		if err := s.connections.Authorize(meta.Connection, source.UserID); err != nil {
+			return nil, err
+		}
+		return s.connections.Query(ctx, meta.Connection, meta.Query)
+	}
+	if err := s.storeDetectedDialect(ctx, source); err != nil {
+		return nil, err
+	}
+	file, err := SourceFileFor(source.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	return s.reader.OpenSource(ctx, file)
+}
+
+// OpenSourceRevisionRows streams the rows of the revision of a source a job
+// read. Query sources have no revisions and return their current rows.
+func (s *sourcesService) OpenSourceRevisionRows(ctx context.Context, source *models.Source, revision int) (gcs.RowIterator, error) {
+	meta, ok := source.Metadata.(models.RevisionedMetadata)
+	if !ok || revision == meta.CurrentRevision() {
+		return s.OpenSourceRows(ctx, source)
+	}
+	file, err := sourceFileForRevision(meta, revision)
+	if err != nil {
+		return nil, err
+	}
+	return s.reader.OpenSource(ctx, file)
+}
+
+// SourceFileFor describes how to read an uploaded source file.
+func SourceFileFor(meta models.SourceMetadata) (gcs.SourceFile, error) {
+	switch m := meta.(type) {
+	case *models.CSVSourceMetadata:
+		file := gcs.SourceFile{ObjectName: m.FileURI, ContentType: m.ContentType}
+		applyDialect(&file, m.Dialect)
+		return file, nil
+	case *models.XLSXSourceMetadata:
+		return gcs.SourceFile{ObjectName: m.FileURI, ContentType: m.ContentType, Sheet: m.SheetName, HeaderRow: m.HeaderRow}, nil
+	default:
+		return gcs.SourceFile{}, fmt.Errorf("source metadata not found")
+	}
+}
+
+// ListSourceSheets lists the sheets of a workbook source.
+func (s *sourcesService) ListSourceSheets(ctx context.Context, sourceID uuid.UUID, userID string) (*SourceSheets, error) {
+	source, err := s.getOwnedSource(ctx, sourceID, userID)
+	if err != nil {
+		return nil, err
 	}
-	csvMeta, ok := source.Metadata.(*models.CSVSourceMetadata)
+	meta, ok := source.Metadata.(*models.XLSXSourceMetadata)
 	if !ok {
-		return nil, fmt.Errorf("source metadata not found")
+		return nil, newValidationError("source is not a workbook")
+	}
+	file, _ := SourceFileFor(meta)
+	sheets, err := s.reader.ListSheets(ctx, file)
+	if err != nil {
+		return nil, err
 	}
-	return s.reader.ReadCSV(ctx, csvMeta.FileURI)
+	return &SourceSheets{Sheets: sheets, Selection: SheetSelection{SheetName: meta.SheetName, HeaderRow: meta.HeaderRow}}, nil
 }
 
-func (s *sourcesService) CreateUploadSource(ctx context.Context, userID, contentType string, headers []string) (uuid.UUID, string, error) {
-	ext, _ := mime.ExtensionsByType(contentType)
-	extension := ".csv"
-	if len(ext) > 0 {
-		extension = ext[0]
+// SelectSourceSheet changes which sheet and header row of a workbook source
+// are read by later previews and enrichment runs.
+func (s *sourcesService) SelectSourceSheet(ctx context.Context, sourceID uuid.UUID, userID string, selection SheetSelection) (*SourceSheets, error) {
+	if selection.HeaderRow < 0 {
+		return nil, newValidationError("header_row must be at least 1")
+	}
+	source, err := s.getOwnedSource(ctx, sourceID, userID)
+	if err != nil {
+		return nil, err
+	}
+	meta, ok := source.Metadata.(*models.XLSXSourceMetadata)
+	if !ok {
+		return nil, newValidationError("source is not a workbook")
+	}
+	file, _ := SourceFileFor(meta)
+	sheets, err := s.reader.ListSheets(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+	if selection.SheetName != "" && !slices.Contains(sheets, selection.SheetName) {
+		return nil, newValidationError(fmt.Sprintf("sheet %q not found in workbook", selection.SheetName))
+	}
+
+	updated := *meta
+	updated.SheetName = selection.SheetName
+	updated.HeaderRow = selection.HeaderRow
+	metaJSON, err := json.Marshal(&updated)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+	if err := s.store.UpdateSourceMetadata(ctx, sourceID, metaJSON); err != nil {
+		return nil, err
 	}
-	fileID := generateJobID(extension)
-	source, err := s.createCSVSource(ctx, userID, fileID, contentType, headers)
+	return &SourceSheets{Sheets: sheets, Selection: selection}, nil
+}
+
+func (s *sourcesService) CreateUploadSource(ctx context.Context, userID, contentType string, headers []string, sheet SheetSelection) (uuid.UUID, string, error) {
+	fileID := generateJobID(uploadExtension(contentType))
+	source, err := s.createUploadSource(ctx, userID, fileID, contentType, headers, sheet)
 	if err != nil {
 		return uuid.Nil, "", fmt.Errorf("failed to create source: %w", err)
 	}
@@ -130,6 +283,138 @@ func (s *sourcesService) CreateUploadSource(ctx context.Context, userID, content
 	return source.ID, url, nil
 }
 
+// SQLSourceInput describes a query source. WriteBackTable, when set, receives
+// the enriched results of every completed job on the source.
+type SQLSourceInput struct {
+	Connection     string
+	Query          string
+	Name           string
+	WriteBackTable string
+}
+
+// ListConnections names the database connections a user may query.
+func (s *sourcesService) ListConnections(userID string) []string {
+	return s.connections.Names(userID)
+}
+
+// CreateSQLSource creates a source backed by a read-only query. The query is
+// run once up front so that mistakes surface before any job is started.
+func (s *sourcesService) CreateSQLSource(ctx context.Context, userID string, input SQLSourceInput) (uuid.UUID, error) {
+	if err := s.connections.Authorize(input.Connection, userID); err != nil {
+		return uuid.Nil, newValidationError(err.Error())
+	}
+	if err := sqlsource.ValidateQuery(input.Query); err != nil {
+		return uuid.Nil, newValidationError(err.Error())
+	}
+	if input.WriteBackTable != "" {
+		if err := s.connections.AuthorizeWrite(input.Connection, input.WriteBackTable); err != nil {
+			return uuid.Nil, newValidationError(err.Error())
+		}
+	}
+	it, err := s.connections.Query(ctx, input.Connection, input.Query)
+	if err != nil {
+		return uuid.Nil, newValidationError(err.Error())
+	}
+	headers := it.Headers()
+	it.Close()
+	if len(headers) == 0 {
+		return uuid.Nil, newValidationError("query returns no columns")
+	}
+
+	meta := &models.SQLSourceMetadata{Connection: input.Connection, Query: input.Query, Name: input.Name}
+	if meta.Name == "" {
+		meta.Name = s.generateSourceName(ctx, headers)
+	}
+	if input.WriteBackTable != "" {
+		meta.WriteBack = &models.SQLWriteBack{Table: input.WriteBackTable}
+	}
+	metaJSON, err := json.Marshal(meta)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+	now := time.Now()
+	source := &models.SourceDB{
+		UserID:    userID,
+		Type:      meta.SourceType(),
+		Metadata:  json.RawMessage(metaJSON),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := s.store.CreateSource(ctx, source); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to create source: %w", err)
+	}
+	return source.ID, nil
+}
+
+// CreateSourceRevision starts the upload of a new version of a file source.
+// The revision stays pending until ConfirmSourceRevision sees the uploaded
+// file, so the source keeps reading its current file in the meantime.
+func (s *sourcesService) CreateSourceRevision(ctx context.Context, sourceID uuid.UUID, userID, contentType string) (int, string, error) {
+	source, err := s.getOwnedSource(ctx, sourceID, userID)
+	if err != nil {
+		return 0, "", err
+	}
+	if _, ok := source.Metadata.(models.RevisionedMetadata); !ok {
+		return 0, "", newValidationError("source does not accept new revisions")
+	}
+	if uploadMetadata("", contentType, "", SheetSelection{}).SourceType() != source.Type {
+		return 0, "", newValidationError("a revision must have the same file type as the source")
+	}
+	fileID := generateJobID(uploadExtension(contentType))
+	url, err := s.reader.GenerateSignedURL(ctx, fileID, contentType)
+	if err != nil {
+		return 0, "", err
+	}
+	var revision int
+	err = s.store.UpdateSource(ctx, sourceID, func(source *models.Source) error {
+		meta, ok := source.Metadata.(models.RevisionedMetadata)
+		if !ok {
+			return newValidationError("source does not accept new revisions")
+		}
+		revision = meta.StageRevision(fileID, contentType, time.Now())
+		return nil
+	})
+	if err != nil {
+		return 0, "", err
+	}
+	return revision, url, nil
+}
+
+// ConfirmSourceRevision makes an uploaded revision the one the source reads.
+// Earlier revisions stay in its metadata so incremental runs can diff
+// against them.
+func (s *sourcesService) ConfirmSourceRevision(ctx context.Context, sourceID uuid.UUID, userID string, revision int) error {
+	source, err := s.getOwnedSource(ctx, sourceID, userID)
+	if err != nil {
+		return err
+	}
+	meta, ok := source.Metadata.(models.RevisionedMetadata)
+	if !ok {
+		return newValidationError("source does not accept new revisions")
+	}
+	pending, ok := meta.PendingRevision(revision)
+	if !ok {
+		return newValidationError(fmt.Sprintf("revision %d is not pending", revision))
+	}
+	if err := s.reader.CheckObject(ctx, pending.FileURI); err != nil {
+		return newValidationError(fmt.Sprintf("revision %d has not been uploaded", revision))
+	}
+	dialect, err := s.detectRevisionDialect(ctx, meta, pending)
+	if err != nil {
+		return err
+	}
+	return s.store.UpdateSource(ctx, sourceID, func(source *models.Source) error {
+		meta, ok := source.Metadata.(models.RevisionedMetadata)
+		if !ok || !meta.PromoteRevision(revision, time.Now()) {
+			return newValidationError(fmt.Sprintf("revision %d is not pending", revision))
+		}
+		if csvMeta, ok := meta.(*models.CSVSourceMetadata); ok && csvMeta.Dialect == nil {
+			csvMeta.Dialect = dialect
+		}
+		return nil
+	})
+}
+
 func (s *sourcesService) generateSourceName(ctx context.Context, headers []string) string {
 	prompt := s.promptService.GenerateSourceNamePrompt(ctx, headers)
 	name, err := s.aiClient.GenerateContent(ctx, prompt)
@@ -139,16 +424,17 @@ func (s *sourcesService) generateSourceName(ctx context.Context, headers []strin
 	return name
 }
 
-func (s *sourcesService) createCSVSource(ctx context.Context, userID, fileURI, contentType string, headers []string) (*models.SourceDB, error) {
+func (s *sourcesService) createUploadSource(ctx context.Context, userID, fileURI, contentType string, headers []string, sheet SheetSelection) (*models.SourceDB, error) {
 	name := s.generateSourceName(ctx, headers)
-	metaJSON, err := json.Marshal(&models.CSVSourceMetadata{FileURI: fileURI, ContentType: contentType, Name: name})
+	meta := uploadMetadata(fileURI, contentType, name, sheet)
+	metaJSON, err := json.Marshal(meta)
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal metadata: %w", err)
 	}
 	now := time.Now()
 	source := &models.SourceDB{
 		UserID:    userID,
-		Type:      models.SourceTypeCSVUpload,
+		Type:      meta.SourceType(),
 		Metadata:  json.RawMessage(metaJSON),
 		CreatedAt: now,
 		UpdatedAt: now,
@@ -159,6 +445,13 @@ func (s *sourcesService) createCSVSource(ctx context.Context, userID, fileURI, c
 	return source, nil
 }
 
+func uploadMetadata(fileURI, contentType, name string, sheet SheetSelection) models.SourceMetadata {
+	if contentType == gcs.ContentTypeXLSX {
+		return &models.XLSXSourceMetadata{FileURI: fileURI, ContentType: contentType, Name: name, SheetName: sheet.SheetName, HeaderRow: sheet.HeaderRow}
+	}
+	return &models.CSVSourceMetadata{FileURI: fileURI, ContentType: contentType, Name: name}
+}
+
 func (s *sourcesService) EnrichSource(ctx context.Context, input EnrichSourceInput) (string, error) {
 	source, err := s.getOwnedSource(ctx, input.SourceID, input.AuthUserID)
 	if err != nil {
@@ -168,22 +461,64 @@ func (s *sourcesService) EnrichSource(ctx context.Context, input EnrichSourceInp
 	if err != nil {
 		return "", err
 	}
-	csvMeta, ok := source.Metadata.(*models.CSVSourceMetadata)
-	if !ok {
-		return "", fmt.Errorf("source metadata not found")
+	if input.Locale != nil && !ValidLocaleTag(*input.Locale) {
+		return "", newValidationError(fmt.Sprintf("invalid locale %q", *input.Locale))
 	}
-	rowKeys, err := s.readRowKeys(ctx, csvMeta.FileURI, keyColumns, input.ColumnsMetadata)
+	headers, err := s.SourceHeaders(ctx, source)
 	if err != nil {
-		return "", newValidationError(fmt.Sprintf("failed to read CSV: %v", err))
+		return "", newValidationError(fmt.Sprintf("failed to read source: %v", err))
+	}
+	if err := ValidateDerivedColumns(input.ColumnsMetadata, headers); err != nil {
+		return "", newValidationError(err.Error())
+	}
+	if err := ValidateClassifyColumns(input.ColumnsMetadata); err != nil {
+		return "", newValidationError(err.Error())
+	}
+	if t := input.KeyNormalization.FuzzyThreshold; t < 0 || t > 1 {
+		return "", newValidationError("fuzzy_threshold must be between 0 and 1")
+	}
+	if len(input.ContextColumns) > maxContextColumns {
+		return "", newValidationError(fmt.Sprintf("at most %d context columns are allowed", maxContextColumns))
+	}
+	rowKeys, err := s.readRowKeys(ctx, source, keyColumns, input.ColumnsMetadata)
+	if err != nil {
+		return "", newValidationError(fmt.Sprintf("failed to read source: %v", err))
 	}
 	if len(rowKeys) == 0 {
 		return "", newValidationError("no rows found in key column")
 	}
-	rowKeys = applyRowLimit(rowKeys, input.RowLimit)
-	if !input.DBUser.CanEnrichCells(int64(len(rowKeys) * len(input.ColumnsMetadata))) {
+	rows := jobRows{revision: 1}
+	if meta, ok := source.Metadata.(models.RevisionedMetadata); ok {
+		rows.revision = meta.CurrentRevision()
+	}
+	if input.Incremental {
+		plan, err := s.planIncremental(ctx, source, keyColumns, rowKeys, input.ColumnsMetadata, input.ContextColumns)
+		if err != nil {
+			return "", err
+		}
+		if len(plan.rowKeys) == 0 {
+			return "", newValidationError(fmt.Sprintf("no new or changed rows since job %s", plan.baseJob.JobID))
+		}
+		rowKeys = plan.rowKeys
+		rows.incremental = plan
+	}
+	if input.KeyNormalization.Enabled {
+		var unbucketed int
+		rowKeys, rows.keyMapping, unbucketed = NormalizeKeys(rowKeys, input.KeyNormalization.FuzzyThreshold)
+		if unbucketed > 0 {
+			logger.Log.Warn("keys left out of fuzzy matching", "source_id", source.ID, "count", unbucketed, "bucket_limit", fuzzyBucketLimit)
+		}
+	}
+	rows.keys = applyRowLimit(rowKeys, input.RowLimit)
+	rows.keyMapping = restrictKeyMapping(rows.keyMapping, rows.keys)
+	if !input.DBUser.CanEnrichCells(requiredCredits(len(rows.keys), input.ColumnsMetadata)) {
 		return "", ErrInsufficientCredits
 	}
-	return s.createAndStartJob(ctx, input, keyColumns, keyColumnDesc, rowKeys)
+	rows.values, err = s.readRowValues(ctx, source, keyColumns, rowValueColumns(input.ColumnsMetadata, input.ContextColumns))
+	if err != nil {
+		return "", newValidationError(fmt.Sprintf("failed to read source: %v", err))
+	}
+	return s.createAndStartJob(ctx, input, keyColumns, keyColumnDesc, rows)
 }
 
 func (s *sourcesService) getOwnedSource(ctx context.Context, sourceID uuid.UUID, userID string) (*models.Source, error) {
@@ -209,35 +544,87 @@ func (s *sourcesService) resolveKeyColumns(ctx context.Context, sourceID uuid.UU
 	return most.KeyColumns, most.KeyColumnDescription, nil
 }
 
-func (s *sourcesService) readRowKeys(ctx context.Context, fileURI string, keyColumns []string, cols []*models.ColumnMetadata) ([]string, error) {
-	imputationCols := imputationColumnNames(cols)
-	var keys []string
-	var err error
-	if len(imputationCols) > 0 {
-		keys, err = s.reader.ReadCompositeKeyFromFileFiltered(ctx, fileURI, keyColumns, imputationCols)
-	} else {
-		keys, err = s.reader.ReadCompositeKeyFromFile(ctx, fileURI, keyColumns)
+func (s *sourcesService) readRowKeys(ctx context.Context, source *models.Source, keyColumns []string, cols []*models.ColumnMetadata) ([]string, error) {
+	if len(keyColumns) == 0 {
+		return nil, fmt.Errorf("at least one column name is required")
 	}
+	it, err := s.OpenSourceRows(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	// With imputation columns, only rows missing one of them are enriched.
+	keys, err := gcs.ScanCompositeKeys(it, keyColumns, imputationColumnNames(cols))
 	if err != nil {
 		return nil, err
 	}
 	return deduplicateKeys(keys), nil
 }
 
-func (s *sourcesService) createAndStartJob(ctx context.Context, input EnrichSourceInput, keyColumns []string, keyColumnDesc *string, rowKeys []string) (string, error) {
+// readRowValues reads the cells of the given columns for every row key, so
+// they can be stored with the job's rows.
+func (s *sourcesService) readRowValues(ctx context.Context, source *models.Source, keyColumns, columns []string) (map[string]map[string]string, error) {
+	if len(columns) == 0 {
+		return nil, nil
+	}
+	it, err := s.OpenSourceRows(ctx, source)
+	if err != nil {
+		return nil, err
+	}
+	defer it.Close()
+	return gcs.ScanRowValues(it, keyColumns, columns)
+}
+
+func (s *sourcesService) createAndStartJob(ctx context.Context, input EnrichSourceInput, keyColumns []string, keyColumnDesc *string, rows jobRows) (string, error) {
 	jobID := generateJobID(".csv")
 	if err := s.store.CreatePendingJob(ctx, jobID, input.AuthUserID, input.SourceID, input.TemplateID); err != nil {
 		return "", fmt.Errorf("failed to create job")
 	}
-	if err := s.configureAndStartJob(ctx, jobID, keyColumns, keyColumnDesc, input.ColumnsMetadata, len(rowKeys)); err != nil {
+	if len(rows.keyMapping) > 0 {
+		if err := s.store.SetJobKeyMapping(ctx, jobID, rows.keyMapping); err != nil {
+			return "", fmt.Errorf("failed to save key mapping")
+		}
+	}
+	totalRows, err := s.recordJobLineage(ctx, jobID, rows)
+	if err != nil {
 		return "", err
 	}
-	go s.enricher.Enrich(context.Background(), jobID, input.DBUser.ID, stripeCustomerIDOrEmpty(input.DBUser), rowKeys, input.ColumnsMetadata, keyColumnDesc)
+	if len(rows.values) > 0 {
+		if err := s.store.SaveRowInputValues(ctx, jobID, jobRowValues(rows.values, rows.keys)); err != nil {
+			return "", fmt.Errorf("failed to save row values")
+		}
+	}
+	if err := s.configureAndStartJob(ctx, jobID, keyColumns, input.ContextColumns, keyColumnDesc, input.Locale, input.ColumnsMetadata, totalRows); err != nil {
+		return "", err
+	}
+	go s.enricher.Enrich(context.Background(), jobID, input.DBUser.ID, stripeCustomerIDOrEmpty(input.DBUser), rows.keys, input.ColumnsMetadata, keyColumnDesc, Deref(input.Locale), input.ContextColumns)
 	return jobID, nil
 }
 
-func (s *sourcesService) configureAndStartJob(ctx context.Context, jobID string, keyColumns []string, keyColumnDesc *string, cols []*models.ColumnMetadata, rowCount int) error {
-	if err := s.store.UpdateJobConfiguration(ctx, jobID, keyColumns, cols, keyColumnDesc); err != nil {
+// recordJobLineage stores which source revision a job reads and, for
+// incremental runs, copies the carried-forward rows from the base job. It
+// returns the job's total row count, carried rows included.
+func (s *sourcesService) recordJobLineage(ctx context.Context, jobID string, rows jobRows) (int, error) {
+	plan := rows.incremental
+	if plan == nil {
+		if rows.revision > 1 {
+			if err := s.store.SetJobRevision(ctx, jobID, rows.revision, nil); err != nil {
+				return 0, fmt.Errorf("failed to record source revision")
+			}
+		}
+		return len(rows.keys), nil
+	}
+	if err := s.store.SetJobRevision(ctx, jobID, rows.revision, &plan.baseJob.JobID); err != nil {
+		return 0, fmt.Errorf("failed to record source revision")
+	}
+	if err := s.store.CopyCompletedRows(ctx, plan.baseJob.JobID, jobID, plan.carried); err != nil {
+		return 0, fmt.Errorf("failed to carry forward results: %w", err)
+	}
+	return len(rows.keys) + len(plan.carried), nil
+}
+
+func (s *sourcesService) configureAndStartJob(ctx context.Context, jobID string, keyColumns, contextColumns []string, keyColumnDesc, locale *string, cols []*models.ColumnMetadata, rowCount int) error {
+	if err := s.store.UpdateJobConfiguration(ctx, jobID, keyColumns, contextColumns, cols, keyColumnDesc, locale); err != nil {
 		return fmt.Errorf("failed to update job configuration")
 	}
 	if err := s.store.StartJob(ctx, jobID, rowCount); err != nil {
@@ -246,6 +633,35 @@ func (s *sourcesService) configureAndStartJob(ctx context.Context, jobID string,
 	return nil
 }
 
+// jobRowValues keeps the values of the rows a job enriches.
+func jobRowValues(values map[string]map[string]string, keys []string) map[string]map[string]string {
+	result := make(map[string]map[string]string, len(keys))
+	for _, key := range keys {
+		if v, ok := values[key]; ok {
+			result[key] = v
+		}
+	}
+	return result
+}
+
+// rowValueColumns lists the source columns whose cells travel with each row:
+// classify inputs, context columns and the source columns derived columns
+// read.
+func rowValueColumns(cols []*models.ColumnMetadata, contextColumns []string) []string {
+	names := ClassifyInputColumns(cols)
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		seen[name] = true
+	}
+	for _, name := range slices.Concat(contextColumns, DerivedSourceColumns(cols)) {
+		if !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
 func imputationColumnNames(cols []*models.ColumnMetadata) []string {
 	var names []string
 	for _, col := range cols {
@@ -263,6 +679,25 @@ func applyRowLimit(keys []string, limit *int) []string {
 	return keys[:*limit]
 }
 
+// restrictKeyMapping drops merged keys whose canonical row was cut by the
+// row limit, so they are not reported as enriched.
+func restrictKeyMapping(mapping map[string]string, rowKeys []string) map[string]string {
+	if len(mapping) == 0 {
+		return mapping
+	}
+	kept := make(map[string]bool, len(rowKeys))
+	for _, k := range rowKeys {
+		kept[k] = true
+	}
+	result := make(map[string]string, len(mapping))
+	for original, canonical := range mapping {
+		if kept[canonical] {
+			result[original] = canonical
+		}
+	}
+	return result
+}
+
 func deduplicateKeys(keys []string) []string {
 	seen := make(map[string]struct{}, len(keys))
 	result := make([]string, 0, len(keys))
@@ -282,6 +717,21 @@ func stripeCustomerIDOrEmpty(u *models.User) string {
 	return ""
 }
 
+// uploadExtension picks the object extension for an upload. NDJSON has no
+// entry in most system MIME tables, so it is mapped explicitly.
+func uploadExtension(contentType string) string {
+	switch contentType {
+	case gcs.ContentTypeNDJSON:
+		return ".ndjson"
+	case gcs.ContentTypeXLSX:
+		return ".xlsx"
+	}
+	if ext, _ := mime.ExtensionsByType(contentType); len(ext) > 0 {
+		return ext[0]
+	}
+	return ".csv"
+}
+
 func generateJobID(extension string) string {
 	return uuid.New().String() + extension
 }
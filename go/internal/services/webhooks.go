@@ -0,0 +1,357 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/state"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrWebhookNotFound = errors.New("webhook endpoint not found")
+	// ErrWebhookRejected marks a delivery the endpoint refused in a way
+	// retrying will not change, such as a 404 or 410.
+	ErrWebhookRejected = errors.New("webhook rejected")
+)
+
+// Headers sent with every webhook delivery.
+const (
+	WebhookSignatureHeader = "X-Ampledata-Signature"
+	WebhookEventHeader     = "X-Ampledata-Event"
+	WebhookDeliveryHeader  = "X-Ampledata-Delivery"
+)
+
+const webhookTimeout = 10 * time.Second
+
+// blockedWebhookPrefixes are ranges outside the standard library's
+// loopback, private and link-local classes that still reach internal or
+// non-routable hosts.
+var blockedWebhookPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),     // "this network"
+	netip.MustParsePrefix("100.64.0.0/10"), // carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),  // IETF protocol assignments
+	netip.MustParsePrefix("198.18.0.0/15"), // benchmarking
+	netip.MustParsePrefix("64:ff9b::/96"),  // NAT64, which can embed any IPv4 address
+}
+
+// WebhookService manages the webhook endpoints of users and their
+// delivery logs.
+type WebhookService struct {
+	store state.Store
+}
+
+func NewWebhookService(store state.Store) *WebhookService {
+	return &WebhookService{store: store}
+}
+
+// CreateEndpoint registers a URL to receive the given events, all of them
+// when none are given. The returned endpoint holds the signing secret.
+func (s *WebhookService) CreateEndpoint(ctx context.Context, userID, endpointURL string, events []models.WebhookEventType) (*models.WebhookEndpoint, error) {
+	if err := validateWebhookURL(endpointURL); err != nil {
+		return nil, err
+	}
+	events, err := resolveWebhookEvents(events)
+	if err != nil {
+		return nil, err
+	}
+	secret, err := newWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+	endpointDB := &models.WebhookEndpointDB{
+		ID:        uuid.New(),
+		UserID:    userID,
+		URL:       endpointURL,
+		Events:    events,
+		Secret:    secret,
+		CreatedAt: time.Now(),
+	}
+	if err := s.store.CreateWebhookEndpoint(ctx, endpointDB); err != nil {
+		return nil, err
+	}
+	return endpointDB.ToWebhookEndpoint(), nil
+}
+
+// ListEndpoints returns the user's endpoints, newest first.
+func (s *WebhookService) ListEndpoints(ctx context.Context, userID string) ([]*models.WebhookEndpoint, error) {
+	return s.store.GetWebhookEndpointsByUser(ctx, userID)
+}
+
+// DeleteEndpoint stops deliveries to an endpoint and drops its log.
+func (s *WebhookService) DeleteEndpoint(ctx context.Context, endpointID uuid.UUID, userID string) error {
+	if _, err := s.ownedEndpoint(ctx, endpointID, userID); err != nil {
+		return err
+	}
+	return s.store.DeleteWebhookEndpoint(ctx, endpointID)
+}
+
+// ListDeliveries returns a page of an endpoint's delivery log, newest
+// first, and its total. A non-empty jobID limits it to one job.
+func (s *WebhookService) ListDeliveries(ctx context.Context, endpointID uuid.UUID, userID, jobID string, offset, limit int) ([]*models.WebhookDelivery, int, error) {
+	if _, err := s.ownedEndpoint(ctx, endpointID, userID); err != nil {
+		return nil, 0, err
+	}
+	return s.store.GetWebhookDeliveries(ctx, endpointID, jobID, offset, limit)
+}
+
+func (s *WebhookService) ownedEndpoint(ctx context.Context, endpointID uuid.UUID, userID string) (*models.WebhookEndpoint, error) {
+	endpoint, err := s.store.GetWebhookEndpoint(ctx, endpointID)
+	if err != nil {
+		return nil, ErrWebhookNotFound
+	}
+	if endpoint.UserID != userID {
+		return nil, ErrJobForbidden
+	}
+	return endpoint, nil
+}
+
+// WebhookDispatcher records job events for the endpoints subscribed to
+// them and sends the signed deliveries.
+type WebhookDispatcher struct {
+	store      state.Store
+	httpClient *http.Client
+}
+
+func NewWebhookDispatcher(store state.Store) *WebhookDispatcher {
+	return &WebhookDispatcher{
+		store:      store,
+		httpClient: newWebhookHTTPClient(),
+	}
+}
+
+// newWebhookHTTPClient returns a client that only connects to public
+// addresses and does not follow redirects, so an endpoint cannot steer
+// deliveries at internal services. The address is checked when the
+// connection is made, after DNS resolution, so a hostname that resolves
+// to an internal address is refused too.
+func newWebhookHTTPClient() *http.Client {
+	dialer := &net.Dialer{Timeout: webhookTimeout, Control: checkWebhookDial}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	// A proxy would make the dialed address the proxy's, not the endpoint's.
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   webhookTimeout,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// checkWebhookDial refuses connections to addresses webhooks may not reach.
+func checkWebhookDial(network, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: invalid webhook address %q", ErrWebhookRejected, address)
+	}
+	if !webhookAddressAllowed(addrPort.Addr()) {
+		return fmt.Errorf("%w: webhook address %s is not public", ErrWebhookRejected, addrPort.Addr())
+	}
+	return nil
+}
+
+// webhookAddressAllowed reports whether webhooks may connect to addr.
+// Loopback, private (including IPv6 unique local), link-local (including
+// cloud metadata endpoints such as 169.254.169.254), multicast,
+// unspecified and the blocked prefixes are refused.
+func webhookAddressAllowed(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range blockedWebhookPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// Subscriptions lists the event types at least one of the user's endpoints
+// subscribes to.
+func (d *WebhookDispatcher) Subscriptions(ctx context.Context, userID string) ([]models.WebhookEventType, error) {
+	endpoints, err := d.store.GetWebhookEndpointsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	var events []models.WebhookEventType
+	for _, endpoint := range endpoints {
+		for _, event := range endpoint.Events {
+			if !slices.Contains(events, event) {
+				events = append(events, event)
+			}
+		}
+	}
+	return events, nil
+}
+
+// CreateDeliveries records a delivery of the event for each of the user's
+// endpoints subscribed to it and returns their IDs.
+func (d *WebhookDispatcher) CreateDeliveries(ctx context.Context, userID string, event *models.WebhookEvent) ([]uuid.UUID, error) {
+	endpoints, err := d.store.GetWebhookEndpointsByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode webhook event: %w", err)
+	}
+	var deliveries []*models.WebhookDeliveryDB
+	for _, endpoint := range endpoints {
+		if !endpoint.Subscribes(event.Type) {
+			continue
+		}
+		deliveries = append(deliveries, &models.WebhookDeliveryDB{
+			ID:         uuid.New(),
+			EndpointID: endpoint.ID,
+			EventID:    event.ID,
+			EventType:  event.Type,
+			JobID:      event.JobID,
+			Payload:    payload,
+			Status:     models.WebhookDeliveryPending,
+			CreatedAt:  time.Now(),
+		})
+	}
+	return d.store.CreateWebhookDeliveries(ctx, deliveries)
+}
+
+// Deliver makes one attempt to send a delivery and logs its outcome. A
+// failed attempt is logged as retrying unless it is the last one or the
+// endpoint rejected the delivery for good.
+func (d *WebhookDispatcher) Deliver(ctx context.Context, deliveryID uuid.UUID, attempt int, lastAttempt bool) error {
+	delivery, err := d.store.GetWebhookDelivery(ctx, deliveryID)
+	if errors.Is(err, sql.ErrNoRows) {
+		// The endpoint was deleted, and its deliveries with it.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if delivery.Status == models.WebhookDeliveryDelivered {
+		return nil
+	}
+	endpoint, err := d.store.GetWebhookEndpoint(ctx, delivery.EndpointID)
+	if err != nil {
+		return err
+	}
+
+	status, sendErr := sendWebhook(ctx, d.httpClient, endpoint, delivery, time.Now())
+	var responseStatus *int
+	if status != 0 {
+		responseStatus = &status
+	}
+	if sendErr == nil {
+		return d.store.RecordWebhookAttempt(ctx, deliveryID, models.WebhookDeliveryDelivered, attempt, responseStatus, nil)
+	}
+	deliveryStatus := models.WebhookDeliveryRetrying
+	if lastAttempt || errors.Is(sendErr, ErrWebhookRejected) {
+		deliveryStatus = models.WebhookDeliveryFailed
+	}
+	msg := sendErr.Error()
+	if err := d.store.RecordWebhookAttempt(ctx, deliveryID, deliveryStatus, attempt, responseStatus, &msg); err != nil {
+		return errors.Join(sendErr, err)
+	}
+	return sendErr
+}
+
+// sendWebhook posts a delivery's payload to its endpoint and returns the
+// response status, or 0 when no response arrived.
+func sendWebhook(ctx context.Context, client *http.Client, endpoint *models.WebhookEndpoint, delivery *models.WebhookDelivery, now time.Time) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return 0, fmt.Errorf("%w: %v", ErrWebhookRejected, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(WebhookEventHeader, string(delivery.EventType))
+	req.Header.Set(WebhookDeliveryHeader, delivery.ID.String())
+	req.Header.Set(WebhookSignatureHeader, SignWebhookPayload(endpoint.Secret, now.Unix(), delivery.Payload))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return resp.StatusCode, nil
+	case resp.StatusCode == http.StatusRequestTimeout || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return resp.StatusCode, fmt.Errorf("endpoint responded %s", resp.Status)
+	default:
+		return resp.StatusCode, fmt.Errorf("%w: endpoint responded %s", ErrWebhookRejected, resp.Status)
+	}
+}
+
+// SignWebhookPayload signs a delivery the way receivers verify it: the
+// HMAC-SHA256 of "<timestamp>.<body>" keyed with the endpoint's secret,
+// sent as "t=<timestamp>,v1=<hex digest>". Receivers should also reject
+// old timestamps to stop replays.
+func SignWebhookPayload(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return fmt.Sprintf("t=%d,v1=%s", timestamp, hex.EncodeToString(mac.Sum(nil)))
+}
+
+func validateWebhookURL(endpointURL string) error {
+	u, err := url.Parse(endpointURL)
+	if err != nil || u.Host == "" {
+		return newValidationError(fmt.Sprintf("invalid webhook URL %q", endpointURL))
+	}
+	if u.Scheme != "https" {
+		return newValidationError("webhook URLs must use https")
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return newValidationError("webhook URLs must not point at localhost")
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && !webhookAddressAllowed(addr) {
+		return newValidationError(fmt.Sprintf("webhook URL address %s is not public", addr))
+	}
+	return nil
+}
+
+// resolveWebhookEvents deduplicates the subscribed events, defaulting to
+// every event.
+func resolveWebhookEvents(events []models.WebhookEventType) ([]models.WebhookEventType, error) {
+	if len(events) == 0 {
+		return slices.Clone(models.WebhookEventTypes), nil
+	}
+	var resolved []models.WebhookEventType
+	for _, event := range events {
+		if !slices.Contains(models.WebhookEventTypes, event) {
+			return nil, newValidationError(fmt.Sprintf("unknown webhook event %q", event))
+		}
+		if !slices.Contains(resolved, event) {
+			resolved = append(resolved, event)
+		}
+	}
+	return resolved, nil
+}
+
+func newWebhookSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
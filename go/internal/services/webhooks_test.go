@@ -0,0 +1,238 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/state"
+	"github.com/google/uuid"
+)
+
+func TestSignWebhookPayload(t *testing.T) {
+	got := SignWebhookPayload("whsec_test", 1700000000, []byte(`{"id":"evt"}`))
+	want := "t=1700000000,v1=a94cea056df1fbb92eadafcf2c5cd541dbe0c6ef736e4748202dd53f86694a3e"
+	if got != want {
+		t.Errorf("SignWebhookPayload() = %q, want %q", got, want)
+	}
+}
+
+func TestSendWebhook(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	payload := []byte(`{"id":"evt"}`)
+	tests := []struct {
+		name         string
+		status       int
+		wantErr      bool
+		wantRejected bool
+	}{
+		{"delivered", http.StatusNoContent, false, false},
+		{"server error is retried", http.StatusBadGateway, true, false},
+		{"rate limit is retried", http.StatusTooManyRequests, true, false},
+		{"not found is rejected", http.StatusNotFound, true, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *http.Request
+			var body []byte
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				got = r
+				body, _ = io.ReadAll(r.Body)
+				w.WriteHeader(tt.status)
+			}))
+			defer srv.Close()
+
+			endpoint := &models.WebhookEndpoint{URL: srv.URL, Secret: "whsec_test"}
+			delivery := &models.WebhookDelivery{ID: uuid.New(), EventType: models.WebhookJobCompleted, Payload: payload}
+			status, err := sendWebhook(context.Background(), srv.Client(), endpoint, delivery, now)
+
+			if status != tt.status {
+				t.Errorf("sendWebhook() status = %d, want %d", status, tt.status)
+			}
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("sendWebhook() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if rejected := errors.Is(err, ErrWebhookRejected); rejected != tt.wantRejected {
+				t.Errorf("sendWebhook() rejected = %v, want %v", rejected, tt.wantRejected)
+			}
+			if string(body) != string(payload) {
+				t.Errorf("body = %s, want %s", body, payload)
+			}
+			if sig := got.Header.Get(WebhookSignatureHeader); sig != SignWebhookPayload("whsec_test", now.Unix(), payload) {
+				t.Errorf("signature = %q", sig)
+			}
+			if event := got.Header.Get(WebhookEventHeader); event != "job.completed" {
+				t.Errorf("event header = %q, want job.completed", event)
+			}
+			if id := got.Header.Get(WebhookDeliveryHeader); id != delivery.ID.String() {
+				t.Errorf("delivery header = %q, want %s", id, delivery.ID)
+			}
+		})
+	}
+}
+
+func TestResolveWebhookEvents(t *testing.T) {
+	tests := []struct {
+		name    string
+		events  []models.WebhookEventType
+		want    []models.WebhookEventType
+		wantErr bool
+	}{
+		{"defaults to all", nil, models.WebhookEventTypes, false},
+		{"deduplicates", []models.WebhookEventType{models.WebhookJobCompleted, models.WebhookRowFailed, models.WebhookJobCompleted}, []models.WebhookEventType{models.WebhookJobCompleted, models.WebhookRowFailed}, false},
+		{"unknown event", []models.WebhookEventType{"job.paused"}, nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolveWebhookEvents(tt.events)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveWebhookEvents() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("resolveWebhookEvents() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		wantErr bool
+	}{
+		{"https://hooks.example.com/ampledata", false},
+		{"http://hooks.example.com/ampledata", true},
+		{"https:///no-host", true},
+		{"not a url", true},
+		{"https://localhost/hook", true},
+		{"https://api.localhost/hook", true},
+		{"https://127.0.0.1/hook", true},
+		{"https://169.254.169.254/latest/meta-data", true},
+		{"https://10.0.0.5:8443/hook", true},
+		{"https://[::1]/hook", true},
+		{"https://[fd00::1]/hook", true},
+		{"https://93.184.216.34/hook", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.url, func(t *testing.T) {
+			if err := validateWebhookURL(tt.url); (err != nil) != tt.wantErr {
+				t.Errorf("validateWebhookURL() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWebhookAddressAllowed(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fd00:ec2::254", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"::", false},
+		{"0.1.2.3", false},
+		{"224.0.0.1", false},
+		{"255.255.255.255", false},
+		{"::ffff:127.0.0.1", false},
+		{"64:ff9b::a9fe:a9fe", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			if got := webhookAddressAllowed(netip.MustParseAddr(tt.addr)); got != tt.want {
+				t.Errorf("webhookAddressAllowed(%s) = %v, want %v", tt.addr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWebhookHTTPClient_RefusesInternalAddresses(t *testing.T) {
+	var hit bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hit = true
+	}))
+	defer srv.Close()
+
+	endpoint := &models.WebhookEndpoint{URL: srv.URL, Secret: "whsec_test"}
+	delivery := &models.WebhookDelivery{ID: uuid.New(), EventType: models.WebhookJobCompleted, Payload: []byte(`{}`)}
+	status, err := sendWebhook(context.Background(), newWebhookHTTPClient(), endpoint, delivery, time.Now())
+
+	if !errors.Is(err, ErrWebhookRejected) {
+		t.Fatalf("sendWebhook() error = %v, want ErrWebhookRejected", err)
+	}
+	if status != 0 || hit {
+		t.Errorf("sendWebhook() reached the server, status = %d", status)
+	}
+}
+
+func TestWebhookHTTPClient_DoesNotFollowRedirects(t *testing.T) {
+	var redirected bool
+	mux := http.NewServeMux()
+	mux.HandleFunc("/hook", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/internal", http.StatusTemporaryRedirect)
+	})
+	mux.HandleFunc("/internal", func(w http.ResponseWriter, r *http.Request) {
+		redirected = true
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client := newWebhookHTTPClient()
+	// The test server listens on loopback, which the real transport refuses.
+	client.Transport = srv.Client().Transport
+	endpoint := &models.WebhookEndpoint{URL: srv.URL + "/hook", Secret: "whsec_test"}
+	delivery := &models.WebhookDelivery{ID: uuid.New(), EventType: models.WebhookJobCompleted, Payload: []byte(`{}`)}
+	status, err := sendWebhook(context.Background(), client, endpoint, delivery, time.Now())
+
+	if status != http.StatusTemporaryRedirect || !errors.Is(err, ErrWebhookRejected) {
+		t.Errorf("sendWebhook() = %d, %v, want a rejected %d", status, err, http.StatusTemporaryRedirect)
+	}
+	if redirected {
+		t.Error("sendWebhook() followed the redirect")
+	}
+}
+
+type endpointStore struct {
+	state.Store
+	endpoints []*models.WebhookEndpoint
+}
+
+func (f *endpointStore) GetWebhookEndpointsByUser(context.Context, string) ([]*models.WebhookEndpoint, error) {
+	return f.endpoints, nil
+}
+
+func TestWebhookDispatcherSubscriptions(t *testing.T) {
+	store := &endpointStore{}
+	d := NewWebhookDispatcher(store)
+	if got, _ := d.Subscriptions(context.Background(), "u"); len(got) != 0 {
+		t.Errorf("Subscriptions() without endpoints = %v", got)
+	}
+	store.endpoints = []*models.WebhookEndpoint{
+		{Events: []models.WebhookEventType{models.WebhookJobCompleted}},
+		{Events: []models.WebhookEventType{models.WebhookJobCompleted, models.WebhookRowFailed}},
+	}
+	got, err := d.Subscriptions(context.Background(), "u")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []models.WebhookEventType{models.WebhookJobCompleted, models.WebhookRowFailed}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Subscriptions() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,80 @@
+package sqlsource
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// readOnlyKeywords are the statements a source query may start with.
+var readOnlyKeywords = []string{"select", "with", "values", "table"}
+
+// ValidateQuery checks that a query is a single read-only statement. It is a
+// first line of defence for clear error messages; the READ ONLY transaction
+// the query runs in is what actually prevents writes.
+func ValidateQuery(query string) error {
+	body := strings.TrimRight(strings.TrimSpace(stripComments(query)), "; \t\r\n")
+	if body == "" {
+		return fmt.Errorf("query is empty")
+	}
+	if strings.Contains(body, ";") {
+		return fmt.Errorf("query must be a single statement")
+	}
+	first := strings.ToLower(strings.FieldsFunc(body, func(r rune) bool {
+		return unicode.IsSpace(r) || r == '('
+	})[0])
+	for _, keyword := range readOnlyKeywords {
+		if first == keyword {
+			return nil
+		}
+	}
+	return fmt.Errorf("query must be a SELECT statement")
+}
+
+// stripComments removes -- and /* */ comments outside of quoted strings and
+// identifiers, and blanks string literals so that their contents are not
+// mistaken for statement separators.
+func stripComments(query string) string {
+	var sb strings.Builder
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '-' && i+1 < len(query) && query[i+1] == '-':
+			for i < len(query) && query[i] != '\n' {
+				i++
+			}
+			sb.WriteByte(' ')
+		case c == '/' && i+1 < len(query) && query[i+1] == '*':
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				return sb.String()
+			}
+			i += end + 3
+			sb.WriteByte(' ')
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(query[i+1:], c)
+			if end < 0 {
+				return sb.String()
+			}
+			i += end + 1
+			sb.WriteString("''")
+		default:
+			sb.WriteByte(c)
+		}
+	}
+	return sb.String()
+}
+
+// QuoteIdentifier quotes a column or table name for Postgres.
+func QuoteIdentifier(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+// QuoteTable quotes a table name that may be qualified with a schema.
+func QuoteTable(name string) string {
+	schema, table, ok := strings.Cut(name, ".")
+	if !ok {
+		return QuoteIdentifier(name)
+	}
+	return QuoteIdentifier(schema) + "." + QuoteIdentifier(table)
+}
@@ -0,0 +1,47 @@
+package sqlsource
+
+import "testing"
+
+func TestValidateQuery(t *testing.T) {
+	tests := []struct {
+		name    string
+		query   string
+		wantErr bool
+	}{
+		{"select", "SELECT name, domain FROM companies", false},
+		{"lowercase with trailing semicolon", "select * from companies;", false},
+		{"cte", "WITH recent AS (SELECT * FROM companies) SELECT * FROM recent", false},
+		{"leading comment", "-- active only\nSELECT * FROM companies WHERE active", false},
+		{"parenthesised", "(SELECT 1)", false},
+		{"semicolon in string", "SELECT * FROM companies WHERE note = 'a;b'", false},
+		{"empty", "  ", true},
+		{"only a comment", "/* nothing */", true},
+		{"update", "UPDATE companies SET active = false", true},
+		{"second statement", "SELECT 1; DROP TABLE companies", true},
+		{"hidden after comment", "SELECT 1 /* x */; DELETE FROM companies", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateQuery(tt.query)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateQuery(%q) error = %v, wantErr %v", tt.query, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestQuoteTable(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"companies", `"companies"`},
+		{"crm.companies", `"crm"."companies"`},
+		{`odd"name`, `"odd""name"`},
+	}
+	for _, tt := range tests {
+		if got := QuoteTable(tt.in); got != tt.want {
+			t.Errorf("QuoteTable(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
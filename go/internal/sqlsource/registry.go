@@ -0,0 +1,316 @@
+package sqlsource
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/blagoySimandov/ampledata/go/internal/gcs"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+// queryTimeout bounds how long a source query may run, including the time
+// spent streaming its rows.
+const queryTimeout = 10 * time.Minute
+
+// upsertBatchSize keeps each upsert statement well below Postgres' limit of
+// 65535 bind parameters.
+const upsertBatchSize = 500
+
+var (
+	ErrUnknownConnection = errors.New("unknown connection")
+	ErrTableNotWritable  = errors.New("table is not writable")
+)
+
+// Connection is a database that sources may query. Users restricts the
+// connection to the listed user IDs; an empty list allows everyone.
+// WriteTables lists the tables results may be written back to; without it
+// nothing is written through the connection.
+type Connection struct {
+	DSN         string   `json:"dsn"`
+	Users       []string `json:"users,omitempty"`
+	WriteTables []string `json:"write_tables,omitempty"`
+}
+
+// ParseConnections reads connections from a JSON object keyed by name, such
+// as {"crm": {"dsn": "postgres://...", "users": ["user_123"]}}.
+func ParseConnections(raw string) (map[string]Connection, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+	var connections map[string]Connection
+	if err := json.Unmarshal([]byte(raw), &connections); err != nil {
+		return nil, fmt.Errorf("invalid source connections: %w", err)
+	}
+	for name, c := range connections {
+		if c.DSN == "" {
+			return nil, fmt.Errorf("source connection %q has no dsn", name)
+		}
+	}
+	return connections, nil
+}
+
+// Registry holds the named database connections of query sources. DSNs and
+// credentials stay on the server; sources refer to connections by name.
+// Databases are opened on first use.
+type Registry struct {
+	connections map[string]Connection
+
+	mu  sync.Mutex
+	dbs map[string]*sql.DB
+}
+
+func NewRegistry(connections map[string]Connection) *Registry {
+	return &Registry{
+		connections: connections,
+		dbs:         make(map[string]*sql.DB),
+	}
+}
+
+// Names lists the connections available to a user.
+func (r *Registry) Names(userID string) []string {
+	var names []string
+	for name := range r.connections {
+		if r.Authorize(name, userID) == nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Authorize reports whether a user may use a connection. Connections the
+// user may not see are reported as unknown.
+func (r *Registry) Authorize(name, userID string) error {
+	c, ok := r.connections[name]
+	if !ok || len(c.Users) > 0 && !slices.Contains(c.Users, userID) {
+		return fmt.Errorf("%w: %s", ErrUnknownConnection, name)
+	}
+	return nil
+}
+
+// AuthorizeWrite reports whether results may be written to a table through
+// a connection. Tables are matched exactly as configured, schema included.
+func (r *Registry) AuthorizeWrite(name, table string) error {
+	c, ok := r.connections[name]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownConnection, name)
+	}
+	if !slices.Contains(c.WriteTables, table) {
+		return fmt.Errorf("%w: %s", ErrTableNotWritable, table)
+	}
+	return nil
+}
+
+func (r *Registry) db(name string) (*sql.DB, error) {
+	c, ok := r.connections[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownConnection, name)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if db, ok := r.dbs[name]; ok {
+		return db, nil
+	}
+	db := sql.OpenDB(pgdriver.NewConnector(pgdriver.WithDSN(c.DSN)))
+	db.SetMaxOpenConns(5)
+	db.SetMaxIdleConns(1)
+	r.dbs[name] = db
+	return db, nil
+}
+
+// Query runs a read-only query and streams its result as source rows. The
+// query runs inside a READ ONLY transaction, so statements that slip past
+// ValidateQuery still cannot modify data.
+func (r *Registry) Query(ctx context.Context, name, query string) (gcs.RowIterator, error) {
+	if err := ValidateQuery(query); err != nil {
+		return nil, err
+	}
+	db, err := r.db(name)
+	if err != nil {
+		return nil, err
+	}
+	tx, err := db.BeginTx(ctx, &sql.TxOptions{ReadOnly: true})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open connection %q: %w", name, err)
+	}
+	timeout := fmt.Sprintf("SET LOCAL statement_timeout = %d", queryTimeout.Milliseconds())
+	if _, err := tx.ExecContext(ctx, timeout); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to configure query: %w", err)
+	}
+	rows, err := tx.QueryContext(ctx, strings.TrimRight(strings.TrimSpace(query), ";"))
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+	headers, err := rows.Columns()
+	if err != nil {
+		rows.Close()
+		tx.Rollback()
+		return nil, fmt.Errorf("query failed: %w", err)
+	}
+	return &rowIterator{tx: tx, rows: rows, headers: headers}, nil
+}
+
+type rowIterator struct {
+	tx      *sql.Tx
+	rows    *sql.Rows
+	headers []string
+	offset  int
+}
+
+func (it *rowIterator) Headers() []string { return it.headers }
+
+func (it *rowIterator) Next() (*gcs.SourceRow, error) {
+	if !it.rows.Next() {
+		if err := it.rows.Err(); err != nil {
+			return nil, fmt.Errorf("query failed: %w", err)
+		}
+		return nil, io.EOF
+	}
+	values := make([]interface{}, len(it.headers))
+	ptrs := make([]interface{}, len(values))
+	for i := range values {
+		ptrs[i] = &values[i]
+	}
+	if err := it.rows.Scan(ptrs...); err != nil {
+		return nil, fmt.Errorf("failed to read query row: %w", err)
+	}
+	cells := make([]string, len(values))
+	for i, v := range values {
+		cells[i] = formatValue(v)
+	}
+	row := &gcs.SourceRow{Offset: it.offset, Cells: cells}
+	it.offset++
+	return row, nil
+}
+
+func (it *rowIterator) Close() error {
+	err := it.rows.Close()
+	it.tx.Rollback()
+	return err
+}
+
+// formatValue renders a column value the way a CSV export of the table
+// would: dates without a time part as YYYY-MM-DD and timestamps as RFC 3339.
+func formatValue(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case []byte:
+		return string(v)
+	case bool:
+		return strconv.FormatBool(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case time.Time:
+		if v.Hour() == 0 && v.Minute() == 0 && v.Second() == 0 && v.Nanosecond() == 0 {
+			return v.Format("2006-01-02")
+		}
+		return v.Format(time.RFC3339)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// UpsertTarget is a table that results are written back to. The table needs
+// a unique constraint over KeyColumns; Columns are overwritten on conflict.
+type UpsertTarget struct {
+	Table      string
+	KeyColumns []string
+	Columns    []string
+}
+
+// Upsert writes rows whose values follow KeyColumns and then Columns. Only
+// the write tables of the connection are accepted.
+func (r *Registry) Upsert(ctx context.Context, name string, target UpsertTarget, rows [][]interface{}) error {
+	if err := r.AuthorizeWrite(name, target.Table); err != nil {
+		return err
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	db, err := r.db(name)
+	if err != nil {
+		return err
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to open connection %q: %w", name, err)
+	}
+	defer tx.Rollback()
+
+	for start := 0; start < len(rows); start += upsertBatchSize {
+		end := min(start+upsertBatchSize, len(rows))
+		batch := rows[start:end]
+		query, args := buildUpsert(target, batch)
+		if _, err := tx.ExecContext(ctx, query, args...); err != nil {
+			return fmt.Errorf("failed to write results to %s: %w", target.Table, err)
+		}
+	}
+	return tx.Commit()
+}
+
+func buildUpsert(target UpsertTarget, rows [][]interface{}) (string, []interface{}) {
+	columns := append(append([]string{}, target.KeyColumns...), target.Columns...)
+	quoted := make([]string, len(columns))
+	for i, c := range columns {
+		quoted[i] = QuoteIdentifier(c)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "INSERT INTO %s (%s) VALUES ", QuoteTable(target.Table), strings.Join(quoted, ", "))
+	args := make([]interface{}, 0, len(rows)*len(columns))
+	for i, row := range rows {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString("(")
+		for j := range columns {
+			if j > 0 {
+				sb.WriteString(", ")
+			}
+			args = append(args, row[j])
+			fmt.Fprintf(&sb, "$%d", len(args))
+		}
+		sb.WriteString(")")
+	}
+
+	fmt.Fprintf(&sb, " ON CONFLICT (%s) ", strings.Join(quoted[:len(target.KeyColumns)], ", "))
+	if len(target.Columns) == 0 {
+		sb.WriteString("DO NOTHING")
+		return sb.String(), args
+	}
+	updates := make([]string, len(target.Columns))
+	for i, c := range quoted[len(target.KeyColumns):] {
+		updates[i] = c + " = EXCLUDED." + c
+	}
+	sb.WriteString("DO UPDATE SET " + strings.Join(updates, ", "))
+	return sb.String(), args
+}
+
+func (r *Registry) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var errs []error
+	for _, db := range r.dbs {
+		errs = append(errs, db.Close())
+	}
+	return errors.Join(errs...)
+}
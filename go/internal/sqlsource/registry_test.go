@@ -0,0 +1,137 @@
+package sqlsource
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBuildUpsert(t *testing.T) {
+	tests := []struct {
+		name      string
+		target    UpsertTarget
+		rows      [][]interface{}
+		wantQuery string
+		wantArgs  []interface{}
+	}{
+		{
+			name:      "update enriched columns",
+			target:    UpsertTarget{Table: "crm.companies", KeyColumns: []string{"name", "country"}, Columns: []string{"revenue"}},
+			rows:      [][]interface{}{{"Acme", "US", 10.5}, {"Globex", "DE", nil}},
+			wantQuery: `INSERT INTO "crm"."companies" ("name", "country", "revenue") VALUES ($1, $2, $3), ($4, $5, $6) ON CONFLICT ("name", "country") DO UPDATE SET "revenue" = EXCLUDED."revenue"`,
+			wantArgs:  []interface{}{"Acme", "US", 10.5, "Globex", "DE", nil},
+		},
+		{
+			name:      "keys only",
+			target:    UpsertTarget{Table: "companies", KeyColumns: []string{"name"}},
+			rows:      [][]interface{}{{"Acme"}},
+			wantQuery: `INSERT INTO "companies" ("name") VALUES ($1) ON CONFLICT ("name") DO NOTHING`,
+			wantArgs:  []interface{}{"Acme"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, args := buildUpsert(tt.target, tt.rows)
+			if query != tt.wantQuery {
+				t.Errorf("query = %s\nwant %s", query, tt.wantQuery)
+			}
+			if !reflect.DeepEqual(args, tt.wantArgs) {
+				t.Errorf("args = %v, want %v", args, tt.wantArgs)
+			}
+		})
+	}
+}
+
+func TestFormatValue(t *testing.T) {
+	tests := []struct {
+		name string
+		in   interface{}
+		want string
+	}{
+		{"null", nil, ""},
+		{"bytes", []byte("numeric"), "numeric"},
+		{"int", int64(42), "42"},
+		{"float", 3.25, "3.25"},
+		{"bool", true, "true"},
+		{"date", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), "2024-03-01"},
+		{"timestamp", time.Date(2024, 3, 1, 9, 30, 0, 0, time.UTC), "2024-03-01T09:30:00Z"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatValue(tt.in); got != tt.want {
+				t.Errorf("formatValue(%v) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegistry_Authorize(t *testing.T) {
+	connections, err := ParseConnections(`{
+		"crm": {"dsn": "postgres://crm", "users": ["user_1"]},
+		"warehouse": {"dsn": "postgres://warehouse"}
+	}`)
+	if err != nil {
+		t.Fatalf("ParseConnections() error: %v", err)
+	}
+	registry := NewRegistry(connections)
+
+	if got, want := registry.Names("user_1"), []string{"crm", "warehouse"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Names(user_1) = %v, want %v", got, want)
+	}
+	if got, want := registry.Names("user_2"), []string{"warehouse"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Names(user_2) = %v, want %v", got, want)
+	}
+	if err := registry.Authorize("crm", "user_2"); !errors.Is(err, ErrUnknownConnection) {
+		t.Errorf("Authorize(crm, user_2) = %v, want ErrUnknownConnection", err)
+	}
+	if err := registry.Authorize("missing", "user_1"); !errors.Is(err, ErrUnknownConnection) {
+		t.Errorf("Authorize(missing, user_1) = %v, want ErrUnknownConnection", err)
+	}
+}
+
+func TestRegistry_AuthorizeWrite(t *testing.T) {
+	registry := NewRegistry(map[string]Connection{
+		"crm":       {DSN: "postgres://crm", WriteTables: []string{"enriched.companies"}},
+		"warehouse": {DSN: "postgres://warehouse"},
+	})
+	tests := []struct {
+		name       string
+		connection string
+		table      string
+		wantErr    error
+	}{
+		{"listed table", "crm", "enriched.companies", nil},
+		{"unlisted table", "crm", "users", ErrTableNotWritable},
+		{"same table without schema", "crm", "companies", ErrTableNotWritable},
+		{"read-only connection", "warehouse", "companies", ErrTableNotWritable},
+		{"unknown connection", "missing", "companies", ErrUnknownConnection},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := registry.AuthorizeWrite(tt.connection, tt.table)
+			if tt.wantErr == nil && err != nil || tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Errorf("AuthorizeWrite(%q, %q) = %v, want %v", tt.connection, tt.table, err, tt.wantErr)
+			}
+		})
+	}
+
+	err := registry.Upsert(context.Background(), "crm", UpsertTarget{Table: "users", KeyColumns: []string{"id"}}, [][]interface{}{{"1"}})
+	if !errors.Is(err, ErrTableNotWritable) {
+		t.Errorf("Upsert() to unlisted table = %v, want ErrTableNotWritable", err)
+	}
+}
+
+func TestParseConnections(t *testing.T) {
+	if _, err := ParseConnections(`{"crm": {}}`); err == nil {
+		t.Error("expected an error for a connection without dsn")
+	}
+	if _, err := ParseConnections(`not json`); err == nil {
+		t.Error("expected an error for invalid JSON")
+	}
+	connections, err := ParseConnections("")
+	if err != nil || connections != nil {
+		t.Errorf("ParseConnections(\"\") = %v, %v; want nil, nil", connections, err)
+	}
+}
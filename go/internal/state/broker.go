@@ -0,0 +1,102 @@
+package state
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+// RowEventsChannel is the Postgres notification channel row events are
+// announced on. Payloads are job IDs.
+const RowEventsChannel = "row_events"
+
+// rowEventRetention is how long row events are kept for clients resuming
+// a stream.
+const rowEventRetention = 24 * time.Hour
+
+// RowEventBroker fans the row event notifications of Postgres out to the
+// subscribers of this process, so every API replica can stream the events
+// of jobs whose rows are processed elsewhere.
+type RowEventBroker struct {
+	db    *bun.DB
+	store Store
+
+	mu   sync.Mutex
+	subs map[string]map[chan struct{}]struct{}
+}
+
+func NewRowEventBroker(db *bun.DB, store Store) *RowEventBroker {
+	return &RowEventBroker{
+		db:    db,
+		store: store,
+		subs:  make(map[string]map[chan struct{}]struct{}),
+	}
+}
+
+// Run listens for row event notifications until ctx is done. It also drops
+// row events past their retention every hour.
+func (b *RowEventBroker) Run(ctx context.Context) error {
+	ln := pgdriver.NewListener(b.db)
+	defer ln.Close()
+	if err := ln.Listen(ctx, RowEventsChannel); err != nil {
+		return err
+	}
+	notifications := ln.Channel()
+
+	prune := time.NewTicker(time.Hour)
+	defer prune.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case n, ok := <-notifications:
+			if !ok {
+				return nil
+			}
+			b.publish(n.Payload)
+		case <-prune.C:
+			if _, err := b.store.DeleteRowEventsBefore(ctx, time.Now().Add(-rowEventRetention)); err != nil {
+				log.Printf("Failed to prune row events: %v", err)
+			}
+		}
+	}
+}
+
+// Subscribe returns a channel that receives a signal whenever new events of
+// the job are stored. Signals are coalesced, so subscribers read the events
+// from the store. The returned function ends the subscription.
+func (b *RowEventBroker) Subscribe(jobID string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+	b.mu.Lock()
+	if b.subs[jobID] == nil {
+		b.subs[jobID] = make(map[chan struct{}]struct{})
+	}
+	b.subs[jobID][ch] = struct{}{}
+	b.mu.Unlock()
+
+	return ch, func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		delete(b.subs[jobID], ch)
+		if len(b.subs[jobID]) == 0 {
+			delete(b.subs, jobID)
+		}
+	}
+}
+
+func (b *RowEventBroker) publish(jobID string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for ch := range b.subs[jobID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// A signal is already pending.
+		}
+	}
+}
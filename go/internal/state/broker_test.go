@@ -0,0 +1,40 @@
+package state
+
+import "testing"
+
+func TestRowEventBrokerPublish(t *testing.T) {
+	b := NewRowEventBroker(nil, nil)
+	first, unsubscribeFirst := b.Subscribe("job-1")
+	second, unsubscribeSecond := b.Subscribe("job-1")
+	other, unsubscribeOther := b.Subscribe("job-2")
+	defer unsubscribeOther()
+
+	// Signals coalesce, so publishing twice leaves one pending.
+	b.publish("job-1")
+	b.publish("job-1")
+
+	for name, ch := range map[string]<-chan struct{}{"first": first, "second": second} {
+		select {
+		case <-ch:
+		default:
+			t.Errorf("%s subscriber was not signalled", name)
+		}
+		select {
+		case <-ch:
+			t.Errorf("%s subscriber was signalled twice", name)
+		default:
+		}
+	}
+	select {
+	case <-other:
+		t.Error("subscriber of another job was signalled")
+	default:
+	}
+
+	unsubscribeFirst()
+	unsubscribeSecond()
+	if _, ok := b.subs["job-1"]; ok {
+		t.Error("job without subscribers was kept")
+	}
+	b.publish("job-1")
+}
@@ -39,6 +39,19 @@ func (m *StateManager) InitializeJob(ctx context.Context, jobID string, rowKeys
 	return nil
 }
 
+// PrepareRerun resumes a finished job and resets the rows about to be
+// re-run. rowColumns maps each row key to the columns it re-runs; with
+// clearValues their stored values are dropped first.
+func (m *StateManager) PrepareRerun(ctx context.Context, jobID string, rowColumns map[string][]string, clearValues bool) error {
+	if err := m.store.SetJobStatus(ctx, jobID, models.JobStatusRunning); err != nil {
+		return fmt.Errorf("failed to resume job: %w", err)
+	}
+	if err := m.store.ResetRowsForRerun(ctx, jobID, rowColumns, clearValues); err != nil {
+		return fmt.Errorf("failed to reset rows: %w", err)
+	}
+	return nil
+}
+
 func (m *StateManager) Transition(ctx context.Context, jobID, key string, toStage models.RowStage, update *models.StateUpdate) error {
 	if err := m.checkAndHandleCancellation(ctx, jobID, key); err != nil {
 		return err
@@ -59,12 +72,19 @@ func (m *StateManager) Transition(ctx context.Context, jobID, key string, toStag
 		state.ApplyUpdate(update)
 	}
 
-	if err := m.store.SaveRowState(ctx, jobID, state); err != nil {
+	if err := m.store.SaveRowStateWithEvent(ctx, jobID, state); err != nil {
 		return fmt.Errorf("failed to save row state: %w", err)
 	}
 	return nil
 }
 
+// SaveValues stores values computed for a row after it finished, such as
+// derived columns, leaving its stage as it is.
+func (m *StateManager) SaveValues(ctx context.Context, jobID, key string, values map[string]interface{}, confidence map[string]*models.FieldConfidenceInfo) error {
+	_, err := m.store.SaveRowValues(ctx, jobID, key, values, confidence)
+	return err
+}
+
 func (m *StateManager) checkAndHandleCancellation(ctx context.Context, jobID, key string) error {
 	cancelled, err := m.CheckCancelled(ctx, jobID)
 	if err != nil {
@@ -2,14 +2,21 @@ package state
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/blagoySimandov/ampledata/go/internal/models"
 	"github.com/google/uuid"
 	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
 )
 
+// copyRowsBatchSize bounds how many rows are sent per CopyCompletedRows or
+// SaveRowInputValues statement.
+const copyRowsBatchSize = 5000
+
 type PostgresStore struct {
 	db *bun.DB
 }
@@ -85,6 +92,63 @@ func (s *PostgresStore) createTables(ctx context.Context) error {
 		return fmt.Errorf("failed to create row_states table: %w", err)
 	}
 
+	_, err = s.db.NewCreateTable().
+		Model((*models.ExportDB)(nil)).
+		IfNotExists().
+		ForeignKey(`("job_id") REFERENCES "jobs" ("job_id") ON DELETE CASCADE`).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create exports table: %w", err)
+	}
+
+	_, err = s.db.NewCreateTable().
+		Model((*models.CellCorrectionDB)(nil)).
+		IfNotExists().
+		ForeignKey(`("job_id") REFERENCES "jobs" ("job_id") ON DELETE CASCADE`).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create cell_corrections table: %w", err)
+	}
+
+	_, err = s.db.NewCreateTable().
+		Model((*models.WebhookEndpointDB)(nil)).
+		IfNotExists().
+		ForeignKey(`("user_id") REFERENCES "users" ("id") ON DELETE CASCADE`).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook_endpoints table: %w", err)
+	}
+
+	_, err = s.db.NewCreateTable().
+		Model((*models.WebhookDeliveryDB)(nil)).
+		IfNotExists().
+		ForeignKey(`("endpoint_id") REFERENCES "webhook_endpoints" ("id") ON DELETE CASCADE`).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook_deliveries table: %w", err)
+	}
+
+	_, err = s.db.NewCreateTable().
+		Model((*models.RowEventDB)(nil)).
+		IfNotExists().
+		ForeignKey(`("job_id") REFERENCES "jobs" ("job_id") ON DELETE CASCADE`).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create row_events table: %w", err)
+	}
+	if _, err := s.db.ExecContext(ctx, "CREATE SEQUENCE IF NOT EXISTS "+rowEventSeq); err != nil {
+		return fmt.Errorf("failed to create row event sequence: %w", err)
+	}
+
+	_, err = s.db.NewCreateTable().
+		Model((*models.APIKeyDB)(nil)).
+		IfNotExists().
+		ForeignKey(`("user_id") REFERENCES "users" ("id") ON DELETE CASCADE`).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create api_keys table: %w", err)
+	}
+
 	return nil
 }
 
@@ -100,6 +164,14 @@ func (s *PostgresStore) createIndexes(ctx context.Context) error {
 		{(*models.RowStateDB)(nil), "idx_row_states_job_id", []string{"job_id"}},
 		{(*models.RowStateDB)(nil), "idx_row_states_stage", []string{"job_id", "stage"}},
 		{(*models.RowStateDB)(nil), "idx_row_states_updated_at", []string{"updated_at"}},
+		{(*models.ExportDB)(nil), "idx_exports_job_id", []string{"job_id"}},
+		{(*models.CellCorrectionDB)(nil), "idx_cell_corrections_job_row", []string{"job_id", "row_key"}},
+		{(*models.WebhookEndpointDB)(nil), "idx_webhook_endpoints_user_id", []string{"user_id"}},
+		{(*models.WebhookDeliveryDB)(nil), "idx_webhook_deliveries_endpoint_created", []string{"endpoint_id", "created_at"}},
+		{(*models.RowEventDB)(nil), "idx_row_events_job_id", []string{"job_id", "id"}},
+		{(*models.RowEventDB)(nil), "idx_row_events_job_seq", []string{"job_id", "seq"}},
+		{(*models.RowEventDB)(nil), "idx_row_events_created_at", []string{"created_at"}},
+		{(*models.APIKeyDB)(nil), "idx_api_keys_user_id", []string{"user_id"}},
 	}
 
 	for _, idx := range indexes {
@@ -114,9 +186,37 @@ func (s *PostgresStore) createIndexes(ctx context.Context) error {
 		}
 	}
 
+	_, err := s.db.NewCreateIndex().
+		Model((*models.WebhookDeliveryDB)(nil)).
+		Index("idx_webhook_deliveries_event_endpoint").
+		Unique().
+		Column("event_id", "endpoint_id").
+		IfNotExists().
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create index idx_webhook_deliveries_event_endpoint: %w", err)
+	}
+
+	for _, stmt := range rowFilterIndexes {
+		if _, err := s.db.ExecContext(ctx, stmt); err != nil {
+			return fmt.Errorf("failed to create row filter index: %w", err)
+		}
+	}
+
 	return nil
 }
 
+// rowFilterIndexes serve the filters of GetRowsPaginated: key lookups in
+// extracted_data, substring search on keys and errors, and attempt counts.
+var rowFilterIndexes = []string{
+	`CREATE EXTENSION IF NOT EXISTS pg_trgm`,
+	`CREATE INDEX IF NOT EXISTS idx_row_states_extracted_data ON row_states USING GIN (extracted_data)`,
+	`CREATE INDEX IF NOT EXISTS idx_row_states_key_trgm ON row_states USING GIN (key gin_trgm_ops)`,
+	`CREATE INDEX IF NOT EXISTS idx_row_states_error_trgm ON row_states USING GIN (error gin_trgm_ops)`,
+	`CREATE INDEX IF NOT EXISTS idx_row_states_attempts ON row_states (job_id, ` + attemptsExpr + `)`,
+	`CREATE INDEX IF NOT EXISTS idx_row_events_unsequenced ON row_events (job_id, id) WHERE seq IS NULL`,
+}
+
 func (s *PostgresStore) CreateJob(ctx context.Context, jobID string, totalRows int, status models.JobStatus) error {
 	now := time.Now()
 	job := &models.JobDB{
@@ -155,6 +255,52 @@ func (s *PostgresStore) GetSource(ctx context.Context, sourceID uuid.UUID) (*mod
 	return sourceDB.ToSource()
 }
 
+func (s *PostgresStore) UpdateSourceMetadata(ctx context.Context, sourceID uuid.UUID, metadata json.RawMessage) error {
+	_, err := s.db.NewUpdate().
+		Model((*models.SourceDB)(nil)).
+		Set("metadata = ?", metadata).
+		Set("updated_at = ?", time.Now()).
+		Where("id = ?", sourceID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update source metadata: %w", err)
+	}
+	return nil
+}
+
+// UpdateSource applies update to a source and saves its metadata. The row is
+// locked for the transaction, so concurrent read-modify-writes of the same
+// source are applied one after the other.
+func (s *PostgresStore) UpdateSource(ctx context.Context, sourceID uuid.UUID, update func(*models.Source) error) error {
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var sourceDB models.SourceDB
+		if err := tx.NewSelect().Model(&sourceDB).Where("id = ?", sourceID).For("UPDATE").Scan(ctx); err != nil {
+			return fmt.Errorf("failed to get source: %w", err)
+		}
+		source, err := sourceDB.ToSource()
+		if err != nil {
+			return err
+		}
+		if err := update(source); err != nil {
+			return err
+		}
+		metadata, err := json.Marshal(source.Metadata)
+		if err != nil {
+			return fmt.Errorf("failed to marshal metadata: %w", err)
+		}
+		_, err = tx.NewUpdate().
+			Model((*models.SourceDB)(nil)).
+			Set("metadata = ?", json.RawMessage(metadata)).
+			Set("updated_at = ?", time.Now()).
+			Where("id = ?", sourceID).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update source metadata: %w", err)
+		}
+		return nil
+	})
+}
+
 func (s *PostgresStore) GetSourcesByUser(ctx context.Context, userID string, offset, limit int) ([]*models.Source, error) {
 	var sourcesDB []*models.SourceDB
 	query := s.db.NewSelect().Model(&sourcesDB).Where("src.user_id = ?", userID).Order("src.created_at DESC")
@@ -228,12 +374,14 @@ func (s *PostgresStore) GetJob(ctx context.Context, jobID string) (*models.Job,
 	return jobDB.ToJob()
 }
 
-func (s *PostgresStore) UpdateJobConfiguration(ctx context.Context, jobID string, keyColumns []string, columnsMetadata []*models.ColumnMetadata, keyColumnDescription *string) error {
+func (s *PostgresStore) UpdateJobConfiguration(ctx context.Context, jobID string, keyColumns, contextColumns []string, columnsMetadata []*models.ColumnMetadata, keyColumnDescription, locale *string) error {
 	_, err := s.db.NewUpdate().
 		Model((*models.JobDB)(nil)).
 		Set("key_columns = ?", keyColumns).
+		Set("context_columns = ?", contextColumns).
 		Set("columns_metadata = ?", columnsMetadata).
 		Set("entity_type = ?", keyColumnDescription).
+		Set("locale = ?", locale).
 		Set("updated_at = ?", time.Now()).
 		Where("job_id = ?", jobID).
 		Exec(ctx)
@@ -243,6 +391,33 @@ func (s *PostgresStore) UpdateJobConfiguration(ctx context.Context, jobID string
 	return nil
 }
 
+func (s *PostgresStore) SetJobKeyMapping(ctx context.Context, jobID string, mapping map[string]string) error {
+	_, err := s.db.NewUpdate().
+		Model((*models.JobDB)(nil)).
+		Set("key_mapping = ?", mapping).
+		Set("updated_at = ?", time.Now()).
+		Where("job_id = ?", jobID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set job key mapping: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) SetJobRevision(ctx context.Context, jobID string, sourceRevision int, baseJobID *string) error {
+	_, err := s.db.NewUpdate().
+		Model((*models.JobDB)(nil)).
+		Set("source_revision = ?", sourceRevision).
+		Set("base_job_id = ?", baseJobID).
+		Set("updated_at = ?", time.Now()).
+		Where("job_id = ?", jobID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to set job revision: %w", err)
+	}
+	return nil
+}
+
 func (s *PostgresStore) StartJob(ctx context.Context, jobID string, totalRows int) error {
 	now := time.Now()
 	_, err := s.db.NewUpdate().
@@ -324,6 +499,56 @@ func (s *PostgresStore) BulkCreateRows(ctx context.Context, jobID string, rowKey
 	})
 }
 
+// SaveRowInputValues stores the source cells of a job's rows before the job
+// starts, so its workflow only carries row keys. Rows are created pending
+// when they do not exist yet.
+func (s *PostgresStore) SaveRowInputValues(ctx context.Context, jobID string, values map[string]map[string]string) error {
+	now := time.Now()
+	rows := make([]models.RowStateDB, 0, len(values))
+	for key, rowValues := range values {
+		rows = append(rows, models.RowStateDB{
+			JobID:       jobID,
+			Key:         key,
+			Stage:       models.StagePending,
+			InputValues: rowValues,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+		})
+	}
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		for start := 0; start < len(rows); start += copyRowsBatchSize {
+			end := min(start+copyRowsBatchSize, len(rows))
+			batch := rows[start:end]
+			_, err := tx.NewInsert().
+				Model(&batch).
+				Column("job_id", "key", "stage", "input_values", "created_at", "updated_at").
+				On("CONFLICT (job_id, key) DO UPDATE").
+				Set("input_values = EXCLUDED.input_values").
+				Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to save row input values: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// GetRowInputValues returns the source cells stored for a row, or nil when
+// none were.
+func (s *PostgresStore) GetRowInputValues(ctx context.Context, jobID, key string) (map[string]string, error) {
+	var row models.RowStateDB
+	err := s.db.NewSelect().
+		Model(&row).
+		Column("input_values").
+		Where("job_id = ?", jobID).
+		Where("key = ?", key).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get row input values: %w", err)
+	}
+	return row.InputValues, nil
+}
+
 func prepareDBState(jobID string, state *models.RowState) *models.RowStateDB {
 	dbState := models.RowStateFromApp(jobID, state)
 	now := time.Now()
@@ -362,24 +587,235 @@ func determineColumns(state *models.RowState) (insertCols, updateCols []string)
 }
 
 func (s *PostgresStore) SaveRowState(ctx context.Context, jobID string, state *models.RowState) error {
+	_, err := saveRowStateQuery(s.db, jobID, state).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save row state: %w", err)
+	}
+
+	return nil
+}
+
+// SaveRowStateWithEvent saves a row state and records the saved row as a
+// row event in the same transaction, so the event stream never misses or
+// invents a transition.
+func (s *PostgresStore) SaveRowStateWithEvent(ctx context.Context, jobID string, state *models.RowState) error {
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		var row models.RowStateDB
+		if err := saveRowStateQuery(tx, jobID, state).Returning("*").Scan(ctx, &row); err != nil {
+			return fmt.Errorf("failed to save row state: %w", err)
+		}
+		return appendRowEvents(ctx, tx, jobID, []*models.RowEventDB{models.NewRowEventDB(jobID, row.ToRowState())})
+	})
+}
+
+func saveRowStateQuery(db bun.IDB, jobID string, state *models.RowState) *bun.InsertQuery {
 	dbState := prepareDBState(jobID, state)
 	insertCols, updateCols := determineColumns(state)
 
-	query := s.db.NewInsert().
+	query := db.NewInsert().
 		Model(dbState).
 		Column(insertCols...).
 		On("CONFLICT (job_id, key) DO UPDATE")
 
 	for _, col := range updateCols {
+		if col == "extracted_data" || col == "confidence" {
+			query = query.Set("? = "+keepVerifiedExpr, bun.Ident(col), bun.Ident(col), bun.Ident(col))
+			continue
+		}
 		query = query.Set("? = EXCLUDED.?", bun.Ident(col), bun.Ident(col))
 	}
+	return query
+}
 
-	_, err := query.Exec(ctx)
+// keepVerifiedExpr merges the human-verified cells of the stored row over
+// the saved jsonb column, so a save based on a read from before a
+// correction cannot undo it.
+const keepVerifiedExpr = `COALESCE(EXCLUDED.?, '{}'::jsonb) || ` + verifiedCellsExpr
+
+// verifiedCellsExpr selects the human-verified cells of a jsonb column of
+// the stored row.
+const verifiedCellsExpr = `COALESCE((
+	SELECT jsonb_object_agg(c.key, rs.? -> c.key)
+	FROM jsonb_each(CASE WHEN jsonb_typeof(rs.confidence) = 'object' THEN rs.confidence ELSE '{}'::jsonb END) AS c(key, info)
+	WHERE c.info ->> 'human_verified' = 'true'
+), '{}'::jsonb)`
+
+// SaveRowValues merges values and their confidence into a row without
+// changing its stage, recording the row as a row event in the same
+// transaction. Human-verified cells are kept.
+func (s *PostgresStore) SaveRowValues(ctx context.Context, jobID, key string, values map[string]interface{}, confidence map[string]*models.FieldConfidenceInfo) (*models.RowState, error) {
+	var row models.RowStateDB
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		q, err := saveRowValuesQuery(tx, &row, jobID, key, values, confidence, time.Now())
+		if err != nil {
+			return err
+		}
+		if err := q.Scan(ctx); err != nil {
+			return fmt.Errorf("failed to save row values: %w", err)
+		}
+		return appendRowEvents(ctx, tx, jobID, []*models.RowEventDB{models.NewRowEventDB(jobID, row.ToRowState())})
+	})
 	if err != nil {
-		return fmt.Errorf("failed to save row state: %w", err)
+		return nil, err
 	}
+	return row.ToRowState(), nil
+}
 
-	return nil
+func saveRowValuesQuery(db bun.IDB, row *models.RowStateDB, jobID, key string, values map[string]interface{}, confidence map[string]*models.FieldConfidenceInfo, now time.Time) (*bun.UpdateQuery, error) {
+	valuesJSON, err := json.Marshal(values)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode row values: %w", err)
+	}
+	confidenceJSON, err := json.Marshal(confidence)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode row confidence: %w", err)
+	}
+	mergeExpr := "? = COALESCE(rs.?, '{}'::jsonb) || ?::jsonb || " + verifiedCellsExpr
+	return db.NewUpdate().
+		Model(row).
+		Set(mergeExpr, bun.Ident("extracted_data"), bun.Ident("extracted_data"), string(valuesJSON), bun.Ident("extracted_data")).
+		Set(mergeExpr, bun.Ident("confidence"), bun.Ident("confidence"), string(confidenceJSON), bun.Ident("confidence")).
+		Set("updated_at = ?", now).
+		Where("job_id = ?", jobID).
+		Where("key = ?", key).
+		Returning("*"), nil
+}
+
+// ResetRowsForRerun moves rows back to PENDING and clears their errors so
+// their columns can be enriched again, recording a row event for each.
+// rowColumns maps each row key to the columns it re-runs; with clearValues
+// their stored values are removed, except for human-verified ones.
+func (s *PostgresStore) ResetRowsForRerun(ctx context.Context, jobID string, rowColumns map[string][]string, clearValues bool) error {
+	groups := groupRowsByColumns(rowColumns)
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		now := time.Now()
+		var events []*models.RowEventDB
+		for _, g := range groups {
+			var rows []models.RowStateDB
+			if err := resetRowsQuery(tx, jobID, g.keys, g.columns, clearValues, now).Scan(ctx, &rows); err != nil {
+				return fmt.Errorf("failed to reset rows: %w", err)
+			}
+			for i := range rows {
+				events = append(events, models.NewRowEventDB(jobID, rows[i].ToRowState()))
+			}
+		}
+		return appendRowEvents(ctx, tx, jobID, events)
+	})
+}
+
+type rowGroup struct {
+	columns []string
+	keys    []string
+}
+
+// groupRowsByColumns batches rows that re-run the same columns, so each
+// batch is reset by one statement.
+func groupRowsByColumns(rowColumns map[string][]string) []*rowGroup {
+	var groups []*rowGroup
+	byColumns := make(map[string]*rowGroup)
+	for key, columns := range rowColumns {
+		id := strings.Join(columns, "\x00")
+		g, ok := byColumns[id]
+		if !ok {
+			g = &rowGroup{columns: columns}
+			byColumns[id] = g
+			groups = append(groups, g)
+		}
+		g.keys = append(g.keys, key)
+	}
+	return groups
+}
+
+// unverifiedColumnsExpr lists the given columns that hold no human-verified
+// value.
+const unverifiedColumnsExpr = `ARRAY(SELECT c FROM unnest(?::text[]) AS c WHERE rs.confidence -> c ->> 'human_verified' IS DISTINCT FROM 'true')`
+
+func resetRowsQuery(db bun.IDB, jobID string, keys, columns []string, clearValues bool, now time.Time) *bun.UpdateQuery {
+	q := db.NewUpdate().
+		Model((*models.RowStateDB)(nil)).
+		Set("stage = ?", models.StagePending).
+		Set("error = NULL").
+		Set("updated_at = ?", now).
+		Where("job_id = ?", jobID).
+		Where("key IN (?)", bun.In(keys)).
+		Returning("*")
+	if clearValues {
+		q = q.Set("extracted_data = extracted_data - "+unverifiedColumnsExpr, pgdialect.Array(columns)).
+			Set("confidence = confidence - "+unverifiedColumnsExpr, pgdialect.Array(columns))
+	}
+	return q
+}
+
+// CorrectCell sets one extracted cell of a row to a reviewer's value,
+// marks it human-verified with full confidence and records the change and
+// a row event.
+func (s *PostgresStore) CorrectCell(ctx context.Context, correction *models.CellCorrectionDB) (*models.RowState, error) {
+	var row models.RowStateDB
+	err := s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		err := tx.NewSelect().
+			Model(&row).
+			Where("job_id = ?", correction.JobID).
+			Where("key = ?", correction.RowKey).
+			For("UPDATE").
+			Scan(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to get row state: %w", err)
+		}
+
+		if row.ExtractedData == nil {
+			row.ExtractedData = make(map[string]interface{})
+		}
+		if row.Confidence == nil {
+			row.Confidence = make(map[string]*models.FieldConfidenceInfo)
+		}
+		correction.OldValue = row.ExtractedData[correction.Column]
+		row.ExtractedData[correction.Column] = correction.NewValue
+		row.Confidence[correction.Column] = &models.FieldConfidenceInfo{
+			Score:         1.0,
+			Reason:        models.CorrectionReason,
+			Precision:     correction.Precision,
+			HumanVerified: true,
+		}
+		row.UpdatedAt = correction.CreatedAt
+
+		_, err = tx.NewUpdate().
+			Model(&row).
+			Column("extracted_data", "confidence", "updated_at").
+			WherePK().
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update row state: %w", err)
+		}
+		if _, err := tx.NewInsert().Model(correction).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to record correction: %w", err)
+		}
+		return appendRowEvents(ctx, tx, correction.JobID, []*models.RowEventDB{models.NewRowEventDB(correction.JobID, row.ToRowState())})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return row.ToRowState(), nil
+}
+
+// GetCellCorrections returns the corrections of a job, newest first. A
+// non-empty rowKey limits them to one row.
+func (s *PostgresStore) GetCellCorrections(ctx context.Context, jobID, rowKey string) ([]*models.CellCorrection, error) {
+	var dbCorrections []models.CellCorrectionDB
+	q := s.db.NewSelect().
+		Model(&dbCorrections).
+		Where("job_id = ?", jobID).
+		Order("created_at DESC")
+	if rowKey != "" {
+		q = q.Where("row_key = ?", rowKey)
+	}
+	if err := q.Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to get cell corrections: %w", err)
+	}
+	corrections := make([]*models.CellCorrection, len(dbCorrections))
+	for i := range dbCorrections {
+		corrections[i] = dbCorrections[i].ToCellCorrection()
+	}
+	return corrections, nil
 }
 
 func (s *PostgresStore) GetRowState(ctx context.Context, jobID string, key string) (*models.RowState, error) {
@@ -404,7 +840,7 @@ func (s *PostgresStore) GetRowsAtStage(ctx context.Context, jobID string, stage
 		Model(&dbStates).
 		Where("job_id = ?", jobID).
 		Where("stage = ?", stage).
-		Order("created_at ASC")
+		Order("created_at ASC", "key ASC")
 
 	if offset > 0 {
 		query = query.Offset(offset)
@@ -426,6 +862,33 @@ func (s *PostgresStore) GetRowsAtStage(ctx context.Context, jobID string, stage
 	return states, nil
 }
 
+// GetRowsAtStageAfterKey returns up to limit rows of a job at a stage in key
+// order, starting after afterKey unless it is nil. Unlike offset paging it
+// visits every row exactly once, however many rows share a created_at.
+func (s *PostgresStore) GetRowsAtStageAfterKey(ctx context.Context, jobID string, stage models.RowStage, afterKey *string, limit int) ([]*models.RowState, error) {
+	var dbStates []models.RowStateDB
+	if err := rowsAtStageAfterKeyQuery(s.db, &dbStates, jobID, stage, afterKey, limit).Scan(ctx); err != nil {
+		return nil, fmt.Errorf("failed to query rows at stage: %w", err)
+	}
+
+	states := make([]*models.RowState, len(dbStates))
+	for i := range dbStates {
+		states[i] = dbStates[i].ToRowState()
+	}
+	return states, nil
+}
+
+func rowsAtStageAfterKeyQuery(db bun.IDB, dbStates *[]models.RowStateDB, jobID string, stage models.RowStage, afterKey *string, limit int) *bun.SelectQuery {
+	query := db.NewSelect().
+		Model(dbStates).
+		Where("job_id = ?", jobID).
+		Where("stage = ?", stage)
+	if afterKey != nil {
+		query = query.Where("key > ?", *afterKey)
+	}
+	return query.Order("key ASC").Limit(limit)
+}
+
 var cancellableStatuses = []models.JobStatus{
 	models.JobStatusPending,
 	models.JobStatusRunning,
@@ -567,6 +1030,209 @@ func (s *PostgresStore) IncrementJobCost(ctx context.Context, jobID string, cost
 	return nil
 }
 
+func (s *PostgresStore) GetRowKeysAtStage(ctx context.Context, jobID string, stage models.RowStage) ([]string, error) {
+	var keys []string
+	err := s.db.NewSelect().
+		Model((*models.RowStateDB)(nil)).
+		Column("key").
+		Where("job_id = ?", jobID).
+		Where("stage = ?", stage).
+		Scan(ctx, &keys)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query row keys at stage: %w", err)
+	}
+	return keys, nil
+}
+
+// GetRowStates looks up rows of a job by key. Keys the job has no row for
+// are missing from the result.
+func (s *PostgresStore) GetRowStates(ctx context.Context, jobID string, keys []string) (map[string]*models.RowState, error) {
+	states := make(map[string]*models.RowState, len(keys))
+	if len(keys) == 0 {
+		return states, nil
+	}
+	var dbStates []models.RowStateDB
+	err := s.db.NewSelect().
+		Model(&dbStates).
+		Where("job_id = ?", jobID).
+		Where("key IN (?)", bun.In(keys)).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get row states: %w", err)
+	}
+	for i := range dbStates {
+		states[dbStates[i].Key] = dbStates[i].ToRowState()
+	}
+	return states, nil
+}
+
+// GetJobPairKeys lists the row keys of two jobs in key order, each key once,
+// so their rows can be compared a batch at a time. It returns up to limit
+// keys, starting after afterKey unless it is nil.
+func (s *PostgresStore) GetJobPairKeys(ctx context.Context, baseJobID, jobID string, afterKey *string, limit int) ([]string, error) {
+	var keys []string
+	if err := jobPairKeysQuery(s.db, baseJobID, jobID, afterKey, limit).Scan(ctx, &keys); err != nil {
+		return nil, fmt.Errorf("failed to list row keys: %w", err)
+	}
+	return keys, nil
+}
+
+// jobPairKeysQuery merges the key-ordered rows of both jobs. Each side is
+// limited on its own first, so only 2*limit index entries are read.
+func jobPairKeysQuery(db bun.IDB, baseJobID, jobID string, afterKey *string, limit int) *bun.SelectQuery {
+	side := func(id string) *bun.SelectQuery {
+		q := db.NewSelect().
+			Model((*models.RowStateDB)(nil)).
+			Column("key").
+			Where("job_id = ?", id)
+		if afterKey != nil {
+			q = q.Where("key > ?", *afterKey)
+		}
+		return q.Order("key ASC").Limit(limit)
+	}
+	return db.NewSelect().
+		TableExpr("((?) UNION (?)) AS pair", side(baseJobID), side(jobID)).
+		Column("pair.key").
+		Order("pair.key ASC").
+		Limit(limit)
+}
+
+// GetRowsVersion returns the version of the rows of the given jobs.
+func (s *PostgresStore) GetRowsVersion(ctx context.Context, jobIDs []string) (RowsVersion, error) {
+	var v RowsVersion
+	err := s.db.NewSelect().
+		Model((*models.RowStateDB)(nil)).
+		ColumnExpr("count(*)").
+		ColumnExpr("coalesce(max(updated_at), 'epoch')").
+		Where("job_id IN (?)", bun.In(jobIDs)).
+		Scan(ctx, &v.Count, &v.UpdatedAt)
+	if err != nil {
+		return RowsVersion{}, fmt.Errorf("failed to get rows version: %w", err)
+	}
+	return v, nil
+}
+
+// CopyCompletedRows carries completed rows of one job into another. keys maps
+// each row key of the target job to the key of the row it is copied from.
+func (s *PostgresStore) CopyCompletedRows(ctx context.Context, fromJobID, toJobID string, keys map[string]string) error {
+	newKeys := make([]string, 0, len(keys))
+	oldKeys := make([]string, 0, len(keys))
+	for newKey, oldKey := range keys {
+		newKeys = append(newKeys, newKey)
+		oldKeys = append(oldKeys, oldKey)
+	}
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		now := time.Now()
+		for start := 0; start < len(newKeys); start += copyRowsBatchSize {
+			end := min(start+copyRowsBatchSize, len(newKeys))
+			_, err := tx.NewRaw(`
+				INSERT INTO row_states (job_id, key, stage, extracted_data, confidence, sources, extraction_history, error, created_at, updated_at)
+				SELECT ?, m.new_key, rs.stage, rs.extracted_data, rs.confidence, rs.sources, rs.extraction_history, rs.error, ?, ?
+				FROM row_states rs
+				JOIN unnest(?::text[], ?::text[]) AS m(new_key, old_key) ON rs.key = m.old_key
+				WHERE rs.job_id = ? AND rs.stage = ?
+				ON CONFLICT (job_id, key) DO NOTHING`,
+				toJobID, now, now,
+				pgdialect.Array(newKeys[start:end]), pgdialect.Array(oldKeys[start:end]),
+				fromJobID, models.StageCompleted,
+			).Exec(ctx)
+			if err != nil {
+				return fmt.Errorf("failed to copy rows: %w", err)
+			}
+		}
+		return nil
+	})
+}
+
+// AppendRowEvent records a row event and notifies the listeners of
+// RowEventsChannel once it is committed. The notification only names the
+// job; listeners read the events from the table.
+// appendRowEvents records events of a job in tx and notifies the listeners
+// of RowEventsChannel once tx commits. Writers take no lock: events are
+// ordered by commit when they are read, see sequenceRowEvents.
+func appendRowEvents(ctx context.Context, tx bun.Tx, jobID string, events []*models.RowEventDB) error {
+	if len(events) == 0 {
+		return nil
+	}
+	if _, err := tx.NewInsert().Model(&events).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to append row events: %w", err)
+	}
+	if _, err := tx.ExecContext(ctx, "SELECT pg_notify(?, ?)", RowEventsChannel, jobID); err != nil {
+		return fmt.Errorf("failed to notify row events: %w", err)
+	}
+	return nil
+}
+
+// rowEventSeq numbers committed row events.
+const rowEventSeq = "row_event_seq"
+
+// sequenceRowEventsSQL numbers the job's committed events that have no Seq
+// yet, in insertion order. Events of transactions still in progress are not
+// visible to it and are numbered by a later call, after every event
+// numbered now.
+const sequenceRowEventsSQL = `UPDATE row_events AS re SET seq = pending.seq
+FROM (
+	SELECT id, nextval('` + rowEventSeq + `') AS seq
+	FROM (SELECT id FROM row_events WHERE job_id = ? AND seq IS NULL ORDER BY id) AS unsequenced
+) AS pending
+WHERE re.id = pending.id`
+
+// sequenceRowEvents assigns the Seq of the job's newly committed events.
+// Readers of the same job take turns under a per-job lock, so a Seq is
+// visible before any higher one is assigned and a reader resuming after a
+// Seq cannot miss an event. Only readers take the lock; row workflows
+// writing events never wait for each other.
+func (s *PostgresStore) sequenceRowEvents(ctx context.Context, jobID string) error {
+	return s.db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		if _, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", RowEventsChannel+":"+jobID); err != nil {
+			return fmt.Errorf("failed to lock row events: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, sequenceRowEventsSQL, jobID); err != nil {
+			return fmt.Errorf("failed to sequence row events: %w", err)
+		}
+		return nil
+	})
+}
+
+// GetRowEvents returns up to limit events of a job after the given ID, in
+// commit order. It first numbers the events committed since the last read,
+// so every event committed later gets a higher ID.
+func (s *PostgresStore) GetRowEvents(ctx context.Context, jobID string, afterID int64, limit int) ([]*models.RowEvent, error) {
+	if err := s.sequenceRowEvents(ctx, jobID); err != nil {
+		return nil, err
+	}
+	var eventDBs []models.RowEventDB
+	err := s.db.NewSelect().
+		Model(&eventDBs).
+		Where("job_id = ?", jobID).
+		Where("seq > ?", afterID).
+		Order("seq ASC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get row events: %w", err)
+	}
+	events := make([]*models.RowEvent, len(eventDBs))
+	for i := range eventDBs {
+		events[i] = eventDBs[i].ToRowEvent()
+	}
+	return events, nil
+}
+
+// DeleteRowEventsBefore drops row events older than before and returns how
+// many were dropped.
+func (s *PostgresStore) DeleteRowEventsBefore(ctx context.Context, before time.Time) (int, error) {
+	res, err := s.db.NewDelete().
+		Model((*models.RowEventDB)(nil)).
+		Where("created_at < ?", before).
+		Exec(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete row events: %w", err)
+	}
+	n, err := res.RowsAffected()
+	return int(n), err
+}
+
 func (s *PostgresStore) GetRowsPaginated(ctx context.Context, jobID string, params RowsQueryParams) (*PaginatedRows, error) {
 	var dbStates []models.RowStateDB
 	var total int
@@ -575,10 +1241,7 @@ func (s *PostgresStore) GetRowsPaginated(ctx context.Context, jobID string, para
 	countQuery := s.db.NewSelect().
 		Model((*models.RowStateDB)(nil)).
 		Where("job_id = ?", jobID)
-
-	if params.Stage != "" && params.Stage != "all" {
-		countQuery = countQuery.Where("stage = ?", params.Stage)
-	}
+	countQuery = applyRowFilters(countQuery, params)
 
 	total, err = countQuery.Count(ctx)
 	if err != nil {
@@ -588,10 +1251,7 @@ func (s *PostgresStore) GetRowsPaginated(ctx context.Context, jobID string, para
 	query := s.db.NewSelect().
 		Model(&dbStates).
 		Where("job_id = ?", jobID)
-
-	if params.Stage != "" && params.Stage != "all" {
-		query = query.Where("stage = ?", params.Stage)
-	}
+	query = applyRowFilters(query, params)
 
 	switch params.Sort {
 	case "updated_at_asc":
@@ -627,6 +1287,347 @@ func (s *PostgresStore) GetRowsPaginated(ctx context.Context, jobID string, para
 	}, nil
 }
 
+// attemptsExpr counts the extraction attempts of a row. It matches the
+// expression of idx_row_states_attempts.
+const attemptsExpr = "(CASE WHEN jsonb_typeof(extraction_history) = 'array' THEN jsonb_array_length(extraction_history) ELSE 0 END)"
+
+// applyRowFilters narrows a row_states query by stage and RowFilters.
+func applyRowFilters(q *bun.SelectQuery, params RowsQueryParams) *bun.SelectQuery {
+	if params.Stage != "" && params.Stage != "all" {
+		q = q.Where("stage = ?", params.Stage)
+	}
+	f := params.Filters
+	for _, col := range f.Present {
+		// The escaped ? is the jsonb key operator, which idx_row_states_extracted_data serves.
+		q = q.Where("extracted_data \\? ? AND extracted_data -> ? <> 'null'::jsonb", col, col)
+	}
+	for _, col := range f.Missing {
+		q = q.Where("COALESCE(extracted_data -> ?, 'null'::jsonb) = 'null'::jsonb", col)
+	}
+	for _, r := range f.Confidence {
+		q = applyConfidenceRange(q, r)
+	}
+	if f.ErrorContains != "" {
+		q = q.Where("error ILIKE ?", containsPattern(f.ErrorContains))
+	}
+	if f.KeyContains != "" {
+		q = q.Where("key ILIKE ?", containsPattern(f.KeyContains))
+	}
+	if f.MinAttempts != nil {
+		q = q.Where(attemptsExpr+" >= ?", *f.MinAttempts)
+	}
+	if f.MaxAttempts != nil {
+		q = q.Where(attemptsExpr+" <= ?", *f.MaxAttempts)
+	}
+	return q
+}
+
+func applyConfidenceRange(q *bun.SelectQuery, r ConfidenceRange) *bun.SelectQuery {
+	score := "(confidence -> ? ->> 'score')::float8"
+	if r.Column == AnyColumn {
+		score = "(c.info ->> 'score')::float8"
+	}
+	var conds []string
+	var args []interface{}
+	for _, bound := range []struct {
+		op    string
+		value *float64
+	}{{">=", r.Min}, {"<", r.Max}} {
+		if bound.value == nil {
+			continue
+		}
+		conds = append(conds, score+" "+bound.op+" ?")
+		if r.Column != AnyColumn {
+			args = append(args, r.Column)
+		}
+		args = append(args, *bound.value)
+	}
+	if len(conds) == 0 {
+		if r.Column == AnyColumn {
+			return q
+		}
+		return q.Where("confidence -> ? ->> 'score' IS NOT NULL", r.Column)
+	}
+	cond := strings.Join(conds, " AND ")
+	if r.Column == AnyColumn {
+		cond = "EXISTS (SELECT 1 FROM jsonb_each(CASE WHEN jsonb_typeof(confidence) = 'object' THEN confidence ELSE '{}'::jsonb END) AS c(name, info) WHERE " + cond + ")"
+	}
+	return q.Where(cond, args...)
+}
+
+// containsPattern is an ILIKE pattern matching text anywhere.
+func containsPattern(text string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(text)
+	return "%" + escaped + "%"
+}
+
 func (s *PostgresStore) Close() error {
 	return s.db.Close()
 }
+
+func (s *PostgresStore) CreateExport(ctx context.Context, export *models.ExportDB) error {
+	_, err := s.db.NewInsert().Model(export).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create export: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) GetExport(ctx context.Context, exportID uuid.UUID) (*models.Export, error) {
+	var exportDB models.ExportDB
+	err := s.db.NewSelect().Model(&exportDB).Where("id = ?", exportID).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get export: %w", err)
+	}
+	return exportDB.ToExport(), nil
+}
+
+func (s *PostgresStore) GetExportsByJob(ctx context.Context, jobID string) ([]*models.Export, error) {
+	var exportDBs []models.ExportDB
+	err := s.db.NewSelect().
+		Model(&exportDBs).
+		Where("job_id = ?", jobID).
+		Order("created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get exports: %w", err)
+	}
+	exports := make([]*models.Export, len(exportDBs))
+	for i := range exportDBs {
+		exports[i] = exportDBs[i].ToExport()
+	}
+	return exports, nil
+}
+
+// FinishExport records the outcome of building an export: the written object
+// and its row count, or the error that stopped it.
+func (s *PostgresStore) FinishExport(ctx context.Context, exportID uuid.UUID, objectName string, rowCount int, exportErr *string) error {
+	status := models.ExportStatusCompleted
+	var object *string
+	if exportErr != nil {
+		status = models.ExportStatusFailed
+	} else {
+		object = &objectName
+	}
+	_, err := s.db.NewUpdate().
+		Model((*models.ExportDB)(nil)).
+		Set("status = ?", status).
+		Set("object_name = ?", object).
+		Set("row_count = ?", rowCount).
+		Set("error = ?", exportErr).
+		Set("completed_at = ?", time.Now()).
+		Where("id = ?", exportID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to finish export: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) SetExportStatus(ctx context.Context, exportID uuid.UUID, status models.ExportStatus) error {
+	_, err := s.db.NewUpdate().
+		Model((*models.ExportDB)(nil)).
+		Set("status = ?", status).
+		Where("id = ?", exportID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update export status: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) CreateWebhookEndpoint(ctx context.Context, endpoint *models.WebhookEndpointDB) error {
+	_, err := s.db.NewInsert().Model(endpoint).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) GetWebhookEndpoint(ctx context.Context, endpointID uuid.UUID) (*models.WebhookEndpoint, error) {
+	var endpointDB models.WebhookEndpointDB
+	err := s.db.NewSelect().Model(&endpointDB).Where("id = ?", endpointID).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook endpoint: %w", err)
+	}
+	return endpointDB.ToWebhookEndpoint(), nil
+}
+
+func (s *PostgresStore) GetWebhookEndpointsByUser(ctx context.Context, userID string) ([]*models.WebhookEndpoint, error) {
+	var endpointDBs []models.WebhookEndpointDB
+	err := s.db.NewSelect().
+		Model(&endpointDBs).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook endpoints: %w", err)
+	}
+	endpoints := make([]*models.WebhookEndpoint, len(endpointDBs))
+	for i := range endpointDBs {
+		endpoints[i] = endpointDBs[i].ToWebhookEndpoint()
+	}
+	return endpoints, nil
+}
+
+// DeleteWebhookEndpoint removes an endpoint together with its delivery log.
+func (s *PostgresStore) DeleteWebhookEndpoint(ctx context.Context, endpointID uuid.UUID) error {
+	_, err := s.db.NewDelete().
+		Model((*models.WebhookEndpointDB)(nil)).
+		Where("id = ?", endpointID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook endpoint: %w", err)
+	}
+	return nil
+}
+
+// CreateWebhookDeliveries records the deliveries of an event and returns
+// their IDs. Deliveries already recorded for the same event and endpoint
+// are kept, so retrying the call does not send an event twice.
+func (s *PostgresStore) CreateWebhookDeliveries(ctx context.Context, deliveries []*models.WebhookDeliveryDB) ([]uuid.UUID, error) {
+	if len(deliveries) == 0 {
+		return nil, nil
+	}
+	var ids []uuid.UUID
+	err := createWebhookDeliveriesQuery(s.db, deliveries).Returning("id").Scan(ctx, &ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook deliveries: %w", err)
+	}
+	return ids, nil
+}
+
+// createWebhookDeliveriesQuery touches the event ID of existing deliveries
+// instead of skipping them, so RETURNING lists every delivery of the event.
+func createWebhookDeliveriesQuery(db bun.IDB, deliveries []*models.WebhookDeliveryDB) *bun.InsertQuery {
+	return db.NewInsert().
+		Model(&deliveries).
+		On("CONFLICT (event_id, endpoint_id) DO UPDATE").
+		Set("event_id = EXCLUDED.event_id")
+}
+
+func (s *PostgresStore) GetWebhookDelivery(ctx context.Context, deliveryID uuid.UUID) (*models.WebhookDelivery, error) {
+	var deliveryDB models.WebhookDeliveryDB
+	err := s.db.NewSelect().Model(&deliveryDB).Where("id = ?", deliveryID).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+	return deliveryDB.ToWebhookDelivery(), nil
+}
+
+// GetWebhookDeliveries returns a page of an endpoint's deliveries, newest
+// first, and their total. A non-empty jobID limits them to one job.
+func (s *PostgresStore) GetWebhookDeliveries(ctx context.Context, endpointID uuid.UUID, jobID string, offset, limit int) ([]*models.WebhookDelivery, int, error) {
+	var deliveryDBs []models.WebhookDeliveryDB
+	q := s.db.NewSelect().
+		Model(&deliveryDBs).
+		Where("endpoint_id = ?", endpointID).
+		Order("created_at DESC").
+		Offset(offset).
+		Limit(limit)
+	if jobID != "" {
+		q = q.Where("job_id = ?", jobID)
+	}
+	total, err := q.ScanAndCount(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get webhook deliveries: %w", err)
+	}
+	deliveries := make([]*models.WebhookDelivery, len(deliveryDBs))
+	for i := range deliveryDBs {
+		deliveries[i] = deliveryDBs[i].ToWebhookDelivery()
+	}
+	return deliveries, total, nil
+}
+
+// RecordWebhookAttempt stores the outcome of an attempt to send a delivery.
+func (s *PostgresStore) RecordWebhookAttempt(ctx context.Context, deliveryID uuid.UUID, status models.WebhookDeliveryStatus, attempts int, responseStatus *int, deliveryErr *string) error {
+	var deliveredAt *time.Time
+	if status == models.WebhookDeliveryDelivered {
+		now := time.Now()
+		deliveredAt = &now
+	}
+	_, err := s.db.NewUpdate().
+		Model((*models.WebhookDeliveryDB)(nil)).
+		Set("status = ?", status).
+		Set("attempts = ?", attempts).
+		Set("response_status = ?", responseStatus).
+		Set("error = ?", deliveryErr).
+		Set("delivered_at = ?", deliveredAt).
+		Where("id = ?", deliveryID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to record webhook attempt: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) CreateAPIKey(ctx context.Context, key *models.APIKeyDB) error {
+	_, err := s.db.NewInsert().Model(key).Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create API key: %w", err)
+	}
+	return nil
+}
+
+func (s *PostgresStore) GetAPIKey(ctx context.Context, keyID uuid.UUID) (*models.APIKey, error) {
+	var keyDB models.APIKeyDB
+	err := s.db.NewSelect().Model(&keyDB).Where("id = ?", keyID).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+	return keyDB.ToAPIKey(), nil
+}
+
+func (s *PostgresStore) GetAPIKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error) {
+	var keyDB models.APIKeyDB
+	err := s.db.NewSelect().Model(&keyDB).Where("key_hash = ?", keyHash).Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API key: %w", err)
+	}
+	return keyDB.ToAPIKey(), nil
+}
+
+func (s *PostgresStore) GetAPIKeysByUser(ctx context.Context, userID string) ([]*models.APIKey, error) {
+	var keyDBs []models.APIKeyDB
+	err := s.db.NewSelect().
+		Model(&keyDBs).
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Scan(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API keys: %w", err)
+	}
+	keys := make([]*models.APIKey, len(keyDBs))
+	for i := range keyDBs {
+		keys[i] = keyDBs[i].ToAPIKey()
+	}
+	return keys, nil
+}
+
+// RevokeAPIKey stops a key from authenticating. Revoking a revoked key keeps
+// its first revocation time.
+func (s *PostgresStore) RevokeAPIKey(ctx context.Context, keyID uuid.UUID, revokedAt time.Time) error {
+	_, err := s.db.NewUpdate().
+		Model((*models.APIKeyDB)(nil)).
+		Set("revoked_at = ?", revokedAt).
+		Where("id = ?", keyID).
+		Where("revoked_at IS NULL").
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to revoke API key: %w", err)
+	}
+	return nil
+}
+
+// TouchAPIKey records when a key was last used.
+func (s *PostgresStore) TouchAPIKey(ctx context.Context, keyID uuid.UUID, usedAt time.Time) error {
+	_, err := s.db.NewUpdate().
+		Model((*models.APIKeyDB)(nil)).
+		Set("last_used_at = ?", usedAt).
+		Where("id = ?", keyID).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update API key: %w", err)
+	}
+	return nil
+}
@@ -2,16 +2,47 @@ package state
 
 import (
 	"context"
+	"encoding/json"
+	"time"
 
 	"github.com/blagoySimandov/ampledata/go/internal/models"
 	"github.com/google/uuid"
 )
 
 type RowsQueryParams struct {
-	Offset int
-	Limit  int
-	Stage  string
-	Sort   string
+	Offset  int
+	Limit   int
+	Stage   string
+	Sort    string
+	Filters RowFilters
+}
+
+// RowFilters narrows the rows of a job. Every set filter must match.
+type RowFilters struct {
+	// Present and Missing list columns that must have, or lack, an
+	// extracted value. A null value counts as missing.
+	Present []string
+	Missing []string
+	// Confidence bounds the confidence scores of columns.
+	Confidence []ConfidenceRange
+	// ErrorContains and KeyContains match substrings, ignoring case.
+	ErrorContains string
+	KeyContains   string
+	// MinAttempts and MaxAttempts bound the number of extraction attempts.
+	MinAttempts *int
+	MaxAttempts *int
+}
+
+// AnyColumn as a ConfidenceRange column matches rows where any column's
+// score is in range.
+const AnyColumn = "*"
+
+// ConfidenceRange matches rows whose confidence score for Column is at
+// least Min and below Max. A nil bound is open.
+type ConfidenceRange struct {
+	Column string
+	Min    *float64
+	Max    *float64
 }
 
 type PaginatedRows struct {
@@ -19,28 +50,77 @@ type PaginatedRows struct {
 	Total int
 }
 
+// RowsVersion identifies the state of a set of rows: it changes whenever a
+// row is added, removed or updated, so results computed from the rows can be
+// reused while it stays the same.
+type RowsVersion struct {
+	Count     int
+	UpdatedAt time.Time
+}
+
 type Store interface {
 	CreateSource(ctx context.Context, source *models.SourceDB) error
 	GetSource(ctx context.Context, sourceID uuid.UUID) (*models.Source, error)
+	UpdateSourceMetadata(ctx context.Context, sourceID uuid.UUID, metadata json.RawMessage) error
+	UpdateSource(ctx context.Context, sourceID uuid.UUID, update func(*models.Source) error) error
 	GetSourcesByUser(ctx context.Context, userID string, offset, limit int) ([]*models.Source, error)
 	GetJobsBySource(ctx context.Context, sourceID uuid.UUID) ([]*models.Job, error)
 	CreatePendingJob(ctx context.Context, jobID, userID string, sourceID uuid.UUID, templateID *uuid.UUID) error
 	GetJob(ctx context.Context, jobID string) (*models.Job, error)
-	UpdateJobConfiguration(ctx context.Context, jobID string, keyColumns []string, columnsMetadata []*models.ColumnMetadata, keyColumnDescription *string) error
+	UpdateJobConfiguration(ctx context.Context, jobID string, keyColumns, contextColumns []string, columnsMetadata []*models.ColumnMetadata, keyColumnDescription, locale *string) error
+	SetJobKeyMapping(ctx context.Context, jobID string, mapping map[string]string) error
+	SetJobRevision(ctx context.Context, jobID string, sourceRevision int, baseJobID *string) error
 	StartJob(ctx context.Context, jobID string, totalRows int) error
 	GetJobsByUser(ctx context.Context, userID string, offset, limit int) ([]*models.Job, error)
 	BulkCreateRows(ctx context.Context, jobID string, rowKeys []string) error
+	SaveRowInputValues(ctx context.Context, jobID string, values map[string]map[string]string) error
+	GetRowInputValues(ctx context.Context, jobID, key string) (map[string]string, error)
 	CancelJob(ctx context.Context, jobID string) error
 
 	SaveRowState(ctx context.Context, jobID string, state *models.RowState) error
+	SaveRowStateWithEvent(ctx context.Context, jobID string, state *models.RowState) error
 	GetRowState(ctx context.Context, jobID string, key string) (*models.RowState, error)
 	GetRowsAtStage(ctx context.Context, jobID string, stage models.RowStage, offset, limit int) ([]*models.RowState, error)
+	GetRowsAtStageAfterKey(ctx context.Context, jobID string, stage models.RowStage, afterKey *string, limit int) ([]*models.RowState, error)
+	GetRowKeysAtStage(ctx context.Context, jobID string, stage models.RowStage) ([]string, error)
+	GetRowStates(ctx context.Context, jobID string, keys []string) (map[string]*models.RowState, error)
+	CopyCompletedRows(ctx context.Context, fromJobID, toJobID string, keys map[string]string) error
+	ResetRowsForRerun(ctx context.Context, jobID string, rowColumns map[string][]string, clearValues bool) error
+	SaveRowValues(ctx context.Context, jobID, key string, values map[string]interface{}, confidence map[string]*models.FieldConfidenceInfo) (*models.RowState, error)
+	CorrectCell(ctx context.Context, correction *models.CellCorrectionDB) (*models.RowState, error)
+	GetCellCorrections(ctx context.Context, jobID, rowKey string) ([]*models.CellCorrection, error)
 	GetRowsPaginated(ctx context.Context, jobID string, params RowsQueryParams) (*PaginatedRows, error)
+	GetJobPairKeys(ctx context.Context, baseJobID, jobID string, afterKey *string, limit int) ([]string, error)
+	GetRowsVersion(ctx context.Context, jobIDs []string) (RowsVersion, error)
+	GetRowEvents(ctx context.Context, jobID string, afterID int64, limit int) ([]*models.RowEvent, error)
+	DeleteRowEventsBefore(ctx context.Context, before time.Time) (int, error)
 
 	SetJobStatus(ctx context.Context, jobID string, status models.JobStatus) error
 	GetJobStatus(ctx context.Context, jobID string) (models.JobStatus, error)
 	GetJobProgress(ctx context.Context, jobID string) (*models.JobProgress, error)
 	IncrementJobCost(ctx context.Context, jobID string, costDollars, costCredits int) error
 
+	CreateExport(ctx context.Context, export *models.ExportDB) error
+	GetExport(ctx context.Context, exportID uuid.UUID) (*models.Export, error)
+	GetExportsByJob(ctx context.Context, jobID string) ([]*models.Export, error)
+	SetExportStatus(ctx context.Context, exportID uuid.UUID, status models.ExportStatus) error
+	FinishExport(ctx context.Context, exportID uuid.UUID, objectName string, rowCount int, exportErr *string) error
+
+	CreateWebhookEndpoint(ctx context.Context, endpoint *models.WebhookEndpointDB) error
+	GetWebhookEndpoint(ctx context.Context, endpointID uuid.UUID) (*models.WebhookEndpoint, error)
+	GetWebhookEndpointsByUser(ctx context.Context, userID string) ([]*models.WebhookEndpoint, error)
+	DeleteWebhookEndpoint(ctx context.Context, endpointID uuid.UUID) error
+	CreateWebhookDeliveries(ctx context.Context, deliveries []*models.WebhookDeliveryDB) ([]uuid.UUID, error)
+	GetWebhookDelivery(ctx context.Context, deliveryID uuid.UUID) (*models.WebhookDelivery, error)
+	GetWebhookDeliveries(ctx context.Context, endpointID uuid.UUID, jobID string, offset, limit int) ([]*models.WebhookDelivery, int, error)
+	RecordWebhookAttempt(ctx context.Context, deliveryID uuid.UUID, status models.WebhookDeliveryStatus, attempts int, responseStatus *int, deliveryErr *string) error
+
+	CreateAPIKey(ctx context.Context, key *models.APIKeyDB) error
+	GetAPIKey(ctx context.Context, keyID uuid.UUID) (*models.APIKey, error)
+	GetAPIKeyByHash(ctx context.Context, keyHash string) (*models.APIKey, error)
+	GetAPIKeysByUser(ctx context.Context, userID string) ([]*models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, keyID uuid.UUID, revokedAt time.Time) error
+	TouchAPIKey(ctx context.Context, keyID uuid.UUID, usedAt time.Time) error
+
 	Close() error
 }
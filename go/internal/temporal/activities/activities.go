@@ -2,13 +2,18 @@ package activities
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/blagoySimandov/ampledata/go/internal/logger"
 	"github.com/blagoySimandov/ampledata/go/internal/models"
 	"github.com/blagoySimandov/ampledata/go/internal/services"
 	"github.com/blagoySimandov/ampledata/go/internal/state"
+	"github.com/google/uuid"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
 )
 
 type webSearcher interface {
@@ -16,7 +21,7 @@ type webSearcher interface {
 }
 
 type decisionMaker interface {
-	MakeDecision(ctx context.Context, serp *models.GoogleSearchResults, rowKey string, maxURLs int, columnsMetadata []*models.ColumnMetadata, keyColumnDescription string, previousAttempts []*models.EnrichmentAttempt) (*services.CrawlDecision, error)
+	MakeDecision(ctx context.Context, serp *models.GoogleSearchResults, rowKey string, maxURLs int, columnsMetadata []*models.ColumnMetadata, keyColumnDescription, instructions string, rowContext map[string]string, previousAttempts []*models.EnrichmentAttempt) (*services.CrawlDecision, error)
 }
 
 type webCrawler interface {
@@ -24,18 +29,33 @@ type webCrawler interface {
 }
 
 type contentExtractor interface {
-	Extract(ctx context.Context, content string, entityKey string, columnsMetadata []*models.ColumnMetadata, keyColumnDescription string) (*services.ExtractionResult, error)
+	Extract(ctx context.Context, content string, entityKey string, columnsMetadata []*models.ColumnMetadata, keyColumnDescription, instructions string, rowContext map[string]string) (*services.ExtractionResult, error)
+	Classify(ctx context.Context, entityKey string, rowValues map[string]string, columnsMetadata []*models.ColumnMetadata, keyColumnDescription, instructions string) (*services.ExtractionResult, error)
 }
 
 type patternGenerator interface {
-	GeneratePatterns(ctx context.Context, columnsMetadata []*models.ColumnMetadata) ([]string, error)
-	GeneratePatternsWithFeedback(ctx context.Context, columnsMetadata []*models.ColumnMetadata, previousAttempts []*models.EnrichmentAttempt) ([]string, error)
+	GeneratePatterns(ctx context.Context, columnsMetadata []*models.ColumnMetadata, contextColumns []string) ([]string, error)
+	GeneratePatternsWithFeedback(ctx context.Context, columnsMetadata []*models.ColumnMetadata, contextColumns []string, previousAttempts []*models.EnrichmentAttempt) ([]string, error)
 }
 
 type billingService interface {
 	ReportUsage(ctx context.Context, stripeCustomerID string, credits int) error
 }
 
+type resultWriter interface {
+	WriteBackResults(ctx context.Context, jobID string) (int, error)
+}
+
+type exportBuilder interface {
+	BuildExport(ctx context.Context, exportID uuid.UUID) (int, error)
+}
+
+type webhookDispatcher interface {
+	Subscriptions(ctx context.Context, userID string) ([]models.WebhookEventType, error)
+	CreateDeliveries(ctx context.Context, userID string, event *models.WebhookEvent) ([]uuid.UUID, error)
+	Deliver(ctx context.Context, deliveryID uuid.UUID, attempt int, lastAttempt bool) error
+}
+
 type Activities struct {
 	stateManager     *state.StateManager
 	webSearcher      webSearcher
@@ -44,6 +64,11 @@ type Activities struct {
 	contentExtractor contentExtractor
 	patternGenerator patternGenerator
 	billingService   billingService
+	resultWriter     resultWriter
+	exportBuilder    exportBuilder
+	webhooks         webhookDispatcher
+	// classifyCellsPerCredit is how many classify cells one credit buys.
+	classifyCellsPerCredit int
 }
 
 func NewActivities(
@@ -54,6 +79,10 @@ func NewActivities(
 	contentExtractor contentExtractor,
 	patternGenerator patternGenerator,
 	billingService billingService,
+	resultWriter resultWriter,
+	exportBuilder exportBuilder,
+	webhooks webhookDispatcher,
+	classifyCellsPerCredit int,
 ) *Activities {
 	return &Activities{
 		stateManager:     stateManager,
@@ -63,17 +92,23 @@ func NewActivities(
 		contentExtractor: contentExtractor,
 		patternGenerator: patternGenerator,
 		billingService:   billingService,
+		resultWriter:     resultWriter,
+		exportBuilder:    exportBuilder,
+		webhooks:         webhooks,
+		classifyCellsPerCredit: classifyCellsPerCredit,
 	}
 }
 
 type GeneratePatternsInput struct {
 	JobID           string
 	ColumnsMetadata []*models.ColumnMetadata
+	ContextColumns  []string
 }
 
 type GeneratePatternsWithFeedbackInput struct {
 	JobID            string
 	ColumnsMetadata  []*models.ColumnMetadata
+	ContextColumns   []string
 	PreviousAttempts []*models.EnrichmentAttempt
 }
 
@@ -84,6 +119,7 @@ type GeneratePatternsOutput struct {
 type SerpFetchInput struct {
 	JobID           string
 	RowKey          string
+	RowContext      map[string]string
 	ColumnsMetadata []*models.ColumnMetadata
 	QueryPatterns   []string
 }
@@ -98,6 +134,8 @@ type DecisionInput struct {
 	SerpData         *models.SerpData
 	ColumnsMetadata  []*models.ColumnMetadata
 	KeyColumnDescription      string
+	Instructions     string
+	RowContext       map[string]string
 	PreviousAttempts []*models.EnrichmentAttempt
 }
 
@@ -120,10 +158,16 @@ type CrawlOutput struct {
 type ExtractInput struct {
 	JobID           string
 	RowKey          string
+	// SerpSnippets are the organic search results, kept to what citations
+	// and relative dates are checked against.
+	SerpSnippets    []models.SerpSnippet
 	Decision        *models.Decision
 	CrawlResults    *models.CrawlResults
 	ColumnsMetadata []*models.ColumnMetadata
 	KeyColumnDescription      string
+	Instructions    string
+	RowContext      map[string]string
+	Locale          string
 }
 
 type ExtractOutput struct {
@@ -132,6 +176,21 @@ type ExtractOutput struct {
 	Reasoning     string
 }
 
+type ClassifyInput struct {
+	JobID                string
+	RowKey               string
+	RowValues            map[string]string
+	ColumnsMetadata      []*models.ColumnMetadata
+	KeyColumnDescription string
+	Instructions         string
+	Locale               string
+}
+
+type RowInputValuesInput struct {
+	JobID  string
+	RowKey string
+}
+
 type StateUpdateInput struct {
 	JobID  string
 	RowKey string
@@ -147,6 +206,20 @@ type FeedbackAnalysisInput struct {
 	ColumnsMetadata []*models.ColumnMetadata
 }
 
+type ComputeDerivedColumnsInput struct {
+	JobID          string
+	RowKey         string
+	ExtractedData  map[string]interface{}
+	Confidence     map[string]*models.FieldConfidenceInfo
+	DerivedColumns []*models.ColumnMetadata
+	Locale         string
+}
+
+type ComputeDerivedColumnsOutput struct {
+	ExtractedData map[string]interface{}
+	Confidence    map[string]*models.FieldConfidenceInfo
+}
+
 type FeedbackAnalysisOutput struct {
 	NeedsFeedback        bool
 	LowConfidenceColumns []string
@@ -158,7 +231,7 @@ func (a *Activities) GeneratePatterns(ctx context.Context, input GeneratePattern
 	ctx = services.ContextWithJobID(ctx, input.JobID)
 	event := logger.NewActivityEvent("generate_patterns", input.JobID)
 
-	patterns, err := a.patternGenerator.GeneratePatterns(ctx, input.ColumnsMetadata)
+	patterns, err := a.patternGenerator.GeneratePatterns(ctx, input.ColumnsMetadata, input.ContextColumns)
 	if err != nil {
 		logger.Log.Warn("pattern generation failed, using fallback", "error", err, "job_id", input.JobID)
 		patterns = []string{"%entity"}
@@ -179,7 +252,7 @@ func (a *Activities) GeneratePatternsWithFeedback(ctx context.Context, input Gen
 	event := logger.NewActivityEvent("generate_patterns_with_feedback", input.JobID)
 	event.SetMetadata("attempt_count", len(input.PreviousAttempts))
 
-	patterns, err := a.patternGenerator.GeneratePatternsWithFeedback(ctx, input.ColumnsMetadata, input.PreviousAttempts)
+	patterns, err := a.patternGenerator.GeneratePatternsWithFeedback(ctx, input.ColumnsMetadata, input.ContextColumns, input.PreviousAttempts)
 	if err != nil {
 		logger.Log.Warn("pattern generation with feedback failed, using fallback", "error", err, "job_id", input.JobID)
 		patterns = []string{"%entity"}
@@ -200,7 +273,7 @@ func (a *Activities) SerpFetch(ctx context.Context, input SerpFetchInput) (*Serp
 	event.RowKey = input.RowKey
 
 	queryBuilder := services.NewPatternQueryBuilder(input.QueryPatterns, input.ColumnsMetadata)
-	queries := queryBuilder.Build(input.RowKey)
+	queries := queryBuilder.Build(input.RowKey, input.RowContext)
 	event.SetMetadata("query_count", len(queries))
 
 	allResults := []*models.GoogleSearchResults{}
@@ -258,7 +331,7 @@ func (a *Activities) MakeDecision(ctx context.Context, input DecisionInput) (*De
 	}
 
 	mergedResults := mergeSerpResults(input.SerpData.Results)
-	crawlDecision, err := a.decisionMaker.MakeDecision(ctx, mergedResults, input.RowKey, 3, input.ColumnsMetadata, input.KeyColumnDescription, input.PreviousAttempts)
+	crawlDecision, err := a.decisionMaker.MakeDecision(ctx, mergedResults, input.RowKey, 3, input.ColumnsMetadata, input.KeyColumnDescription, input.Instructions, input.RowContext, input.PreviousAttempts)
 	if err != nil {
 		event.EmitActivityError(ctx, fmt.Errorf("decision making failed: %w", err))
 		return nil, fmt.Errorf("decision making failed: %w", err)
@@ -346,8 +419,8 @@ func filterMissingColumnsMetadata(missingColumns []string, allColumns []*models.
 	return result
 }
 
-func (a *Activities) extractFromContent(ctx context.Context, content, rowKey string, metadata []*models.ColumnMetadata, entityType string) (map[string]interface{}, map[string]*models.FieldConfidenceInfo, string, error) {
-	result, err := a.contentExtractor.Extract(ctx, content, rowKey, metadata, entityType)
+func (a *Activities) extractFromContent(ctx context.Context, content, rowKey string, metadata []*models.ColumnMetadata, entityType, instructions string, rowContext map[string]string) (map[string]interface{}, map[string]*models.FieldConfidenceInfo, string, error) {
+	result, err := a.contentExtractor.Extract(ctx, content, rowKey, metadata, entityType, instructions, rowContext)
 	if err != nil {
 		return nil, nil, "", fmt.Errorf("content extraction failed: %w", err)
 	}
@@ -388,6 +461,56 @@ func mergeDecisionData(extractedData map[string]interface{}, confidence map[stri
 	}
 }
 
+func extractionSources(decision *models.Decision, crawlResults *models.CrawlResults) []string {
+	var sources []string
+	if decision != nil {
+		sources = append(sources, decision.SourceURLs...)
+	}
+	if crawlResults != nil {
+		sources = append(sources, crawlResults.Sources...)
+	}
+	return sources
+}
+
+// serpReferenceDate returns the publication date used to resolve relative
+// dates ("3 days ago"): the newest dated SERP result among the row's sources,
+// otherwise the newest dated result overall, otherwise now.
+func serpReferenceDate(snippets []models.SerpSnippet, sources []string, now time.Time) time.Time {
+	sourceSet := make(map[string]struct{}, len(sources))
+	for _, src := range sources {
+		sourceSet[src] = struct{}{}
+	}
+
+	var newest, newestFromSources time.Time
+	for _, snippet := range snippets {
+		if snippet.Date == "" {
+			continue
+		}
+		parsed, err := services.ParseDate(snippet.Date, services.DefaultLocale, now)
+		if err != nil || parsed.Precision != models.DatePrecisionDay {
+			continue
+		}
+		if parsed.Date.After(newest) {
+			newest = parsed.Date
+		}
+		if snippet.Link == "" {
+			continue
+		}
+		if _, ok := sourceSet[snippet.Link]; ok && parsed.Date.After(newestFromSources) {
+			newestFromSources = parsed.Date
+		}
+	}
+
+	switch {
+	case !newestFromSources.IsZero():
+		return newestFromSources
+	case !newest.IsZero():
+		return newest
+	default:
+		return now
+	}
+}
+
 func (a *Activities) Extract(ctx context.Context, input ExtractInput) (*ExtractOutput, error) {
 	ctx = services.ContextWithJobID(ctx, input.JobID)
 	event := logger.NewActivityEvent("extract", input.JobID)
@@ -402,7 +525,7 @@ func (a *Activities) Extract(ctx context.Context, input ExtractInput) (*ExtractO
 
 		if len(missingColsMetadata) > 0 {
 			var err error
-			extractedData, confidence, reasoning, err = a.extractFromContent(ctx, *input.CrawlResults.Content, input.RowKey, missingColsMetadata, input.KeyColumnDescription)
+			extractedData, confidence, reasoning, err = a.extractFromContent(ctx, *input.CrawlResults.Content, input.RowKey, missingColsMetadata, input.KeyColumnDescription, input.Instructions, input.RowContext)
 			if err != nil {
 				event.EmitActivityError(ctx, err)
 				return nil, err
@@ -418,6 +541,20 @@ func (a *Activities) Extract(ctx context.Context, input ExtractInput) (*ExtractO
 
 	mergeDecisionData(extractedData, confidence, input.Decision)
 
+	// Quotes are only trusted once found in the pages the row was
+	// extracted from: the crawled pages or the search result snippets.
+	var pages []services.CrawledPage
+	if input.CrawlResults != nil && input.CrawlResults.Content != nil {
+		pages = services.ParseCrawledPages(*input.CrawlResults.Content)
+	}
+	pages = append(pages, services.SerpPages(input.SerpSnippets)...)
+	services.VerifyCitations(confidence, pages)
+
+	extractedData = services.ValidateAndCoerceTypes(extractedData, input.ColumnsMetadata, confidence, services.CoercionOptions{
+		Locale:        services.ResolveLocale(input.Locale),
+		ReferenceDate: serpReferenceDate(input.SerpSnippets, extractionSources(input.Decision, input.CrawlResults), time.Now()),
+	})
+
 	// Calculate average confidence
 	var totalConfidence float64
 	for _, conf := range confidence {
@@ -450,11 +587,115 @@ func (a *Activities) Extract(ctx context.Context, input ExtractInput) (*ExtractO
 	}, nil
 }
 
+// Classify answers classify columns from the row's own input values, without
+// searching or crawling.
+func (a *Activities) Classify(ctx context.Context, input ClassifyInput) (*ExtractOutput, error) {
+	ctx = services.ContextWithJobID(ctx, input.JobID)
+	event := logger.NewActivityEvent("classify", input.JobID)
+	event.RowKey = input.RowKey
+
+	result, err := a.contentExtractor.Classify(ctx, input.RowKey, input.RowValues, input.ColumnsMetadata, input.KeyColumnDescription, input.Instructions)
+	if err != nil {
+		err = fmt.Errorf("classification failed: %w", err)
+		event.EmitActivityError(ctx, err)
+		return nil, err
+	}
+
+	confidence := result.Confidence
+	if confidence == nil {
+		confidence = make(map[string]*models.FieldConfidenceInfo)
+	}
+	extractedData := services.ValidateAndCoerceTypes(result.ExtractedData, input.ColumnsMetadata, confidence, services.CoercionOptions{
+		Locale: services.ResolveLocale(input.Locale),
+	})
+
+	event.EmitActivitySuccess(ctx, map[string]interface{}{
+		"fields_extracted": len(extractedData),
+	})
+
+	output := &ExtractOutput{Reasoning: result.Reasoning}
+	if len(extractedData) > 0 {
+		output.ExtractedData = extractedData
+	}
+	if len(confidence) > 0 {
+		output.Confidence = confidence
+	}
+	return output, nil
+}
+
+func (a *Activities) ComputeDerivedColumns(ctx context.Context, input ComputeDerivedColumnsInput) (*ComputeDerivedColumnsOutput, error) {
+	event := logger.NewActivityEvent("compute_derived_columns", input.JobID)
+	event.RowKey = input.RowKey
+
+	// The stored row is authoritative: it holds reviewers' corrections and,
+	// after a re-run, the values of the columns that were not re-run.
+	// Lookups run without a job, so they have no stored row.
+	stored := input.JobID != ""
+	extracted, current := input.ExtractedData, input.Confidence
+	var sourceValues map[string]string
+	if stored {
+		row, err := a.stateManager.Store().GetRowState(ctx, input.JobID, input.RowKey)
+		if err != nil {
+			event.EmitActivityError(ctx, err)
+			return nil, fmt.Errorf("failed to load row: %w", err)
+		}
+		if row != nil {
+			extracted, current = row.ExtractedData, row.Confidence
+		}
+		// Source cells the expressions read are stored with the row, like
+		// classify inputs and context columns.
+		sourceValues, err = a.stateManager.Store().GetRowInputValues(ctx, input.JobID, input.RowKey)
+		if err != nil {
+			event.EmitActivityError(ctx, err)
+			return nil, fmt.Errorf("failed to load row values: %w", err)
+		}
+	}
+
+	values, confidence := services.ComputeDerivedColumns(extracted, sourceValues, current, input.DerivedColumns, services.CoercionOptions{
+		Locale: services.ResolveLocale(input.Locale),
+	})
+
+	// The row has already completed, so its values are saved without
+	// moving it to another stage.
+	if stored {
+		if err := a.stateManager.SaveValues(ctx, input.JobID, input.RowKey, values, confidence); err != nil {
+			event.EmitActivityError(ctx, err)
+			return nil, fmt.Errorf("failed to save derived columns: %w", err)
+		}
+	}
+
+	computed := 0
+	for _, v := range values {
+		if v != nil {
+			computed++
+		}
+	}
+	event.EmitActivitySuccess(ctx, map[string]interface{}{
+		"derived_columns": len(input.DerivedColumns),
+		"computed":        computed,
+	})
+
+	return &ComputeDerivedColumnsOutput{
+		ExtractedData: values,
+		Confidence:    confidence,
+	}, nil
+}
+
+// CheckCancelled reports whether the job has stopped. Lookups, which run
+// without a job, are never cancelled this way.
 func (a *Activities) CheckCancelled(ctx context.Context, jobID string) (bool, error) {
+	if jobID == "" {
+		return false, nil
+	}
 	return a.stateManager.CheckCancelled(ctx, jobID)
 }
 
+// UpdateState moves a row to the next stage. Lookups have no stored row, so
+// their updates are dropped.
 func (a *Activities) UpdateState(ctx context.Context, input StateUpdateInput) error {
+	if input.JobID == "" {
+		return nil
+	}
 	err := a.stateManager.Transition(ctx, input.JobID, input.RowKey, input.Stage, input.Data)
 	if err != nil {
 		return fmt.Errorf("state transition failed: %w", err)
@@ -463,6 +704,12 @@ func (a *Activities) UpdateState(ctx context.Context, input StateUpdateInput) er
 	return nil
 }
 
+// GetRowInputValues loads the source cells stored for a row when its job
+// started.
+func (a *Activities) GetRowInputValues(ctx context.Context, input RowInputValuesInput) (map[string]string, error) {
+	return a.stateManager.Store().GetRowInputValues(ctx, input.JobID, input.RowKey)
+}
+
 func (a *Activities) AnalyzeFeedback(ctx context.Context, input FeedbackAnalysisInput) (*FeedbackAnalysisOutput, error) {
 	output := &FeedbackAnalysisOutput{
 		NeedsFeedback:        false,
@@ -518,6 +765,30 @@ func (a *Activities) InitializeJob(ctx context.Context, jobID string, rowKeys []
 	return nil
 }
 
+type PrepareRerunInput struct {
+	JobID      string
+	RowColumns map[string][]string
+	Merge      models.MergeMode
+}
+
+// PrepareRerun resumes a finished job and resets the rows about to be
+// re-run. Forced replacement drops the re-run columns' values first, so
+// values the re-run does not find are left empty.
+func (a *Activities) PrepareRerun(ctx context.Context, input PrepareRerunInput) error {
+	event := logger.NewActivityEvent("prepare_rerun", input.JobID)
+	event.SetMetadata("row_count", len(input.RowColumns))
+
+	err := a.stateManager.PrepareRerun(ctx, input.JobID, input.RowColumns, input.Merge == models.MergeReplace)
+	if err != nil {
+		event.EmitActivityError(ctx, fmt.Errorf("rerun preparation failed: %w", err))
+		return fmt.Errorf("rerun preparation failed: %w", err)
+	}
+
+	event.EmitActivitySuccess(ctx, nil)
+
+	return nil
+}
+
 func (a *Activities) CompleteJob(ctx context.Context, jobID string) error {
 	event := logger.NewActivityEvent("complete_job", jobID)
 
@@ -532,13 +803,36 @@ func (a *Activities) CompleteJob(ctx context.Context, jobID string) error {
 	return nil
 }
 
+type SetJobStatusInput struct {
+	JobID  string
+	Status models.JobStatus
+}
+
+// SetJobStatus puts a job back into the status it had before a re-run.
+func (a *Activities) SetJobStatus(ctx context.Context, input SetJobStatusInput) error {
+	event := logger.NewActivityEvent("set_job_status", input.JobID)
+	event.SetMetadata("status", string(input.Status))
+
+	if err := a.stateManager.Store().SetJobStatus(ctx, input.JobID, input.Status); err != nil {
+		event.EmitActivityError(ctx, fmt.Errorf("job status update failed: %w", err))
+		return fmt.Errorf("job status update failed: %w", err)
+	}
+
+	event.EmitActivitySuccess(ctx, nil)
+
+	return nil
+}
+
 type ReportUsageInput struct {
 	StripeCustomerID string
 	Credits          int
+	// ClassifiedCells are billed on top of Credits at the classify rate.
+	ClassifiedCells int
 }
 
 func (a *Activities) ReportUsage(ctx context.Context, input ReportUsageInput) error {
-	return a.billingService.ReportUsage(ctx, input.StripeCustomerID, input.Credits)
+	credits := input.Credits + models.ClassifyCredits(input.ClassifiedCells, a.classifyCellsPerCredit)
+	return a.billingService.ReportUsage(ctx, input.StripeCustomerID, credits)
 }
 
 type IncrementJobCreditsInput struct {
@@ -549,3 +843,100 @@ type IncrementJobCreditsInput struct {
 func (a *Activities) IncrementJobCredits(ctx context.Context, input IncrementJobCreditsInput) error {
 	return a.stateManager.Store().IncrementJobCost(ctx, input.JobID, 0, input.Credits)
 }
+
+// WriteBackResults upserts a finished job's results into the write-back
+// table of its source, if the source has one.
+func (a *Activities) WriteBackResults(ctx context.Context, jobID string) (int, error) {
+	event := logger.NewActivityEvent("write_back_results", jobID)
+
+	written, err := a.resultWriter.WriteBackResults(ctx, jobID)
+	if err != nil {
+		event.EmitActivityError(ctx, fmt.Errorf("write back failed: %w", err))
+		return 0, fmt.Errorf("write back failed: %w", err)
+	}
+
+	event.EmitActivitySuccess(ctx, map[string]interface{}{
+		"rows_written": written,
+	})
+
+	return written, nil
+}
+
+// BuildExport writes the file of an export of a job's results.
+func (a *Activities) BuildExport(ctx context.Context, exportID string) (int, error) {
+	id, err := uuid.Parse(exportID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid export ID %q: %w", exportID, err)
+	}
+	event := logger.NewActivityEvent("build_export", exportID)
+
+	rows, err := a.exportBuilder.BuildExport(ctx, id)
+	if err != nil {
+		event.EmitActivityError(ctx, fmt.Errorf("export failed: %w", err))
+		return 0, fmt.Errorf("export failed: %w", err)
+	}
+
+	event.EmitActivitySuccess(ctx, map[string]interface{}{
+		"rows_exported": rows,
+	})
+
+	return rows, nil
+}
+
+// WebhookDeliveryAttempts is how many times a webhook delivery is tried
+// before it is logged as failed.
+const WebhookDeliveryAttempts = 8
+
+type CreateWebhookDeliveriesInput struct {
+	UserID string
+	Event  *models.WebhookEvent
+}
+
+// CreateWebhookDeliveries records a delivery of a job event for each of the
+// user's webhook endpoints subscribed to it.
+func (a *Activities) CreateWebhookDeliveries(ctx context.Context, input CreateWebhookDeliveriesInput) ([]string, error) {
+	event := logger.NewActivityEvent("create_webhook_deliveries", input.Event.JobID)
+
+	ids, err := a.webhooks.CreateDeliveries(ctx, input.UserID, input.Event)
+	if err != nil {
+		event.EmitActivityError(ctx, fmt.Errorf("webhook deliveries failed: %w", err))
+		return nil, fmt.Errorf("webhook deliveries failed: %w", err)
+	}
+
+	deliveryIDs := make([]string, len(ids))
+	for i, id := range ids {
+		deliveryIDs[i] = id.String()
+	}
+	event.EmitActivitySuccess(ctx, map[string]interface{}{
+		"event":      string(input.Event.Type),
+		"deliveries": len(deliveryIDs),
+	})
+
+	return deliveryIDs, nil
+}
+
+// GetWebhookSubscriptions lists the event types the user's webhook endpoints
+// subscribe to, so workflows only start deliveries someone receives.
+func (a *Activities) GetWebhookSubscriptions(ctx context.Context, userID string) ([]models.WebhookEventType, error) {
+	events, err := a.webhooks.Subscriptions(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook subscriptions: %w", err)
+	}
+	return events, nil
+}
+
+// DeliverWebhook sends a webhook delivery. Endpoints that reject it for
+// good are not retried.
+func (a *Activities) DeliverWebhook(ctx context.Context, deliveryID string) error {
+	id, err := uuid.Parse(deliveryID)
+	if err != nil {
+		return temporal.NewNonRetryableApplicationError(fmt.Sprintf("invalid delivery ID %q", deliveryID), "InvalidDeliveryID", err)
+	}
+	attempt := int(activity.GetInfo(ctx).Attempt)
+
+	err = a.webhooks.Deliver(ctx, id, attempt, attempt >= WebhookDeliveryAttempts)
+	if errors.Is(err, services.ErrWebhookRejected) {
+		return temporal.NewNonRetryableApplicationError(err.Error(), "WebhookRejected", err)
+	}
+	return err
+}
@@ -19,6 +19,10 @@ func NewWorker(temporalClient client.Client, taskQueue string, activities *activ
 	// Register workflows
 	w.RegisterWorkflow(workflows.JobWorkflow)
 	w.RegisterWorkflow(workflows.EnrichmentWorkflow)
+	w.RegisterWorkflow(workflows.ExportWorkflow)
+	w.RegisterWorkflow(workflows.RerunWorkflow)
+	w.RegisterWorkflow(workflows.WebhookWorkflow)
+	w.RegisterWorkflow(workflows.LookupWorkflow)
 
 	// Register activities
 	// We use string names for activities to make them more discoverable and maintainable
@@ -40,9 +44,15 @@ func NewWorker(temporalClient client.Client, taskQueue string, activities *activ
 	w.RegisterActivityWithOptions(activities.Extract, activity.RegisterOptions{
 		Name: "Extract",
 	})
+	w.RegisterActivityWithOptions(activities.Classify, activity.RegisterOptions{
+		Name: "Classify",
+	})
 	w.RegisterActivityWithOptions(activities.UpdateState, activity.RegisterOptions{
 		Name: "UpdateState",
 	})
+	w.RegisterActivityWithOptions(activities.GetRowInputValues, activity.RegisterOptions{
+		Name: "GetRowInputValues",
+	})
 	w.RegisterActivityWithOptions(activities.AnalyzeFeedback, activity.RegisterOptions{
 		Name: "AnalyzeFeedback",
 	})
@@ -52,6 +62,12 @@ func NewWorker(temporalClient client.Client, taskQueue string, activities *activ
 	w.RegisterActivityWithOptions(activities.CompleteJob, activity.RegisterOptions{
 		Name: "CompleteJob",
 	})
+	w.RegisterActivityWithOptions(activities.PrepareRerun, activity.RegisterOptions{
+		Name: "PrepareRerun",
+	})
+	w.RegisterActivityWithOptions(activities.SetJobStatus, activity.RegisterOptions{
+		Name: "SetJobStatus",
+	})
 	w.RegisterActivityWithOptions(activities.ReportUsage, activity.RegisterOptions{
 		Name: "ReportUsage",
 	})
@@ -61,6 +77,24 @@ func NewWorker(temporalClient client.Client, taskQueue string, activities *activ
 	w.RegisterActivityWithOptions(activities.CheckCancelled, activity.RegisterOptions{
 		Name: "CheckCancelled",
 	})
+	w.RegisterActivityWithOptions(activities.ComputeDerivedColumns, activity.RegisterOptions{
+		Name: "ComputeDerivedColumns",
+	})
+	w.RegisterActivityWithOptions(activities.WriteBackResults, activity.RegisterOptions{
+		Name: "WriteBackResults",
+	})
+	w.RegisterActivityWithOptions(activities.BuildExport, activity.RegisterOptions{
+		Name: "BuildExport",
+	})
+	w.RegisterActivityWithOptions(activities.GetWebhookSubscriptions, activity.RegisterOptions{
+		Name: "GetWebhookSubscriptions",
+	})
+	w.RegisterActivityWithOptions(activities.CreateWebhookDeliveries, activity.RegisterOptions{
+		Name: "CreateWebhookDeliveries",
+	})
+	w.RegisterActivityWithOptions(activities.DeliverWebhook, activity.RegisterOptions{
+		Name: "DeliverWebhook",
+	})
 
 	return &Worker{
 		temporalWorker: w,
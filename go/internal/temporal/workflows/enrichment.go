@@ -2,6 +2,7 @@ package workflows
 
 import (
 	"fmt"
+	"sort"
 	"time"
 
 	"go.temporal.io/sdk/temporal"
@@ -18,11 +19,26 @@ type EnrichmentWorkflowInput struct {
 	StripeCustomerID string
 	RowKey           string
 	ColumnsMetadata  []*models.ColumnMetadata
+	ClassifyColumns  []*models.ColumnMetadata
+	DerivedColumns   []*models.ColumnMetadata
+	// RowValues and RowContext are loaded from the row's stored input values
+	// when they are not given and the row has classify or context columns.
+	RowValues        map[string]string
+	RowContext       map[string]string
+	ContextColumns   []string
 	QueryPatterns    []string
 	KeyColumnDescription       string
+	Locale           string
 	RetryCount       int
 	PreviousAttempts []*models.EnrichmentAttempt
 	MaxRetries       int
+	// Instructions are added to the prompts of a re-run, and Merge says how
+	// its values are combined with the row's stored ones.
+	Instructions     string
+	Merge            models.MergeMode
+	// SkipUsage leaves reporting the row's usage to the caller, which does
+	// so once the result has been delivered.
+	SkipUsage        bool
 }
 
 type EnrichmentWorkflowOutput struct {
@@ -35,6 +51,7 @@ type EnrichmentWorkflowOutput struct {
 	Cancelled         bool
 	Error             string
 	IterationCount    int
+	ClassifiedCells   int
 }
 
 func mergeSources(serpSources, crawlSources []string) []string {
@@ -55,40 +72,11 @@ func mergeSources(serpSources, crawlSources []string) []string {
 	return result
 }
 
-// mergeBestConfidence merges retry results into the base, keeping whichever
-// value has the higher confidence score for each field. Fields that only exist
-// in one side are always included.
-func mergeBestConfidence(
-	base map[string]interface{},
-	baseConf map[string]*models.FieldConfidenceInfo,
-	retry map[string]interface{},
-	retryConf map[string]*models.FieldConfidenceInfo,
-) (map[string]interface{}, map[string]*models.FieldConfidenceInfo) {
-	if base == nil {
-		base = make(map[string]interface{})
-	}
-	if baseConf == nil {
-		baseConf = make(map[string]*models.FieldConfidenceInfo)
-	}
-	for k, v := range retry {
-		current := baseConf[k]
-		candidate := retryConf[k]
-		if current == nil || (candidate != nil && candidate.Score > current.Score) {
-			base[k] = v
-			if candidate != nil {
-				baseConf[k] = candidate
-			}
-		}
-	}
-	return base, baseConf
-}
-
+// EnrichmentWorkflow enriches one row, then computes its derived columns.
+// Derived columns are computed for failed rows too, from whatever values the
+// row has, so a row that only failed its search still gets the derived
+// columns of its classified values.
 func EnrichmentWorkflow(ctx workflow.Context, input EnrichmentWorkflowInput) (*EnrichmentWorkflowOutput, error) {
-	info := workflow.GetInfo(ctx)
-	event := logger.NewEnrichmentEvent(input.JobID, input.RowKey, "")
-	event.SetWorkflowInfo(info.WorkflowExecution.ID, info.WorkflowExecution.RunID)
-	event.SetMetadata("retry_count", input.RetryCount)
-
 	activityOptions := workflow.ActivityOptions{
 		StartToCloseTimeout: 2 * time.Minute,
 		RetryPolicy: &temporal.RetryPolicy{
@@ -100,6 +88,34 @@ func EnrichmentWorkflow(ctx workflow.Context, input EnrichmentWorkflowInput) (*E
 	}
 	ctx = workflow.WithActivityOptions(ctx, activityOptions)
 
+	if input.RowValues == nil && input.JobID != "" && (len(input.ClassifyColumns) > 0 || len(input.ContextColumns) > 0) {
+		var values map[string]string
+		err := workflow.ExecuteActivity(ctx, "GetRowInputValues", activities.RowInputValuesInput{
+			JobID:  input.JobID,
+			RowKey: input.RowKey,
+		}).Get(ctx, &values)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load row values: %w", err)
+		}
+		input.RowValues = values
+		input.RowContext = rowContext(values, input.ContextColumns)
+	}
+
+	output, err := enrichRow(ctx, input)
+	if err != nil || !output.Success {
+		return output, err
+	}
+	computeDerivedColumns(ctx, input, output)
+	return output, nil
+}
+
+// enrichRow runs the search pipeline for a row, retrying with feedback.
+func enrichRow(ctx workflow.Context, input EnrichmentWorkflowInput) (*EnrichmentWorkflowOutput, error) {
+	info := workflow.GetInfo(ctx)
+	event := logger.NewEnrichmentEvent(input.JobID, input.RowKey, "")
+	event.SetWorkflowInfo(info.WorkflowExecution.ID, info.WorkflowExecution.RunID)
+	event.SetMetadata("retry_count", input.RetryCount)
+
 	output := &EnrichmentWorkflowOutput{
 		RowKey:         input.RowKey,
 		Success:        false,
@@ -115,6 +131,26 @@ func EnrichmentWorkflow(ctx workflow.Context, input EnrichmentWorkflowInput) (*E
 		return output, nil
 	}
 
+	// Classify columns only need the row's own values, so they are answered
+	// before the search pipeline. Rows with nothing left to search finish here.
+	if len(input.ClassifyColumns) > 0 {
+		if !classifyRow(ctx, input, output, event) {
+			return output, nil
+		}
+		if len(input.ColumnsMetadata) == 0 {
+			workflow.ExecuteActivity(ctx, "UpdateState", activities.StateUpdateInput{
+				JobID:  input.JobID,
+				RowKey: input.RowKey,
+				Stage:  models.StageCompleted,
+				Data:   nil,
+			}).Get(ctx, nil)
+
+			output.Success = true
+			event.EmitSuccess(ctx)
+			return output, nil
+		}
+	}
+
 	// Generate patterns if this is a retry with feedback
 	queryPatterns := input.QueryPatterns
 	if input.RetryCount > 0 && len(input.PreviousAttempts) > 0 {
@@ -123,6 +159,7 @@ func EnrichmentWorkflow(ctx workflow.Context, input EnrichmentWorkflowInput) (*E
 		err := workflow.ExecuteActivity(ctx, "GeneratePatternsWithFeedback", activities.GeneratePatternsWithFeedbackInput{
 			JobID:            input.JobID,
 			ColumnsMetadata:  input.ColumnsMetadata,
+			ContextColumns:   contextColumnNames(input.RowContext),
 			PreviousAttempts: input.PreviousAttempts,
 		}).Get(ctx, &patternsOutput)
 		if err != nil {
@@ -141,6 +178,7 @@ func EnrichmentWorkflow(ctx workflow.Context, input EnrichmentWorkflowInput) (*E
 	err := workflow.ExecuteActivity(ctx, "SerpFetch", activities.SerpFetchInput{
 		JobID:           input.JobID,
 		RowKey:          input.RowKey,
+		RowContext:      input.RowContext,
 		ColumnsMetadata: input.ColumnsMetadata,
 		QueryPatterns:   queryPatterns,
 	}).Get(ctx, &serpOutput)
@@ -179,6 +217,8 @@ func EnrichmentWorkflow(ctx workflow.Context, input EnrichmentWorkflowInput) (*E
 		SerpData:         serpOutput.SerpData,
 		ColumnsMetadata:  input.ColumnsMetadata,
 		KeyColumnDescription:      input.KeyColumnDescription,
+		Instructions:     input.Instructions,
+		RowContext:       input.RowContext,
 		PreviousAttempts: input.PreviousAttempts,
 	}).Get(ctx, &decisionOutput)
 	if err != nil {
@@ -247,10 +287,14 @@ func EnrichmentWorkflow(ctx workflow.Context, input EnrichmentWorkflowInput) (*E
 	err = workflow.ExecuteActivity(ctx, "Extract", activities.ExtractInput{
 		JobID:           input.JobID,
 		RowKey:          input.RowKey,
+		SerpSnippets:    serpOutput.SerpData.Snippets(),
 		Decision:        decisionOutput.Decision,
 		CrawlResults:    crawlOutput.CrawlResults,
 		ColumnsMetadata: input.ColumnsMetadata,
 		KeyColumnDescription:      input.KeyColumnDescription,
+		Instructions:    input.Instructions,
+		RowContext:      input.RowContext,
+		Locale:          input.Locale,
 	}).Get(ctx, &extractOutput)
 	if err != nil {
 		output.Error = fmt.Sprintf("Extraction failed: %v", err)
@@ -278,7 +322,7 @@ func EnrichmentWorkflow(ctx workflow.Context, input EnrichmentWorkflowInput) (*E
 		"confidence":       extractOutput.Confidence,
 	})
 
-	enrichedData := models.StateUpdate{}
+	enrichedData := models.StateUpdate{Merge: input.Merge}
 	if extractOutput.ExtractedData != nil {
 		enrichedData.ExtractedData = extractOutput.ExtractedData
 	}
@@ -307,8 +351,10 @@ func EnrichmentWorkflow(ctx workflow.Context, input EnrichmentWorkflowInput) (*E
 		Data:   &enrichedData,
 	}).Get(ctx, nil)
 
-	output.ExtractedData = extractOutput.ExtractedData
-	output.Confidence = extractOutput.Confidence
+	output.ExtractedData, output.Confidence = models.MergeBestConfidence(
+		output.ExtractedData, output.Confidence,
+		extractOutput.ExtractedData, extractOutput.Confidence,
+	)
 	output.Sources = allSources
 	output.ExtractionHistory = []*models.ExtractionHistoryEntry{historyEntry}
 	output.Success = true
@@ -353,15 +399,19 @@ func EnrichmentWorkflow(ctx workflow.Context, input EnrichmentWorkflowInput) (*E
 			UserID:           input.UserID,
 			StripeCustomerID: input.StripeCustomerID,
 			RowKey:           input.RowKey,
+			RowContext:       input.RowContext,
 			ColumnsMetadata:  filteredMetadata,
 			QueryPatterns:    input.QueryPatterns,
 			KeyColumnDescription:       input.KeyColumnDescription,
+			Locale:           input.Locale,
 			RetryCount:       input.RetryCount + 1,
 			PreviousAttempts: previousAttempts,
 			MaxRetries:       input.MaxRetries,
+			Instructions:     input.Instructions,
+			Merge:            input.Merge,
 		}
 
-		retryOutput, err := EnrichmentWorkflow(ctx, retryInput)
+		retryOutput, err := enrichRow(ctx, retryInput)
 		if err != nil {
 			return output, err
 		}
@@ -370,7 +420,7 @@ func EnrichmentWorkflow(ctx workflow.Context, input EnrichmentWorkflowInput) (*E
 		// and all retry attempts. The retry only re-ran problematic columns, but
 		// its LLM may still return values for other fields — only upgrade when
 		// confidence actually improves.
-		output.ExtractedData, output.Confidence = mergeBestConfidence(
+		output.ExtractedData, output.Confidence = models.MergeBestConfidence(
 			output.ExtractedData, output.Confidence,
 			retryOutput.ExtractedData, retryOutput.Confidence,
 		)
@@ -389,6 +439,7 @@ func EnrichmentWorkflow(ctx workflow.Context, input EnrichmentWorkflowInput) (*E
 			ExtractedData: output.ExtractedData,
 			Confidence:    output.Confidence,
 			Sources:       output.Sources,
+			Merge:         input.Merge,
 		}
 		workflow.ExecuteActivity(ctx, "UpdateState", activities.StateUpdateInput{
 			JobID:  input.JobID,
@@ -399,7 +450,7 @@ func EnrichmentWorkflow(ctx workflow.Context, input EnrichmentWorkflowInput) (*E
 
 		event.EmitSuccess(ctx)
 
-		if input.RetryCount == 0 {
+		if input.RetryCount == 0 && !input.SkipUsage {
 			var reportErr error
 			workflow.ExecuteActivity(ctx, "ReportUsage", activities.ReportUsageInput{
 				StripeCustomerID: input.StripeCustomerID,
@@ -422,7 +473,7 @@ func EnrichmentWorkflow(ctx workflow.Context, input EnrichmentWorkflowInput) (*E
 
 	event.EmitSuccess(ctx)
 
-	if input.RetryCount == 0 {
+	if input.RetryCount == 0 && !input.SkipUsage {
 		var reportErr error
 		workflow.ExecuteActivity(ctx, "ReportUsage", activities.ReportUsageInput{
 			StripeCustomerID: input.StripeCustomerID,
@@ -435,3 +486,109 @@ func EnrichmentWorkflow(ctx workflow.Context, input EnrichmentWorkflowInput) (*E
 
 	return output, nil
 }
+
+// computeDerivedColumns evaluates the job's derived columns once the row has
+// completed and merges them into the output. Retry attempts carry no derived
+// columns, so this only runs for the top-level row workflow.
+func computeDerivedColumns(ctx workflow.Context, input EnrichmentWorkflowInput, output *EnrichmentWorkflowOutput) {
+	if len(input.DerivedColumns) == 0 {
+		return
+	}
+
+	var derivedOutput activities.ComputeDerivedColumnsOutput
+	err := workflow.ExecuteActivity(ctx, "ComputeDerivedColumns", activities.ComputeDerivedColumnsInput{
+		JobID:          input.JobID,
+		RowKey:         input.RowKey,
+		ExtractedData:  output.ExtractedData,
+		Confidence:     output.Confidence,
+		DerivedColumns: input.DerivedColumns,
+		Locale:         input.Locale,
+	}).Get(ctx, &derivedOutput)
+	if err != nil {
+		workflow.GetLogger(ctx).Error("derived column computation failed", "error", err, "row_key", input.RowKey)
+		return
+	}
+
+	if output.ExtractedData == nil {
+		output.ExtractedData = make(map[string]interface{})
+	}
+	if output.Confidence == nil {
+		output.Confidence = make(map[string]*models.FieldConfidenceInfo)
+	}
+	for k, v := range derivedOutput.ExtractedData {
+		output.ExtractedData[k] = v
+	}
+	for k, v := range derivedOutput.Confidence {
+		output.Confidence[k] = v
+	}
+}
+
+// contextColumnNames lists the row's context columns in a stable order for
+// pattern regeneration.
+func contextColumnNames(rowContext map[string]string) []string {
+	names := make([]string, 0, len(rowContext))
+	for name := range rowContext {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// classifyRow answers the row's classify columns from its input values and
+// persists them. When search columns follow, the row stays in its current
+// stage so progress reflects the search pipeline. It reports whether the row
+// can continue; on failure the row is marked failed.
+func classifyRow(ctx workflow.Context, input EnrichmentWorkflowInput, output *EnrichmentWorkflowOutput, event *logger.WideEvent) bool {
+	event.StartStage("CLASSIFY")
+	var classifyOutput activities.ExtractOutput
+	err := workflow.ExecuteActivity(ctx, "Classify", activities.ClassifyInput{
+		JobID:                input.JobID,
+		RowKey:               input.RowKey,
+		RowValues:            input.RowValues,
+		ColumnsMetadata:      input.ClassifyColumns,
+		KeyColumnDescription: input.KeyColumnDescription,
+		Instructions:         input.Instructions,
+		Locale:               input.Locale,
+	}).Get(ctx, &classifyOutput)
+	if err != nil {
+		output.Error = fmt.Sprintf("Classification failed: %v", err)
+		event.FailStage("CLASSIFY", err)
+		event.EmitError(ctx, err)
+
+		workflow.ExecuteActivity(ctx, "UpdateState", activities.StateUpdateInput{
+			JobID:  input.JobID,
+			RowKey: input.RowKey,
+			Stage:  models.StageFailed,
+			Data: &models.StateUpdate{
+				Error: &output.Error,
+			},
+		}).Get(ctx, nil)
+
+		return false
+	}
+	event.CompleteStage("CLASSIFY", map[string]interface{}{
+		"fields_extracted": len(classifyOutput.ExtractedData),
+	})
+
+	stage := models.StagePending
+	if len(input.ColumnsMetadata) == 0 {
+		stage = models.StageEnriched
+	}
+	workflow.ExecuteActivity(ctx, "UpdateState", activities.StateUpdateInput{
+		JobID:  input.JobID,
+		RowKey: input.RowKey,
+		Stage:  stage,
+		Data: &models.StateUpdate{
+			ExtractedData: classifyOutput.ExtractedData,
+			Confidence:    classifyOutput.Confidence,
+			Merge:         input.Merge,
+		},
+	}).Get(ctx, nil)
+
+	output.ExtractedData, output.Confidence = models.MergeBestConfidence(
+		output.ExtractedData, output.Confidence,
+		classifyOutput.ExtractedData, classifyOutput.Confidence,
+	)
+	output.ClassifiedCells = len(input.ClassifyColumns)
+	return true
+}
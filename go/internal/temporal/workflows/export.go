@@ -0,0 +1,29 @@
+package workflows
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+type ExportWorkflowInput struct {
+	ExportID string
+}
+
+// ExportWorkflow builds the file of an export. Large sources take a while to
+// stream, so the activity gets a generous timeout.
+func ExportWorkflow(ctx workflow.Context, input ExportWorkflowInput) (int, error) {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    5 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    time.Minute,
+			MaximumAttempts:    3,
+		},
+	})
+	var rows int
+	err := workflow.ExecuteActivity(ctx, "BuildExport", input.ExportID).Get(ctx, &rows)
+	return rows, err
+}
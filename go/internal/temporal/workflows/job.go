@@ -19,8 +19,10 @@ type JobWorkflowInput struct {
 	UserID               string
 	StripeCustomerID     string
 	RowKeys              []string
+	ContextColumns       []string
 	ColumnsMetadata      []*models.ColumnMetadata
 	KeyColumnDescription *string
+	Locale               string
 	MaxRetries           int
 }
 
@@ -32,6 +34,9 @@ type JobWorkflowOutput struct {
 	CompletedAt    time.Time
 }
 
+// JobWorkflow enriches the rows of a job. Its input carries only row keys;
+// each row's source values are stored with the row and loaded by the row's
+// own workflow, so large sources do not exceed the payload limit.
 func JobWorkflow(ctx workflow.Context, input JobWorkflowInput) (*JobWorkflowOutput, error) {
 	info := workflow.GetInfo(ctx)
 	event := logger.NewJobEvent(input.JobID, "")
@@ -58,14 +63,30 @@ func JobWorkflow(ctx workflow.Context, input JobWorkflowInput) (*JobWorkflowOutp
 		event.EmitError(ctx, fmt.Errorf("job initialization failed: %w", err))
 		return nil, fmt.Errorf("job initialization failed: %w", err)
 	}
+	webhooks := newWebhookNotifier(activityCtx, input.UserID, input.JobID)
+	webhooks.notify(ctx, models.WebhookEvent{
+		Type:    models.WebhookJobStarted,
+		JobID:   input.JobID,
+		Outcome: jobOutcome(output),
+	})
+
+	// Derived columns are computed from the other values once a row
+	// completes, so they never reach search or extraction.
+	enrichedColumns, derivedColumns := models.SplitDerivedColumns(input.ColumnsMetadata)
+	// Classify columns are answered from the row's own values and skip the
+	// search pipeline, so they need no query patterns.
+	searchedColumns, classifyColumns := models.SplitClassifyColumns(enrichedColumns)
 
 	var patternsOutput activities.GeneratePatternsOutput
-	err := workflow.ExecuteActivity(activityCtx, "GeneratePatterns", activities.GeneratePatternsInput{
-		JobID:           input.JobID,
-		ColumnsMetadata: input.ColumnsMetadata,
-	}).Get(activityCtx, &patternsOutput)
-	if err != nil {
-		patternsOutput.Patterns = []string{"%entity"}
+	if len(searchedColumns) > 0 {
+		err := workflow.ExecuteActivity(activityCtx, "GeneratePatterns", activities.GeneratePatternsInput{
+			JobID:           input.JobID,
+			ColumnsMetadata: searchedColumns,
+			ContextColumns:  input.ContextColumns,
+		}).Get(activityCtx, &patternsOutput)
+		if err != nil {
+			patternsOutput.Patterns = []string{"%entity"}
+		}
 	}
 	event.SetMetadata("pattern_count", len(patternsOutput.Patterns))
 
@@ -89,30 +110,64 @@ func JobWorkflow(ctx workflow.Context, input JobWorkflowInput) (*JobWorkflowOutp
 			UserID:               input.UserID,
 			StripeCustomerID:     input.StripeCustomerID,
 			RowKey:               rowKey,
-			ColumnsMetadata:      input.ColumnsMetadata,
+			ColumnsMetadata:      searchedColumns,
+			ClassifyColumns:      classifyColumns,
+			DerivedColumns:       derivedColumns,
+			ContextColumns:       input.ContextColumns,
 			QueryPatterns:        patternsOutput.Patterns,
 			KeyColumnDescription: keyColumnDescription,
+			Locale:               input.Locale,
 			RetryCount:           0,
 			PreviousAttempts:     []*models.EnrichmentAttempt{},
 			MaxRetries:           input.MaxRetries,
 		}))
 	}
 
-	for _, future := range sem.Futures() {
+	classifiedCells := 0
+	for i, future := range sem.Futures() {
 		var rowOutput EnrichmentWorkflowOutput
 		if err := future.Get(ctx, &rowOutput); err != nil {
+			// Rows stopped by cancelling the job have not failed.
+			if temporal.IsCanceledError(err) {
+				continue
+			}
 			workflow.GetLogger(ctx).Error("child workflow failed", "error", err)
 			output.FailedRows++
-		} else if rowOutput.Success {
+			webhooks.rowFailed(ctx, input.RowKeys[i], err.Error())
+			continue
+		}
+		classifiedCells += rowOutput.ClassifiedCells
+		if rowOutput.Success {
 			output.SuccessfulRows++
 		} else if !rowOutput.Cancelled {
 			output.FailedRows++
+			webhooks.rowFailed(ctx, rowOutput.RowKey, rowOutput.Error)
 		}
 	}
 
+	// Cancelling the job cancels this workflow, so the classify cells of the
+	// rows that finished are billed and the event is sent from a context
+	// that outlives it.
+	if ctx.Err() != nil {
+		disconnectedCtx, _ := workflow.NewDisconnectedContext(ctx)
+		reportClassifyUsage(workflow.WithActivityOptions(disconnectedCtx, activityOptions), input.StripeCustomerID, classifiedCells, event)
+		webhooks.flush(disconnectedCtx)
+		webhooks.notify(disconnectedCtx, models.WebhookEvent{
+			Type:    models.WebhookJobCancelled,
+			JobID:   input.JobID,
+			Outcome: jobOutcome(output),
+		})
+		event.EmitError(ctx, ctx.Err())
+		return nil, ctx.Err()
+	}
+
+	webhooks.flush(ctx)
+
 	event.Completed = output.SuccessfulRows
 	event.Failed = output.FailedRows
 
+	reportClassifyUsage(activityCtx, input.StripeCustomerID, classifiedCells, event)
+
 	workflow.ExecuteActivity(activityCtx, "IncrementJobCredits", activities.IncrementJobCreditsInput{
 		JobID:   input.JobID,
 		Credits: output.SuccessfulRows,
@@ -123,7 +178,51 @@ func JobWorkflow(ctx workflow.Context, input JobWorkflowInput) (*JobWorkflowOutp
 		return nil, err
 	}
 
+	// Writing results back to a query source is best effort: the job's
+	// results stay available here even if the target table rejects them.
+	writeBackCtx := workflow.WithStartToCloseTimeout(activityCtx, 10*time.Minute)
+	var rowsWritten int
+	if err := workflow.ExecuteActivity(writeBackCtx, "WriteBackResults", input.JobID).Get(writeBackCtx, &rowsWritten); err != nil {
+		event.SetMetadata("write_back_error", err.Error())
+	} else if rowsWritten > 0 {
+		event.SetMetadata("rows_written_back", rowsWritten)
+	}
+
 	output.CompletedAt = workflow.Now(ctx)
+	webhooks.notify(ctx, models.WebhookEvent{
+		Type:    models.WebhookJobCompleted,
+		JobID:   input.JobID,
+		Outcome: jobOutcome(output),
+	})
 	event.EmitSuccess(ctx)
 	return output, nil
 }
+
+// rowContext picks the row's context column values. Columns the row has no
+// cell for map to an empty string, so their query placeholders are dropped
+// rather than left in the query.
+func rowContext(values map[string]string, contextColumns []string) map[string]string {
+	if len(contextColumns) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(contextColumns))
+	for _, name := range contextColumns {
+		result[name] = values[name]
+	}
+	return result
+}
+
+// reportClassifyUsage bills the classify cells of a run once for all its
+// rows, so the reduced rate is not rounded up row by row.
+func reportClassifyUsage(ctx workflow.Context, stripeCustomerID string, classifiedCells int, event *logger.WideEvent) {
+	if classifiedCells == 0 {
+		return
+	}
+	err := workflow.ExecuteActivity(ctx, "ReportUsage", activities.ReportUsageInput{
+		StripeCustomerID: stripeCustomerID,
+		ClassifiedCells:  classifiedCells,
+	}).Get(ctx, nil)
+	if err != nil {
+		event.SetMetadata("billing_error", err.Error())
+	}
+}
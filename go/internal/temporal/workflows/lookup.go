@@ -0,0 +1,60 @@
+package workflows
+
+import (
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/temporal/activities"
+)
+
+type LookupWorkflowInput struct {
+	UserID               string
+	Key                  string
+	KeyColumnDescription string
+	ColumnsMetadata      []*models.ColumnMetadata
+	Locale               string
+	MaxRetries           int
+}
+
+// LookupWorkflow enriches a single entity outside of any job. It runs the
+// EnrichmentWorkflow of a job's row without a job ID, so nothing is stored
+// and the result is only returned. Usage is not reported here: the caller
+// reports it once it has the result, so a lookup that times out on its
+// side is not charged.
+func LookupWorkflow(ctx workflow.Context, input LookupWorkflowInput) (*EnrichmentWorkflowOutput, error) {
+	activityCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 1 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    30 * time.Second,
+			MaximumAttempts:    3,
+		},
+	})
+
+	searchedColumns, derivedColumns := models.SplitDerivedColumns(input.ColumnsMetadata)
+
+	var patternsOutput activities.GeneratePatternsOutput
+	err := workflow.ExecuteActivity(activityCtx, "GeneratePatterns", activities.GeneratePatternsInput{
+		ColumnsMetadata: searchedColumns,
+	}).Get(activityCtx, &patternsOutput)
+	if err != nil {
+		patternsOutput.Patterns = []string{"%entity"}
+	}
+
+	return EnrichmentWorkflow(ctx, EnrichmentWorkflowInput{
+		UserID:               input.UserID,
+		RowKey:               input.Key,
+		ColumnsMetadata:      searchedColumns,
+		DerivedColumns:       derivedColumns,
+		QueryPatterns:        patternsOutput.Patterns,
+		KeyColumnDescription: input.KeyColumnDescription,
+		Locale:               input.Locale,
+		PreviousAttempts:     []*models.EnrichmentAttempt{},
+		MaxRetries:           input.MaxRetries,
+		SkipUsage:            true,
+	})
+}
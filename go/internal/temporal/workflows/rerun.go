@@ -0,0 +1,197 @@
+package workflows
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/blagoySimandov/ampledata/go/internal/config"
+	"github.com/blagoySimandov/ampledata/go/internal/logger"
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/temporal/activities"
+)
+
+type RerunWorkflowInput struct {
+	JobID            string
+	UserID           string
+	StripeCustomerID string
+	// RowColumns maps each re-run row key to the names of its re-run columns.
+	RowColumns           map[string][]string
+	ContextColumns       []string
+	ColumnsMetadata      []*models.ColumnMetadata
+	KeyColumnDescription string
+	Locale               string
+	MaxRetries           int
+	Instructions         string
+	Merge                models.MergeMode
+	// PreviousStatus is the job's status before the re-run, restored once
+	// the re-run is done.
+	PreviousStatus models.JobStatus
+}
+
+// RerunWorkflow enriches some cells of a finished job again. Every row runs
+// an EnrichmentWorkflow over its re-run columns only, so only those cells
+// are billed; derived columns are recomputed from the merged row.
+func RerunWorkflow(ctx workflow.Context, input RerunWorkflowInput) (*JobWorkflowOutput, error) {
+	info := workflow.GetInfo(ctx)
+	event := logger.NewJobEvent(input.JobID, "")
+	event.SetWorkflowInfo(info.WorkflowExecution.ID, info.WorkflowExecution.RunID)
+	event.TotalRows = len(input.RowColumns)
+	event.SetMetadata("rerun", true)
+	event.SetMetadata("merge", string(input.Merge))
+
+	activityCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 1 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    30 * time.Second,
+			MaximumAttempts:    3,
+		},
+	})
+
+	output := &JobWorkflowOutput{
+		JobID:     input.JobID,
+		TotalRows: len(input.RowColumns),
+	}
+
+	err := workflow.ExecuteActivity(activityCtx, "PrepareRerun", activities.PrepareRerunInput{
+		JobID:      input.JobID,
+		RowColumns: input.RowColumns,
+		Merge:      input.Merge,
+	}).Get(activityCtx, nil)
+	if err != nil {
+		// The job may already be RUNNING, which would block further re-runs.
+		workflow.ExecuteActivity(activityCtx, "SetJobStatus", activities.SetJobStatusInput{
+			JobID:  input.JobID,
+			Status: input.PreviousStatus,
+		}).Get(activityCtx, nil)
+		event.EmitError(ctx, fmt.Errorf("rerun preparation failed: %w", err))
+		return nil, fmt.Errorf("rerun preparation failed: %w", err)
+	}
+
+	enrichedColumns, derivedColumns := models.SplitDerivedColumns(input.ColumnsMetadata)
+	searchedColumns, classifyColumns := models.SplitClassifyColumns(enrichedColumns)
+
+	// Map iteration order is random, so rows are started in key order to
+	// keep the workflow deterministic on replay.
+	rowKeys := make([]string, 0, len(input.RowColumns))
+	var rerunColumns []string
+	for key, columns := range input.RowColumns {
+		rowKeys = append(rowKeys, key)
+		rerunColumns = append(rerunColumns, columns...)
+	}
+	sort.Strings(rowKeys)
+
+	var patternsOutput activities.GeneratePatternsOutput
+	if patternColumns := selectColumns(searchedColumns, rerunColumns); len(patternColumns) > 0 {
+		err := workflow.ExecuteActivity(activityCtx, "GeneratePatterns", activities.GeneratePatternsInput{
+			JobID:           input.JobID,
+			ColumnsMetadata: patternColumns,
+			ContextColumns:  input.ContextColumns,
+		}).Get(activityCtx, &patternsOutput)
+		if err != nil {
+			patternsOutput.Patterns = []string{"%entity"}
+		}
+	}
+
+	childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		WorkflowExecutionTimeout: 10 * time.Minute,
+		WorkflowTaskTimeout:      1 * time.Minute,
+		ParentClosePolicy:        *enumspb.PARENT_CLOSE_POLICY_TERMINATE.Enum(),
+	})
+
+	sem := &workflowSemaphore{ctx: ctx, limit: config.Load().ConcurrencyRowEnrichmentLimit}
+
+	for _, rowKey := range rowKeys {
+		columns := input.RowColumns[rowKey]
+		sem.Acquire()
+		sem.Add(workflow.ExecuteChildWorkflow(childCtx, EnrichmentWorkflow, EnrichmentWorkflowInput{
+			JobID:                input.JobID,
+			UserID:               input.UserID,
+			StripeCustomerID:     input.StripeCustomerID,
+			RowKey:               rowKey,
+			ColumnsMetadata:      selectColumns(searchedColumns, columns),
+			ClassifyColumns:      selectColumns(classifyColumns, columns),
+			DerivedColumns:       derivedColumns,
+			ContextColumns:       input.ContextColumns,
+			QueryPatterns:        patternsOutput.Patterns,
+			KeyColumnDescription: input.KeyColumnDescription,
+			Locale:               input.Locale,
+			RetryCount:           0,
+			PreviousAttempts:     []*models.EnrichmentAttempt{},
+			MaxRetries:           input.MaxRetries,
+			Instructions:         input.Instructions,
+			Merge:                input.Merge,
+		}))
+	}
+
+	classifiedCells := 0
+	for _, future := range sem.Futures() {
+		var rowOutput EnrichmentWorkflowOutput
+		if err := future.Get(ctx, &rowOutput); err != nil {
+			workflow.GetLogger(ctx).Error("child workflow failed", "error", err)
+			output.FailedRows++
+			continue
+		}
+		classifiedCells += rowOutput.ClassifiedCells
+		if rowOutput.Success {
+			output.SuccessfulRows++
+		} else if !rowOutput.Cancelled {
+			output.FailedRows++
+		}
+	}
+
+	event.Completed = output.SuccessfulRows
+	event.Failed = output.FailedRows
+
+	reportClassifyUsage(activityCtx, input.StripeCustomerID, classifiedCells, event)
+
+	workflow.ExecuteActivity(activityCtx, "IncrementJobCredits", activities.IncrementJobCreditsInput{
+		JobID:   input.JobID,
+		Credits: output.SuccessfulRows,
+	}).Get(activityCtx, nil)
+
+	err = workflow.ExecuteActivity(activityCtx, "SetJobStatus", activities.SetJobStatusInput{
+		JobID:  input.JobID,
+		Status: input.PreviousStatus,
+	}).Get(activityCtx, nil)
+	if err != nil {
+		event.EmitError(ctx, err)
+		return nil, err
+	}
+
+	if input.PreviousStatus == models.JobStatusCompleted {
+		writeBackCtx := workflow.WithStartToCloseTimeout(activityCtx, 10*time.Minute)
+		var rowsWritten int
+		if err := workflow.ExecuteActivity(writeBackCtx, "WriteBackResults", input.JobID).Get(writeBackCtx, &rowsWritten); err != nil {
+			event.SetMetadata("write_back_error", err.Error())
+		} else if rowsWritten > 0 {
+			event.SetMetadata("rows_written_back", rowsWritten)
+		}
+	}
+
+	output.CompletedAt = workflow.Now(ctx)
+	event.EmitSuccess(ctx)
+	return output, nil
+}
+
+// selectColumns keeps the columns of cols that are named in names, in the
+// order of cols.
+func selectColumns(cols []*models.ColumnMetadata, names []string) []*models.ColumnMetadata {
+	wanted := make(map[string]bool, len(names))
+	for _, name := range names {
+		wanted[name] = true
+	}
+	var selected []*models.ColumnMetadata
+	for _, col := range cols {
+		if wanted[col.Name] {
+			selected = append(selected, col)
+		}
+	}
+	return selected
+}
@@ -0,0 +1,197 @@
+package workflows
+
+import (
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+
+	"github.com/blagoySimandov/ampledata/go/internal/models"
+	"github.com/blagoySimandov/ampledata/go/internal/temporal/activities"
+)
+
+type WebhookWorkflowInput struct {
+	UserID string
+	// Event is a single job event and Events a batch of row events. Event
+	// is kept for workflows started before events were batched.
+	Event  *models.WebhookEvent
+	Events []*models.WebhookEvent
+}
+
+// rowFailedBatchSize is how many row.failed events one WebhookWorkflow
+// sends.
+const rowFailedBatchSize = 100
+
+// WebhookWorkflow sends job events to every webhook endpoint of the user
+// subscribed to them. Each delivery is retried with backoff on its own, so a
+// slow or failing endpoint does not hold up the others.
+func WebhookWorkflow(ctx workflow.Context, input WebhookWorkflowInput) error {
+	createCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 1 * time.Minute,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    30 * time.Second,
+			MaximumAttempts:    3,
+		},
+	})
+	events := input.Events
+	if input.Event != nil {
+		events = append(events, input.Event)
+	}
+	created := make([]workflow.Future, len(events))
+	for i, event := range events {
+		created[i] = workflow.ExecuteActivity(createCtx, "CreateWebhookDeliveries", activities.CreateWebhookDeliveriesInput{
+			UserID: input.UserID,
+			Event:  event,
+		})
+	}
+	var deliveryIDs []string
+	for _, future := range created {
+		var ids []string
+		if err := future.Get(createCtx, &ids); err != nil {
+			return err
+		}
+		deliveryIDs = append(deliveryIDs, ids...)
+	}
+
+	deliverCtx := workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: 30 * time.Second,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    10 * time.Second,
+			BackoffCoefficient: 2.0,
+			MaximumInterval:    10 * time.Minute,
+			MaximumAttempts:    activities.WebhookDeliveryAttempts,
+		},
+	})
+	futures := make([]workflow.Future, len(deliveryIDs))
+	for i, id := range deliveryIDs {
+		futures[i] = workflow.ExecuteActivity(deliverCtx, "DeliverWebhook", id)
+	}
+	// Failed deliveries are recorded in the delivery log, so they do not
+	// fail the workflow.
+	for i, future := range futures {
+		if err := future.Get(deliverCtx, nil); err != nil {
+			workflow.GetLogger(ctx).Warn("webhook delivery failed", "delivery_id", deliveryIDs[i], "error", err)
+		}
+	}
+	return nil
+}
+
+// webhookNotifier sends the webhook events of a job. The event types the
+// user's endpoints subscribe to are looked up once, when the job starts, and
+// events nobody subscribes to start no workflow. row.failed events are
+// collected and sent rowFailedBatchSize at a time.
+type webhookNotifier struct {
+	userID     string
+	jobID      string
+	subscribed []models.WebhookEventType
+	// known is false when the lookup failed, in which case every event is
+	// sent and the deliveries only go to subscribed endpoints.
+	known      bool
+	failedRows []*models.WebhookEvent
+}
+
+func newWebhookNotifier(ctx workflow.Context, userID, jobID string) *webhookNotifier {
+	n := &webhookNotifier{userID: userID, jobID: jobID}
+	err := workflow.ExecuteActivity(ctx, "GetWebhookSubscriptions", userID).Get(ctx, &n.subscribed)
+	if err != nil {
+		workflow.GetLogger(ctx).Warn("failed to get webhook subscriptions", "error", err)
+		return n
+	}
+	n.known = true
+	return n
+}
+
+func (n *webhookNotifier) subscribes(event models.WebhookEventType) bool {
+	return !n.known || slices.Contains(n.subscribed, event)
+}
+
+// notify sends a job event.
+func (n *webhookNotifier) notify(ctx workflow.Context, event models.WebhookEvent) {
+	if !n.subscribes(event.Type) {
+		return
+	}
+	notifyWebhooks(ctx, n.userID, &event)
+}
+
+// rowFailed queues the row.failed event of a row, sending the queue once it
+// holds a batch.
+func (n *webhookNotifier) rowFailed(ctx workflow.Context, rowKey, rowErr string) {
+	if !n.subscribes(models.WebhookRowFailed) {
+		return
+	}
+	n.failedRows = append(n.failedRows, &models.WebhookEvent{
+		Type:   models.WebhookRowFailed,
+		JobID:  n.jobID,
+		RowKey: rowKey,
+		Error:  rowErr,
+	})
+	if len(n.failedRows) >= rowFailedBatchSize {
+		n.flush(ctx)
+	}
+}
+
+// flush sends the queued row.failed events.
+func (n *webhookNotifier) flush(ctx workflow.Context) {
+	if len(n.failedRows) == 0 {
+		return
+	}
+	notifyWebhooks(ctx, n.userID, n.failedRows...)
+	n.failedRows = nil
+}
+
+// notifyWebhooks starts a WebhookWorkflow for some events. The workflow is
+// abandoned rather than awaited, so deliveries outlive the job's workflow
+// without delaying it; only its start is waited for.
+func notifyWebhooks(ctx workflow.Context, userID string, events ...*models.WebhookEvent) {
+	var eventIDs []string
+	encoded := workflow.SideEffect(ctx, func(workflow.Context) interface{} {
+		ids := make([]string, len(events))
+		for i := range ids {
+			ids[i] = uuid.NewString()
+		}
+		return ids
+	})
+	if err := encoded.Get(&eventIDs); err != nil {
+		workflow.GetLogger(ctx).Error("failed to create webhook event IDs", "error", err)
+		return
+	}
+	now := workflow.Now(ctx)
+	for i, event := range events {
+		event.ID = eventIDs[i]
+		event.CreatedAt = now
+	}
+
+	input := WebhookWorkflowInput{UserID: userID}
+	if len(events) == 1 && events[0].Type != models.WebhookRowFailed {
+		input.Event = events[0]
+	} else {
+		input.Events = events
+	}
+	childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+		WorkflowID:        "webhook-" + eventIDs[0],
+		ParentClosePolicy: *enumspb.PARENT_CLOSE_POLICY_ABANDON.Enum(),
+	})
+	child := workflow.ExecuteChildWorkflow(childCtx, WebhookWorkflow, input)
+	if err := child.GetChildWorkflowExecution().Get(ctx, nil); err != nil {
+		workflow.GetLogger(ctx).Error("failed to start webhook workflow", "event", string(events[0].Type), "events", len(events), "error", err)
+	}
+}
+
+// jobOutcome counts how the rows of a job workflow ended.
+func jobOutcome(output *JobWorkflowOutput) *models.JobOutcome {
+	outcome := &models.JobOutcome{
+		TotalRows:      output.TotalRows,
+		SuccessfulRows: output.SuccessfulRows,
+		FailedRows:     output.FailedRows,
+	}
+	if !output.CompletedAt.IsZero() {
+		completedAt := output.CompletedAt
+		outcome.CompletedAt = &completedAt
+	}
+	return outcome
+}
@@ -0,0 +1,8 @@
+-- Sources of the dropped type cannot be converted, so they are deleted
+-- together with their jobs before the enum is recreated.
+DELETE FROM jobs WHERE source_id IN (SELECT id FROM sources WHERE type = 'sql_query');
+DELETE FROM sources WHERE type = 'sql_query';
+ALTER TYPE source_type RENAME TO source_type_old;
+CREATE TYPE source_type AS ENUM ('csv_upload', 'xlsx_upload');
+ALTER TABLE sources ALTER COLUMN type TYPE source_type USING type::text::source_type;
+DROP TYPE source_type_old;
@@ -0,0 +1,3 @@
+ALTER TABLE jobs
+DROP COLUMN IF EXISTS base_job_id,
+DROP COLUMN IF EXISTS source_revision;
@@ -0,0 +1,3 @@
+ALTER TABLE jobs
+ADD COLUMN IF NOT EXISTS source_revision INTEGER NOT NULL DEFAULT 1,
+ADD COLUMN IF NOT EXISTS base_job_id VARCHAR(255);
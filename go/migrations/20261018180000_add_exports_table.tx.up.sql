@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS exports (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    job_id VARCHAR(255) NOT NULL REFERENCES jobs(job_id) ON DELETE CASCADE,
+    user_id VARCHAR(255) NOT NULL,
+    format VARCHAR(255) NOT NULL,
+    options JSONB,
+    status VARCHAR(255) NOT NULL DEFAULT 'PENDING',
+    object_name VARCHAR(255),
+    row_count INTEGER NOT NULL DEFAULT 0,
+    error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    completed_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS idx_exports_job_id ON exports (job_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_row_states_attempts;
+DROP INDEX IF EXISTS idx_row_states_error_trgm;
+DROP INDEX IF EXISTS idx_row_states_key_trgm;
+DROP INDEX IF EXISTS idx_row_states_extracted_data;
@@ -0,0 +1,14 @@
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+-- Column presence filters look up keys of extracted_data.
+CREATE INDEX IF NOT EXISTS idx_row_states_extracted_data ON row_states USING GIN (extracted_data);
+
+-- Substring search on row keys and error messages.
+CREATE INDEX IF NOT EXISTS idx_row_states_key_trgm ON row_states USING GIN (key gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_row_states_error_trgm ON row_states USING GIN (error gin_trgm_ops);
+
+-- Extraction attempt count filters.
+CREATE INDEX IF NOT EXISTS idx_row_states_attempts ON row_states (
+    job_id,
+    (CASE WHEN jsonb_typeof(extraction_history) = 'array' THEN jsonb_array_length(extraction_history) ELSE 0 END)
+);
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS cell_corrections (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    job_id VARCHAR(255) NOT NULL REFERENCES jobs(job_id) ON DELETE CASCADE,
+    row_key TEXT NOT NULL,
+    column_name VARCHAR(255) NOT NULL,
+    old_value JSONB,
+    new_value JSONB,
+    user_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_cell_corrections_job_row ON cell_corrections (job_id, row_key);
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS row_events (
+    id BIGSERIAL PRIMARY KEY,
+    job_id VARCHAR(255) NOT NULL REFERENCES jobs(job_id) ON DELETE CASCADE,
+    row_key TEXT NOT NULL,
+    stage VARCHAR(255) NOT NULL,
+    extracted_data JSONB,
+    confidence JSONB,
+    error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_row_events_job_id ON row_events (job_id, id);
+CREATE INDEX IF NOT EXISTS idx_row_events_created_at ON row_events (created_at);
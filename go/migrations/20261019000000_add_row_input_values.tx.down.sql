@@ -0,0 +1,2 @@
+ALTER TABLE row_states
+DROP COLUMN IF EXISTS input_values;
@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS idx_row_events_unsequenced;
+
+--bun:split
+
+DROP INDEX IF EXISTS idx_row_events_job_seq;
+
+--bun:split
+
+ALTER TABLE row_events
+DROP COLUMN IF EXISTS seq;
+
+--bun:split
+
+DROP SEQUENCE IF EXISTS row_event_seq;
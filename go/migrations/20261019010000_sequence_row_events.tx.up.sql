@@ -0,0 +1,23 @@
+ALTER TABLE row_events
+ADD COLUMN IF NOT EXISTS seq BIGINT;
+
+--bun:split
+
+CREATE SEQUENCE IF NOT EXISTS row_event_seq;
+
+--bun:split
+
+-- Existing events keep their IDs, so open streams resume where they left off.
+UPDATE row_events SET seq = id WHERE seq IS NULL;
+
+--bun:split
+
+SELECT setval('row_event_seq', GREATEST((SELECT COALESCE(MAX(seq), 0) FROM row_events), 1));
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS idx_row_events_job_seq ON row_events (job_id, seq);
+
+--bun:split
+
+CREATE INDEX IF NOT EXISTS idx_row_events_unsequenced ON row_events (job_id, id) WHERE seq IS NULL;
@@ -8,6 +8,24 @@ import type {
   SourceListResponse,
   SourceDetail,
   JobProgressResponse,
+  CellCorrectionRequest,
+  CellCorrectionResponse,
+  CellCorrectionListResponse,
+  RerunRequest,
+  RerunResponse,
+  DiffFilters,
+  JobDiffResponse,
+  RowEvent,
+  CreateWebhookEndpointRequest,
+  WebhookEndpoint,
+  WebhookEndpointListResponse,
+  WebhookDeliveryListResponse,
+  LookupRequest,
+  LookupResponse,
+  CreateAPIKeyRequest,
+  APIKey,
+  APIKeyListResponse,
+  RowFilters,
   RowsProgressResponse,
   SignedURLRequest,
   SignedURLResponse,
@@ -36,12 +54,14 @@ export class ApiClient {
 
   private buildUrl(
     endpoint: string,
-    params?: Record<string, string | number | undefined>,
+    params?: Record<string, string | number | string[] | undefined>,
   ): string {
     const url = new URL(endpoint, "http://localhost");
     if (params) {
       Object.entries(params).forEach(([key, value]) => {
-        if (value !== undefined) {
+        if (Array.isArray(value)) {
+          value.forEach((v) => url.searchParams.append(key, v));
+        } else if (value !== undefined) {
           url.searchParams.append(key, String(value));
         }
       });
@@ -89,8 +109,15 @@ export class ApiClient {
     return decodeSourceDetail(data);
   }
 
-  public async getSourceData(sourceId: string): Promise<SourceDataResponse> {
-    const endpoint = this.buildUrl(ENDPOINTS.SOURCES_DATA(sourceId));
+  public async getSourceData(
+    sourceId: string,
+    offset = 0,
+    limit = 1000,
+  ): Promise<SourceDataResponse> {
+    const endpoint = this.buildUrl(ENDPOINTS.SOURCES_DATA(sourceId), {
+      offset,
+      limit,
+    });
     return this.request<SourceDataResponse>(endpoint);
   }
 
@@ -117,12 +144,14 @@ export class ApiClient {
     limit = 50,
     stage = "all",
     sort = "updated_at_desc",
+    filters: RowFilters = {},
   ): Promise<RowsProgressResponse> {
     const endpoint = this.buildUrl(ENDPOINTS.JOBS_ROWS(jobId), {
       offset,
       limit,
       stage,
       sort,
+      ...filters,
     });
     const data = await this.request<RowsProgressResponse>(endpoint);
     return decodeRowsProgress(data);
@@ -135,6 +164,192 @@ export class ApiClient {
     });
   }
 
+  public async correctCell(
+    jobId: string,
+    req: CellCorrectionRequest,
+  ): Promise<CellCorrectionResponse> {
+    const endpoint = this.buildUrl(ENDPOINTS.JOBS_CORRECTIONS(jobId));
+    return this.request<CellCorrectionResponse>(endpoint, {
+      method: "POST",
+      body: JSON.stringify(req),
+    });
+  }
+
+  public async rerunRows(
+    jobId: string,
+    req: RerunRequest,
+  ): Promise<RerunResponse> {
+    const endpoint = this.buildUrl(ENDPOINTS.JOBS_RERUN(jobId));
+    return this.request<RerunResponse>(endpoint, {
+      method: "POST",
+      body: JSON.stringify(req),
+    });
+  }
+
+  public async diffJobs(
+    jobId: string,
+    baseJobId: string,
+    offset = 0,
+    limit = 50,
+    filters: DiffFilters = {},
+  ): Promise<JobDiffResponse> {
+    const endpoint = this.buildUrl(ENDPOINTS.JOBS_DIFF(jobId), {
+      base: baseJobId,
+      offset,
+      limit,
+      ...filters,
+    });
+    return this.request<JobDiffResponse>(endpoint);
+  }
+
+  public async downloadJobDiff(
+    jobId: string,
+    baseJobId: string,
+    filters: DiffFilters = {},
+  ): Promise<Blob> {
+    const endpoint = this.buildUrl(ENDPOINTS.JOBS_DIFF_CSV(jobId), {
+      base: baseJobId,
+      ...filters,
+    });
+    const token = await this.getToken();
+    const response = await fetch(`${this.baseUrl}${endpoint}`, {
+      headers: token ? { Authorization: `Bearer ${token}` } : {},
+    });
+    if (!response.ok)
+      throw new Error(`Diff download failed: ${response.statusText}`);
+    return response.blob();
+  }
+
+  /**
+   * Follows a job's row events until the signal aborts. Events after
+   * lastEventId are replayed first; the returned promise resolves with the
+   * ID of the last event received, to resume from after a disconnect.
+   */
+  public async streamJobEvents(
+    jobId: string,
+    onEvent: (event: RowEvent) => void,
+    signal: AbortSignal,
+    lastEventId?: number,
+  ): Promise<number | undefined> {
+    const token = await this.getToken();
+    const headers: Record<string, string> = { Accept: "text/event-stream" };
+    if (token) headers.Authorization = `Bearer ${token}`;
+    if (lastEventId !== undefined)
+      headers["Last-Event-ID"] = String(lastEventId);
+
+    let lastId = lastEventId;
+    let response: Response;
+    try {
+      response = await fetch(`${this.baseUrl}${ENDPOINTS.JOBS_EVENTS(jobId)}`, {
+        headers,
+        signal,
+      });
+    } catch (err) {
+      if (signal.aborted) return lastId;
+      throw err;
+    }
+    if (!response.ok || !response.body)
+      throw new Error(`Event stream failed: ${response.statusText}`);
+
+    const reader = response.body
+      .pipeThrough(new TextDecoderStream())
+      .getReader();
+    let buffer = "";
+    try {
+      for (;;) {
+        const { value, done } = await reader.read();
+        if (done) return lastId;
+        buffer += value;
+        let end: number;
+        while ((end = buffer.indexOf("\n\n")) >= 0) {
+          const block = buffer.slice(0, end);
+          buffer = buffer.slice(end + 2);
+          const data = block
+            .split("\n")
+            .filter((line) => line.startsWith("data: "))
+            .map((line) => line.slice(6))
+            .join("\n");
+          if (!data) continue;
+          const event = JSON.parse(data) as RowEvent;
+          lastId = event.id;
+          onEvent(event);
+        }
+      }
+    } catch (err) {
+      if (signal.aborted) return lastId;
+      throw err;
+    }
+  }
+
+  public async listCorrections(
+    jobId: string,
+    rowKey?: string,
+  ): Promise<CellCorrectionListResponse> {
+    const endpoint = this.buildUrl(ENDPOINTS.JOBS_CORRECTIONS(jobId), {
+      row_key: rowKey,
+    });
+    return this.request<CellCorrectionListResponse>(endpoint);
+  }
+
+  public async createWebhookEndpoint(
+    req: CreateWebhookEndpointRequest,
+  ): Promise<WebhookEndpoint> {
+    return this.request<WebhookEndpoint>(ENDPOINTS.WEBHOOK_ENDPOINTS, {
+      method: "POST",
+      body: JSON.stringify(req),
+    });
+  }
+
+  public async listWebhookEndpoints(): Promise<WebhookEndpointListResponse> {
+    return this.request<WebhookEndpointListResponse>(
+      ENDPOINTS.WEBHOOK_ENDPOINTS,
+    );
+  }
+
+  public async deleteWebhookEndpoint(endpointId: string): Promise<void> {
+    await this.request<void>(ENDPOINTS.WEBHOOK_ENDPOINT(endpointId), {
+      method: "DELETE",
+    });
+  }
+
+  public async listWebhookDeliveries(
+    endpointId: string,
+    offset = 0,
+    limit = 50,
+    jobId?: string,
+  ): Promise<WebhookDeliveryListResponse> {
+    const endpoint = this.buildUrl(ENDPOINTS.WEBHOOK_DELIVERIES(endpointId), {
+      offset,
+      limit,
+      job_id: jobId,
+    });
+    return this.request<WebhookDeliveryListResponse>(endpoint);
+  }
+
+  public async lookupEntity(req: LookupRequest): Promise<LookupResponse> {
+    return this.request<LookupResponse>(ENDPOINTS.LOOKUPS, {
+      method: "POST",
+      body: JSON.stringify(req),
+    });
+  }
+
+  public async createApiKey(req: CreateAPIKeyRequest): Promise<APIKey> {
+    return this.request<APIKey>(ENDPOINTS.API_KEYS, {
+      method: "POST",
+      body: JSON.stringify(req),
+    });
+  }
+
+  public async listApiKeys(): Promise<APIKeyListResponse> {
+    return this.request<APIKeyListResponse>(ENDPOINTS.API_KEYS);
+  }
+
+  public async revokeApiKey(keyId: string): Promise<void> {
+    await this.request<void>(ENDPOINTS.API_KEY(keyId), {
+      method: "DELETE",
+    });
+  }
+
   public async getSignedUrl(req: SignedURLRequest): Promise<SignedURLResponse> {
     const endpoint = this.buildUrl(ENDPOINTS.ENRICHMENT_SIGNED_URL);
     return this.request<SignedURLResponse>(endpoint, {
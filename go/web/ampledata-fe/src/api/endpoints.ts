@@ -7,6 +7,18 @@ export const ENDPOINTS = {
   JOBS_ROWS: (jobId: string) => `/jobs/${jobId}/rows`,
   JOBS_RESULTS: (jobId: string) => `/jobs/${jobId}/results`,
   JOBS_CANCEL: (jobId: string) => `/jobs/${jobId}/cancel`,
+  JOBS_CORRECTIONS: (jobId: string) => `/jobs/${jobId}/corrections`,
+  JOBS_RERUN: (jobId: string) => `/jobs/${jobId}/rerun`,
+  JOBS_DIFF: (jobId: string) => `/jobs/${jobId}/diff`,
+  JOBS_EVENTS: (jobId: string) => `/jobs/${jobId}/events`,
+  JOBS_DIFF_CSV: (jobId: string) => `/jobs/${jobId}/diff/csv`,
+  WEBHOOK_ENDPOINTS: "/webhook-endpoints",
+  WEBHOOK_ENDPOINT: (endpointId: string) => `/webhook-endpoints/${endpointId}`,
+  WEBHOOK_DELIVERIES: (endpointId: string) =>
+    `/webhook-endpoints/${endpointId}/deliveries`,
+  LOOKUPS: "/lookups",
+  API_KEYS: "/api-keys",
+  API_KEY: (keyId: string) => `/api-keys/${keyId}`,
   ENRICHMENT_SIGNED_URL: "/enrichment-signed-url",
   SELECT_KEY: "/select-key",
   TIERS: "/tiers",
@@ -5,9 +5,9 @@ export type JobStatus =
   | "CANCELLED"
   | "COMPLETED";
 
-export type JobType = "enrichment" | "imputation";
+export type JobType = "enrichment" | "imputation" | "derived" | "classify";
 
-export type ColumnType = "string" | "number" | "boolean" | "date";
+export type ColumnType = "string" | "number" | "boolean" | "date" | "list";
 
 export type RowStage =
   | "PENDING"
@@ -24,6 +24,8 @@ export interface ColumnMetadata {
   type: ColumnType;
   job_type: JobType;
   description?: string | null;
+  expression?: string | null;
+  input_columns?: string[] | null;
 }
 
 export interface SourceJobSummary {
@@ -31,10 +33,13 @@ export interface SourceJobSummary {
   status: JobStatus;
   total_rows: number;
   key_columns?: string[] | null;
+  context_columns?: string[] | null;
   key_column_description?: string | null;
   columns_metadata?: ColumnMetadata[] | null;
   created_at: string;
   started_at?: string | null;
+  source_revision?: number;
+  base_job_id?: string | null;
 }
 
 export interface SourceSummary {
@@ -51,6 +56,17 @@ export interface SourceDetail {
   type: string;
   created_at: string;
   jobs: SourceJobSummary[];
+  revision?: number | null;
+}
+
+export interface SourceRevisionRequest {
+  contentType: string;
+  length: number;
+}
+
+export interface SourceRevisionResponse {
+  url: string;
+  revision: number;
 }
 
 export interface SourceListResponse {
@@ -61,13 +77,59 @@ export interface SourceListResponse {
 export interface SourceDataResponse {
   headers: string[];
   rows: string[][];
+  offset: number;
+  has_more: boolean;
+}
+
+export interface SheetSelectionRequest {
+  sheet_name?: string | null;
+  header_row?: number | null;
+}
+
+export interface SourceSheetsResponse {
+  sheets: string[];
+  sheet_name?: string | null;
+  header_row: number;
+}
+
+export interface CSVDialectRequest {
+  delimiter?: string | null;
+  encoding?: string | null;
+  header_row?: number | null;
+}
+
+export interface CSVDialectResponse {
+  delimiter: string;
+  encoding: string;
+  bom: boolean;
+  header_row: number;
+  manual: boolean;
+}
+
+export interface SQLSourceRequest {
+  connection: string;
+  query: string;
+  name?: string | null;
+  write_back_table?: string | null;
+}
+
+export interface SQLSourceResponse {
+  source_id: string;
+}
+
+export interface SourceConnectionsResponse {
+  connections: string[];
 }
 
 export interface EnrichRequest {
   columns_metadata: ColumnMetadata[];
   key_columns?: string[] | null;
+  context_columns?: string[] | null;
   key_column_description?: string | null;
   row_limit?: number | null;
+  normalize_keys?: boolean | null;
+  fuzzy_threshold?: number | null;
+  incremental?: boolean | null;
 }
 
 export interface EnrichResponse {
@@ -85,6 +147,16 @@ export interface JobProgressResponse {
 export interface FieldConfidenceInfo {
   score: number;
   reason: string;
+  /** Set by a reviewer; enrichment never overwrites the value. */
+  human_verified?: boolean;
+  citation?: Citation;
+}
+
+export interface Citation {
+  url: string;
+  quote: string;
+  /** Whether the quote was found in the content fetched for the row. */
+  verified: boolean;
 }
 
 export interface ExtractionHistoryEntry {
@@ -113,15 +185,242 @@ export interface PaginationInfo {
   has_more: boolean;
 }
 
+/** Filters of the job rows endpoint. Every set filter must match. */
+export interface RowFilters {
+  present?: string[];
+  missing?: string[];
+  /** Ranges as column:min..max; the column * matches any column. */
+  confidence?: string[];
+  error_contains?: string;
+  key_contains?: string;
+  min_attempts?: number;
+  max_attempts?: number;
+}
+
 export interface RowsProgressResponse {
   rows: RowProgressItem[];
   pagination: PaginationInfo;
 }
 
+export type ExportFormat = "csv" | "xlsx" | "ndjson" | "parquet";
+
+export type ExportStatus = "PENDING" | "RUNNING" | "COMPLETED" | "FAILED";
+
+export interface ExportRequest {
+  format?: ExportFormat;
+  include_confidence?: boolean;
+  include_sources?: boolean;
+  include_citations?: boolean;
+  include_error?: boolean;
+}
+
+export interface ExportResponse {
+  id: string;
+  job_id: string;
+  format: ExportFormat;
+  status: ExportStatus;
+  row_count: number;
+  include_confidence: boolean;
+  include_sources: boolean;
+  include_citations: boolean;
+  include_error: boolean;
+  error?: string | null;
+  created_at: string;
+  completed_at?: string | null;
+  download_url?: string | null;
+  download_expires_at?: string | null;
+}
+
+export interface ExportListResponse {
+  exports: ExportResponse[];
+}
+
+export interface CellCorrectionRequest {
+  row_key: string;
+  column: string;
+  /** Converted to the column's type; null when the cell has no value. */
+  value: unknown;
+}
+
+export interface CellCorrection {
+  id: string;
+  job_id: string;
+  row_key: string;
+  column: string;
+  old_value: unknown;
+  new_value: unknown;
+  user_id: string;
+  created_at: string;
+}
+
+export interface CellCorrectionResponse {
+  correction: CellCorrection;
+  row: RowProgressItem;
+}
+
+export interface CellCorrectionListResponse {
+  corrections: CellCorrection[];
+}
+
+export type RerunMergeMode = "best" | "replace";
+
+export interface RerunRequest {
+  row_keys: string[];
+  columns?: string[];
+  instructions?: string;
+  merge?: RerunMergeMode;
+}
+
+export interface RerunResponse {
+  job_id: string;
+  rows: number;
+  credits: number;
+}
+
+export type DiffChange = "added" | "removed" | "changed" | "unchanged";
+
+export interface CellDiff {
+  column: string;
+  change: DiffChange;
+  base_value: unknown;
+  value: unknown;
+  base_confidence?: number;
+  confidence?: number;
+  confidence_delta?: number;
+}
+
+export interface RowDiff {
+  key: string;
+  cells: CellDiff[];
+}
+
+export interface ColumnDiffSummary {
+  column: string;
+  added: number;
+  removed: number;
+  changed: number;
+  unchanged: number;
+  mean_confidence_delta?: number;
+}
+
+export interface JobDiffResponse {
+  base_job_id: string;
+  job_id: string;
+  columns: ColumnDiffSummary[];
+  rows: RowDiff[];
+  pagination: PaginationInfo;
+}
+
+export interface DiffFilters {
+  column?: string[];
+  change?: DiffChange[];
+}
+
+export interface RowEvent {
+  id: number;
+  job_id: string;
+  row_key: string;
+  stage: string;
+  extracted_data?: Record<string, unknown>;
+  confidence?: Record<string, FieldConfidenceInfo>;
+  error?: string | null;
+  created_at: string;
+}
+
+export type WebhookEventType =
+  | "job.started"
+  | "row.failed"
+  | "job.completed"
+  | "job.cancelled";
+
+export interface CreateWebhookEndpointRequest {
+  url: string;
+  events?: WebhookEventType[];
+}
+
+export interface WebhookEndpoint {
+  id: string;
+  url: string;
+  events: WebhookEventType[];
+  secret?: string;
+  created_at: string;
+}
+
+export interface WebhookEndpointListResponse {
+  endpoints: WebhookEndpoint[];
+}
+
+export interface WebhookDelivery {
+  id: string;
+  endpoint_id: string;
+  event_id: string;
+  event_type: WebhookEventType;
+  job_id: string;
+  payload: Record<string, unknown>;
+  status: "PENDING" | "RETRYING" | "DELIVERED" | "FAILED";
+  attempts: number;
+  response_status?: number | null;
+  error?: string | null;
+  created_at: string;
+  delivered_at?: string | null;
+}
+
+export interface WebhookDeliveryListResponse {
+  deliveries: WebhookDelivery[];
+  pagination: PaginationInfo;
+}
+
+export interface LookupRequest {
+  key: string;
+  key_column_description?: string;
+  columns_metadata: ColumnMetadata[];
+  locale?: string;
+  timeout_seconds?: number;
+}
+
+export interface LookupResponse {
+  key: string;
+  extracted_data: Record<string, unknown>;
+  confidence: Record<string, FieldConfidenceInfo>;
+  sources: string[];
+  success: boolean;
+  error?: string;
+}
+
+export type APIKeyScope = "read-only" | "enrich" | "billing";
+
+export interface CreateAPIKeyRequest {
+  name: string;
+  scopes?: APIKeyScope[];
+  expires_at?: string;
+}
+
+export interface APIKey {
+  id: string;
+  name: string;
+  prefix: string;
+  scopes: APIKeyScope[];
+  key?: string;
+  expires_at?: string | null;
+  last_used_at?: string | null;
+  revoked_at?: string | null;
+  created_at: string;
+}
+
+export interface APIKeyListResponse {
+  keys: APIKey[];
+}
+
 export interface SignedURLRequest {
-  contentType: "text/csv" | "application/json";
+  contentType:
+    | "text/csv"
+    | "application/json"
+    | "application/x-ndjson"
+    | "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet";
   length: number;
   headers?: string[];
+  sheetName?: string;
+  headerRow?: number;
 }
 
 export interface SignedURLResponse {
@@ -1,10 +1,20 @@
 import type { ApiClient } from "@/api";
-import { useQuery } from "@tanstack/react-query";
+import { keepPreviousData, useQuery } from "@tanstack/react-query";
 
-export function useSourceData(api: ApiClient, sourceId: string) {
+export const SOURCE_PAGE_SIZE = 100;
+
+// Each page is read from the source file on the server, so the UI fetches
+// only the page it shows.
+export function useSourceData(
+  api: ApiClient,
+  sourceId: string,
+  offset = 0,
+  limit = SOURCE_PAGE_SIZE,
+) {
   return useQuery({
-    queryKey: ["source-data", sourceId],
-    queryFn: () => api.getSourceData(sourceId),
+    queryKey: ["source-data", sourceId, offset, limit],
+    queryFn: () => api.getSourceData(sourceId, offset, limit),
     staleTime: Infinity, // Source data doesn't change
+    placeholderData: keepPreviousData,
   });
 }
@@ -8,6 +8,7 @@ import {
   SelectItem,
 } from "@/components/ui/select";
 import { Trash2 } from "lucide-react";
+import type { ColumnType } from "@/api";
 import type { ColumnEditorProps } from "./types";
 
 export function ColumnEditor({
@@ -60,7 +61,7 @@ export function ColumnEditor({
         </Select>
         <Select
           value={column.type}
-          onValueChange={(v: "string" | "number" | "boolean" | "date") =>
+          onValueChange={(v: ColumnType) =>
             onUpdate({ type: v })
           }
         >
@@ -72,6 +73,7 @@ export function ColumnEditor({
             <SelectItem value="number">Number</SelectItem>
             <SelectItem value="boolean">Bool</SelectItem>
             <SelectItem value="date">Date</SelectItem>
+            <SelectItem value="list">List</SelectItem>
           </SelectContent>
         </Select>
         <Button
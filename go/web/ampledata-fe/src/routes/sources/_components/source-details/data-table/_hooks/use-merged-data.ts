@@ -14,9 +14,9 @@ import type { UseQueryResult } from "@tanstack/react-query";
 function buildRowFromCsv(
   csvRow: unknown[],
   headers: string[],
-  index: number,
+  sourceIndex: number,
 ): RowData {
-  const row: RowData = { __index: index.toString() };
+  const row: RowData = { __index: sourceIndex.toString() };
   headers.forEach((header, i) => {
     row[header] = csvRow[i];
   });
@@ -28,7 +28,7 @@ function buildBaseRowMap(sourceData: SourceDataResponse): Map<string, RowData> {
   sourceData.rows.forEach((csvRow, index) => {
     rowMap.set(
       index.toString(),
-      buildRowFromCsv(csvRow, sourceData.headers, index),
+      buildRowFromCsv(csvRow, sourceData.headers, sourceData.offset + index),
     );
   });
   return rowMap;
@@ -196,28 +196,32 @@ function applyJobToRows(
 /**
  * Merges CSV source data with enrichment job results into a unified row set.
  *
- * Fetches the raw source rows for `sourceId` and overlays enriched columns
- * from each job in `jobs`. Newer jobs take precedence over older ones for
- * overlapping columns. Rows with no matching job result are marked as PENDING.
+ * Fetches one page of raw source rows for `sourceId` and overlays enriched
+ * columns from each job in `jobs`. Newer jobs take precedence over older ones
+ * for overlapping columns. Rows with no matching job result are marked as
+ * PENDING.
  *
  * @param sourceId - The ID of the source CSV to fetch
  * @param jobs     - Ordered list of enrichment jobs (newest first)
+ * @param offset   - Index of the first source row of the page
  * @returns        - Merged rows, original source columns, enriched column names,
  *                   and a combined fetching flag
  *
  * @example
- * const { data, isFetching } = useMergedData("src_123", jobs);
- * // data.rows        → all rows with enriched fields merged in
+ * const { data, isFetching } = useMergedData("src_123", jobs, 0);
+ * // data.rows        → the page's rows with enriched fields merged in
  * // data.enrichedColumns → ["email", "company", ...]
  */
 export function useMergedData(
   sourceId: string,
   jobs: SourceJobSummary[],
+  offset: number,
 ): { data: MergedDataResult; isFetching: boolean } {
   const api = useApi();
   const { data: sourceData, isFetching: sourceFetching } = useSourceData(
     api,
     sourceId,
+    offset,
   );
   const jobQueries = useAllJobsRows(api, jobs);
 
@@ -225,7 +229,13 @@ export function useMergedData(
 
   const mergedData = useMemo<MergedDataResult>(() => {
     if (!sourceData)
-      return { rows: [], sourceColumns: [], enrichedColumns: [] };
+      return {
+        rows: [],
+        sourceColumns: [],
+        enrichedColumns: [],
+        offset,
+        hasMore: false,
+      };
 
     const rowMap = buildBaseRowMap(sourceData);
     const enrichedCols = new Set<string>();
@@ -248,8 +258,10 @@ export function useMergedData(
       rows: Array.from(rowMap.values()),
       sourceColumns: sourceData.headers,
       enrichedColumns: Array.from(enrichedCols).sort(),
+      offset: sourceData.offset,
+      hasMore: sourceData.has_more,
     };
-  }, [sourceData, jobQueries, jobs]);
+  }, [sourceData, jobQueries, jobs, offset]);
 
   return { data: mergedData, isFetching };
 }
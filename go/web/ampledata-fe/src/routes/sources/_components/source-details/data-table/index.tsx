@@ -1,11 +1,13 @@
 import type { GridApi } from "ag-grid-community";
 import { AgGridReact } from "ag-grid-react";
-import { useRef } from "react";
+import { useRef, useState } from "react";
 import { useColumnDefs } from "./_columns";
 import type { SourceJobSummary, Template } from "@/api";
 import { useMergedData } from "./_hooks/use-merged-data";
 import { agtheme } from "@/lib/ag-theme";
 import { GridToolbar } from "./grid-toolbar";
+import { SourcePager } from "./source-pager";
+import { SOURCE_PAGE_SIZE } from "@/hooks";
 
 interface DataTableProps {
   sourceId: string;
@@ -24,7 +26,12 @@ export function DataTable({
   onToggleSidebar,
   initialTemplate,
 }: DataTableProps) {
-  const { data: mergedData, isFetching } = useMergedData(sourceId, jobs);
+  const [offset, setOffset] = useState(0);
+  const { data: mergedData, isFetching } = useMergedData(
+    sourceId,
+    jobs,
+    offset,
+  );
   const gridRef = useRef<AgGridReact>(null);
   const columnDefs = useColumnDefs(
     mergedData.sourceColumns,
@@ -62,9 +69,6 @@ export function DataTable({
           theme={agtheme}
           loading={isFetching && mergedData.rows.length === 0}
           getRowId={(params) => params.data.__index}
-          pagination
-          paginationPageSize={100}
-          paginationPageSizeSelector={[50, 100, 200, 500]}
           animateRows
           enableCellTextSelection
           rowHeight={36}
@@ -75,6 +79,14 @@ export function DataTable({
           }}
         />
       </div>
+      <SourcePager
+        offset={mergedData.offset}
+        rowCount={mergedData.rows.length}
+        hasMore={mergedData.hasMore}
+        isFetching={isFetching}
+        onPrevious={() => setOffset((o) => Math.max(0, o - SOURCE_PAGE_SIZE))}
+        onNext={() => setOffset((o) => o + SOURCE_PAGE_SIZE)}
+      />
     </div>
   );
 }
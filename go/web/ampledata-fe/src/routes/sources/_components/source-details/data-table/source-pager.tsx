@@ -0,0 +1,47 @@
+import { ChevronLeft, ChevronRight } from "lucide-react";
+
+interface SourcePagerProps {
+  offset: number;
+  rowCount: number;
+  hasMore: boolean;
+  isFetching: boolean;
+  onPrevious: () => void;
+  onNext: () => void;
+}
+
+const buttonClass =
+  "h-7 px-2 flex items-center text-xs font-bold text-slate-600 bg-white border border-slate-200 rounded-lg hover:bg-slate-50 transition-colors disabled:opacity-40 disabled:pointer-events-none";
+
+export function SourcePager({
+  offset,
+  rowCount,
+  hasMore,
+  isFetching,
+  onPrevious,
+  onNext,
+}: SourcePagerProps) {
+  const first = rowCount === 0 ? 0 : offset + 1;
+  return (
+    <div className="flex items-center justify-end gap-3 px-4 py-2 border-t border-slate-100 bg-slate-50 shrink-0">
+      <span className="text-xs font-bold text-slate-400 uppercase tracking-widest">
+        Rows {first}–{offset + rowCount}
+      </span>
+      <button
+        onClick={onPrevious}
+        disabled={offset === 0 || isFetching}
+        title="Previous page"
+        className={buttonClass}
+      >
+        <ChevronLeft className="w-3.5 h-3.5" />
+      </button>
+      <button
+        onClick={onNext}
+        disabled={!hasMore || isFetching}
+        title="Next page"
+        className={buttonClass}
+      >
+        <ChevronRight className="w-3.5 h-3.5" />
+      </button>
+    </div>
+  );
+}
@@ -31,4 +31,6 @@ export interface MergedDataResult {
   rows: RowData[];
   sourceColumns: string[];
   enrichedColumns: string[];
+  offset: number;
+  hasMore: boolean;
 }
@@ -33,11 +33,14 @@ class AmpleCrawler:
         )
 
     async def crawl(self, urls: list[str], query: str) -> list[str]:
+        return [content for _, content in await self.crawl_pages(urls, query)]
+
+    async def crawl_pages(self, urls: list[str], query: str) -> list[tuple[str, str]]:
         results = await self._crawler.arun_many(
             urls=urls, config=self._make_config(query)
         )
         return [
-            result.markdown.fit_markdown or result.markdown.raw_markdown
+            (result.url, result.markdown.fit_markdown or result.markdown.raw_markdown)
             for result in results  # type: ignore
             if result.success and result.markdown
         ]
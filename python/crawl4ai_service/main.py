@@ -24,9 +24,15 @@ class CrawlRequest(BaseModel):
     query: str
 
 
+class CrawledPage(BaseModel):
+    url: str
+    content: str
+
+
 class CrawlResponse(BaseModel):
     content: str
     success: bool
+    pages: list[CrawledPage] = []
 
 
 @app.post("/crawl")
@@ -34,11 +40,15 @@ async def crawl(request: CrawlRequest) -> CrawlResponse:
     if crawler is None:
         return CrawlResponse(content="", success=False)
     try:
-        parts = await crawler.crawl(urls=request.urls, query=request.query)
+        pages = await crawler.crawl_pages(urls=request.urls, query=request.query)
     except Exception as e:
         return CrawlResponse(content=str(e), success=False)
 
-    return CrawlResponse(content="\n\n---\n\n".join(parts), success=True)
+    return CrawlResponse(
+        content="\n\n---\n\n".join(content for _, content in pages),
+        success=True,
+        pages=[CrawledPage(url=url, content=content) for url, content in pages],
+    )
 
 
 @app.get("/health")